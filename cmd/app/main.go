@@ -1,79 +1,356 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
 
+	guestlist "github.com/fpetrikovich/go-guestlist"
+	"github.com/fpetrikovich/go-guestlist/pkg/central"
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	"github.com/fpetrikovich/go-guestlist/pkg/graph"
 	"github.com/fpetrikovich/go-guestlist/pkg/handler"
-	mw "github.com/fpetrikovich/go-guestlist/pkg/middleware"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/pass"
 	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/routes"
+	"github.com/fpetrikovich/go-guestlist/pkg/rpc"
 	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
 )
 
 func main() {
-	router := mux.NewRouter()
+	cfg := config.LoadFromEnv()
 
-	log.Print("[INFO] Server is up!")
+	logger := logging.New(os.Stdout, cfg)
+	slog.SetDefault(logger)
+
+	if err := run(cfg, logger); err != nil {
+		logger.Error("Server failed.", "error", err)
+		os.Exit(1)
+	}
+}
+
+/*
+The `run` function wires the application together and serves HTTP until the process receives SIGINT or SIGTERM.
+On a signal the server stops accepting connections and waits up to `cfg.ShutdownTimeout` for in-flight requests
+to finish, after which the deferred calls close the database connection and flush pending spans.
+*/
+func run(cfg *config.Config, logger *slog.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Set up tracing before any request can be served
+	shutdownTracer, err := tracing.InitTracer(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracer(context.Background())
 
 	// Connect to the database
-	dbRepository := repository.NewMySQLRepository()
+	dbRepository, err := repository.NewMySQLRepository(cfg.MySQLAddress, logger)
+	if err != nil {
+		return err
+	}
 	defer dbRepository.Connection.Close()
 
-	initRoutes(router, dbRepository)
+	// Changes made without a station are recorded at the one of the instance, which must be registered
+	if cfg.StationID != "" {
+		station := &model.Station{StationID: cfg.StationID, Name: cfg.StationID}
+		if err := repository.NewMySQLStationRepository(dbRepository.Connection, logger).RegisterStation(ctx, station); err != nil {
+			return err
+		}
+	}
+
+	// Services tell the waitlist when seats free up, and the door screens when guests arrive
+	seatsFreed := service.NewSeatsFreedSignal()
+	arrivals := service.NewArrivalFeed()
+
+	router, err := initRoutes(dbRepository, cfg, seatsFreed, arrivals, logger)
+	if err != nil {
+		return err
+	}
+
+	// Run the background workers of the mode of the instance, stopping them before the database is closed
+	workers, err := createWorkers(dbRepository.Connection, cfg, seatsFreed, logger)
+	if err != nil {
+		return err
+	}
+	stopWorkers := startWorkers(workers)
+	defer stopWorkers()
+
+	server := &http.Server{
+		Addr:    ":" + cfg.Port,
+		Handler: router,
+	}
+
+	// Serve the typed RPC API on its own port, next to the REST API
+	grpcListener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		return err
+	}
+	grpcServer := createGRPCServer(dbRepository.Connection, cfg, seatsFreed, arrivals, logger)
 
-	err := http.ListenAndServe(":3000", router)
+	serverErr := make(chan error, 2)
+	go func() {
+		logger.Info("Server is up!", "port", cfg.Port)
+		serverErr <- server.ListenAndServe()
+	}()
+	go func() {
+		logger.Info("gRPC server is up!", "port", cfg.GRPCPort)
+		serverErr <- grpcServer.Serve(grpcListener)
+	}()
 
-	log.Fatal(err)
+	select {
+	case err := <-serverErr:
+		grpcServer.Stop()
+		return err
+	case <-ctx.Done():
+	}
+
+	logger.Info("Shutting down, draining in-flight requests.")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Streams watching arrivals never end on their own, so they are cut once the deadline is hit
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		grpcServer.Stop()
+		return err
+	}
+
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		grpcServer.Stop()
+	}
+
+	logger.Info("Server stopped.")
+	return nil
 }
 
 /*
-The initRoutes function sets up HTTP routes for a `mux.Router` using a `repository.MySQLRepository` for database access.
-It takes in a `mux.Router` pointer and a `repository.MySQLRepository` pointer as parameters and maps URL paths to their respective handlers.
-This function provides a centralized location for managing application routes.
+The initRoutes function sets up the HTTP routes of the app using a `repository.MySQLRepository` for database access.
+It takes in a `repository.MySQLRepository` pointer, the `config.Config`, the signal of freed seats, the feed of arrivals and the logger as parameters,
+creates the handlers of the API on top of the database and returns the router built for them by `routes.NewRouter`.
 */
-func initRoutes(router *mux.Router, dbRepo *repository.MySQLRepository) {
+func initRoutes(dbRepo *repository.MySQLRepository, cfg *config.Config, seatsFreed *service.SeatsFreedSignal, arrivals *service.ArrivalFeed, logger *slog.Logger) (*mux.Router, error) {
 
 	// Create handlers
-	tableHandler, guestHandler := createHandlers(dbRepo.Connection)
-
-	// Table Routes
-	router.Handle("/tables/{id}", mw.AppHandler(tableHandler.GetTable)).Methods("GET")
-	router.Handle("/tables", mw.AppHandler(tableHandler.GetTables)).Methods("GET")
-	router.Handle("/tables", mw.AppHandler(tableHandler.CreateTable)).Methods("POST")
-	router.Handle("/seats_empty", mw.AppHandler(tableHandler.GetEmptySeats)).Methods("GET")
-	// Guest Routes
-	router.Handle("/guest_list/{name}", mw.AppHandler(guestHandler.GetGuest)).Methods("GET")
-	router.Handle("/guest_list", mw.AppHandler(guestHandler.GetGuestList)).Methods("GET")
-	router.Handle("/guest_list/{name}", mw.AppHandler(guestHandler.CreateGuest)).Methods("POST")
-	router.Handle("/guests/{name}", mw.AppHandler(guestHandler.UpdateGuest)).Methods("PUT")
-	router.Handle("/guests", mw.AppHandler(guestHandler.GetArrivedGuests)).Methods("GET")
-	router.Handle("/guests/{name}", mw.AppHandler(guestHandler.DeleteGuest)).Methods("DELETE")
-
-	// ping
-	router.HandleFunc("/ping", handlerPing)
+	h, err := createHandlers(dbRepo.Connection, cfg, seatsFreed, arrivals, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return routes.NewRouter(h, cfg, logger)
 }
 
 /*
-The `createHandlers` function creates two handlers, `handler.EventTableHandler` and `handler.GuestHandler`,
-for a SQL database represented by the `sql.DB` pointer `con`. It returns two pointers to these handlers.
-The purpose of this function is to create instances of the event table and guest handlers
+The `createHandlers` function creates the handlers of the API for a SQL database represented by the `sql.DB` pointer `con`, injecting `logger` in the layers that log.
+The services that free seats notify `seatsFreed`, and arrivals are published to `arrivals`.
+The purpose of this function is to create instances of the repositories, services and handlers
 and pass in the database connection so they can access the database.
 */
-func createHandlers(con *sql.DB) (*handler.EventTableHandler, *handler.GuestHandler) {
+func createHandlers(con *sql.DB, cfg *config.Config, seatsFreed *service.SeatsFreedSignal, arrivals *service.ArrivalFeed, logger *slog.Logger) (*routes.Handlers, error) {
 	// Table
-	tableRepository := repository.NewMySQLEventTableRepository(con)
-	tableService := service.NewDefaultEventTableService(tableRepository)
+	tableRepository := repository.NewMySQLEventTableRepository(con, logger)
+	tableService := service.NewDefaultEventTableService(tableRepository, seatsFreed)
 	// Guest
-	guestRepository := repository.NewMySQLGuestRepository(con)
-	guestService := service.NewDefaultGuestService(guestRepository, tableService)
+	guestRepository := repository.NewMySQLGuestRepository(con, logger)
+	guestService := service.NewDefaultGuestService(guestRepository, tableService, cfg.VIPReserveSeats, cfg.UndoWindow, cfg.StationMode == config.DoorStation, seatsFreed, arrivals, logger)
+	// Waitlist
+	waitlistRepository := repository.NewMySQLWaitlistRepository(con, logger)
+	waitlistService := service.NewDefaultWaitlistService(waitlistRepository, guestService, seatsFreed, logger)
+	// Tags
+	tagRepository := repository.NewMySQLTagRepository(con, logger)
+	tagService := service.NewDefaultTagService(tagRepository, logger)
+	// RSVP
+	rsvpRepository := repository.NewMySQLRSVPRepository(con, logger)
+	rsvpService := service.NewDefaultRSVPService(rsvpRepository, tableService, cfg.VIPReserveSeats, seatsFreed, logger)
+	// Check-in passes
+	passService := service.NewDefaultPassService(guestRepository, rsvpRepository, guestService, newPassSigner(cfg, logger), logger)
+	// Notifications
+	renderer, err := notification.NewRenderer()
+	if err != nil {
+		return nil, err
+	}
+	notificationRepository := repository.NewMySQLNotificationRepository(con, logger)
+	notificationService := service.NewDefaultNotificationService(notificationRepository, renderer, cfg.EventName, cfg.PublicBaseURL, logger)
+	// Sync with the door stations
+	syncService := service.NewDefaultSyncService(repository.NewMySQLSyncRepository(con, logger), seatsFreed, logger)
+	// Stations at the entrances
+	stationService := service.NewDefaultStationService(repository.NewMySQLStationRepository(con, logger), logger)
+	// Reports
+	reportRepository := repository.NewMySQLReportRepository(con, logger)
+	reportService := service.NewDefaultReportService(reportRepository)
+	// GraphQL
+	schema, err := graph.NewSchema(guestService, tableService)
+	if err != nil {
+		return nil, err
+	}
+	// Documentation, served with the address and version of this deployment
+	docsHandler, err := handler.NewDocsHandler(guestlist.APISpec, cfg.PublicBaseURL, cfg.ServiceVersion)
+	if err != nil {
+		return nil, err
+	}
+	// Health
+	healthRepository := repository.NewMySQLHealthRepository(con)
+	healthService := service.NewDefaultHealthService(healthRepository)
 	// Handlers
-	return handler.NewEventTableHandler(tableService), handler.NewGuestHandler(guestService)
+	return &routes.Handlers{
+		Table:        handler.NewEventTableHandler(tableService, logger),
+		Guest:        handler.NewGuestHandler(guestService, logger),
+		Tag:          handler.NewTagHandler(tagService, logger),
+		RSVP:         handler.NewRSVPHandler(rsvpService, logger),
+		Waitlist:     handler.NewWaitlistHandler(waitlistService, logger),
+		Pass:         handler.NewPassHandler(passService, logger),
+		Notification: handler.NewNotificationHandler(notificationService, logger),
+		Report:       handler.NewReportHandler(reportService, logger),
+		Sync:         handler.NewSyncHandler(syncService, logger),
+		Station:      handler.NewStationHandler(stationService, logger),
+		GraphQL:      handler.NewGraphQLHandler(schema, logger),
+		Health:       handler.NewHealthHandler(healthService),
+		Docs:         docsHandler,
+	}, nil
+}
+
+/*
+The `createGRPCServer` function creates the gRPC server of the guests and tables, on top of the same services as the REST handlers.
+Unary calls get the write deadline of the configuration unless the client sets its own.
+*/
+func createGRPCServer(con *sql.DB, cfg *config.Config, seatsFreed *service.SeatsFreedSignal, arrivals *service.ArrivalFeed, logger *slog.Logger) *grpc.Server {
+	tableService := service.NewDefaultEventTableService(repository.NewMySQLEventTableRepository(con, logger), seatsFreed)
+	guestService := service.NewDefaultGuestService(repository.NewMySQLGuestRepository(con, logger), tableService, cfg.VIPReserveSeats, cfg.UndoWindow, cfg.StationMode == config.DoorStation, seatsFreed, arrivals, logger)
+	return rpc.NewServer(guestService, tableService, arrivals, cfg.WriteTimeout, cfg.StationID, logger)
 }
 
-func handlerPing(w http.ResponseWriter, r *http.Request) {
-	fmt.Fprintf(w, "pong\n")
+/*
+The `createWorkers` function creates the workers that run in the background next to the API, depending on `cfg.StationMode`.
+The central instance delivers the notification outbox, offers the seats that free up to the waitlist and releases the seats
+of the guests that didn't turn up by the cutoff. A door station leaves all that to the central instance, and only syncs with it.
+*/
+func createWorkers(con *sql.DB, cfg *config.Config, seatsFreed *service.SeatsFreedSignal, logger *slog.Logger) ([]func(context.Context), error) {
+	switch cfg.StationMode {
+	case config.DoorStation:
+		if cfg.StationID == "" || cfg.CentralURL == "" {
+			return nil, errors.New("a door station needs STATION_ID and CENTRAL_URL")
+		}
+		syncWorker := service.NewSyncWorker(repository.NewMySQLSyncRepository(con, logger),
+			central.NewHTTPClient(cfg.CentralURL, cfg.CentralAPIKey, cfg.WriteTimeout), cfg.StationID, cfg.SyncInterval, logger)
+		return []func(context.Context){syncWorker.Run}, nil
+	case config.CentralStation:
+		dispatcher, err := createOutboxDispatcher(con, cfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		waitlistDispatcher, err := createWaitlistDispatcher(con, cfg, seatsFreed, logger)
+		if err != nil {
+			return nil, err
+		}
+		noShowScheduler := createNoShowScheduler(con, cfg, seatsFreed, logger)
+		return []func(context.Context){dispatcher.Run, waitlistDispatcher.Run, noShowScheduler.Run}, nil
+	default:
+		return nil, fmt.Errorf("unknown STATION_MODE %q, use central or door", cfg.StationMode)
+	}
+}
+
+// Runs each worker in its own goroutine, returning a function that stops them all and waits for them to return.
+func startWorkers(workers []func(context.Context)) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for _, worker := range workers {
+		wg.Add(1)
+		go func(run func(context.Context)) {
+			defer wg.Done()
+			run(ctx)
+		}(worker)
+	}
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+/*
+The `createOutboxDispatcher` function creates the worker that delivers the notification outbox through the SMTP server of the configuration.
+*/
+func createOutboxDispatcher(con *sql.DB, cfg *config.Config, logger *slog.Logger) (*service.OutboxDispatcher, error) {
+	sender, err := notification.NewSMTPSender(notification.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.SMTPFrom,
+	})
+	if err != nil {
+		return nil, err
+	}
+	notificationRepository := repository.NewMySQLNotificationRepository(con, logger)
+	return service.NewOutboxDispatcher(notificationRepository, sender, cfg.OutboxPollInterval, cfg.OutboxMaxAttempts, logger), nil
+}
+
+/*
+The `createWaitlistDispatcher` function creates the worker that offers the seats freed up to the waitlist, woken up by `seatsFreed`.
+*/
+func createWaitlistDispatcher(con *sql.DB, cfg *config.Config, seatsFreed *service.SeatsFreedSignal, logger *slog.Logger) (*service.WaitlistDispatcher, error) {
+	renderer, err := notification.NewRenderer()
+	if err != nil {
+		return nil, err
+	}
+	waitlistRepository := repository.NewMySQLWaitlistRepository(con, logger)
+	notificationRepository := repository.NewMySQLNotificationRepository(con, logger)
+	return service.NewWaitlistDispatcher(waitlistRepository, notificationRepository, renderer,
+		cfg.EventName, cfg.PublicBaseURL, cfg.WaitlistOfferTTL, cfg.VIPReserveSeats, seatsFreed, cfg.WaitlistPollInterval, logger), nil
+}
+
+/*
+The `createNoShowScheduler` function creates the worker that marks the guests who didn't arrive by the cutoff of the configuration as no-shows.
+A cutoff before the doors open is a mistake in the configuration, so no-shows aren't marked at all rather than marking every guest.
+*/
+func createNoShowScheduler(con *sql.DB, cfg *config.Config, seatsFreed *service.SeatsFreedSignal, logger *slog.Logger) *service.NoShowScheduler {
+	schedule := model.EventSchedule{DoorsOpen: cfg.DoorsOpen, NoShowCutoff: cfg.NoShowCutoff}
+	if !schedule.DoorsOpen.IsZero() && !schedule.NoShowCutoff.IsZero() && schedule.NoShowCutoff.Before(schedule.DoorsOpen) {
+		logger.Warn("NO_SHOW_CUTOFF is before EVENT_DOORS_OPEN, no-shows won't be marked.", "doors_open", schedule.DoorsOpen, "no_show_cutoff", schedule.NoShowCutoff)
+		schedule.NoShowCutoff = time.Time{}
+	}
+	guestRepository := repository.NewMySQLGuestRepository(con, logger)
+	return service.NewNoShowScheduler(guestRepository, schedule, service.SystemClock, cfg.NoShowPollInterval, seatsFreed, logger)
+}
+
+/*
+The `newPassSigner` function creates the signer of the check-in passes with the secret from the configuration.
+Without a secret a random one is generated, which works for a single instance but invalidates every pass on restart.
+*/
+func newPassSigner(cfg *config.Config, logger *slog.Logger) *pass.Signer {
+	if cfg.PassSecret != "" {
+		return pass.NewSigner([]byte(cfg.PassSecret))
+	}
+
+	logger.Warn("PASS_SECRET is not set, check-in passes will be invalid after a restart.")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return pass.NewSigner(secret)
 }
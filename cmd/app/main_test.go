@@ -0,0 +1,159 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	guestlist "github.com/fpetrikovich/go-guestlist"
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+// Matches the variables of a route with a pattern, like `{id:[0-9]+}`.
+var routeVariable = regexp.MustCompile(`\{(\w+):[^}]+\}`)
+
+// Returns the routes of the app, on a database connection that is never opened.
+func newTestRouter(t *testing.T, cfg *config.Config) *mux.Router {
+	// The connection is only opened on the first query, which never happens here
+	con, err := sql.Open("mysql", "user:password@tcp(localhost:3306)/database")
+	assert.NoError(t, err)
+	t.Cleanup(func() { con.Close() })
+
+	router, err := initRoutes(&repository.MySQLRepository{Connection: con}, cfg, service.NewSeatsFreedSignal(), service.NewArrivalFeed(), logging.NewNop())
+	assert.NoError(t, err)
+	return router
+}
+
+func Test_Routes_Match_API_Spec(t *testing.T) {
+	router := newTestRouter(t, config.LoadFromEnv())
+
+	routes := make(map[string]bool)
+	router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		if route.GetHandler() == nil {
+			// Prefixes of the subrouters of each version, their routes are walked on their own
+			return nil
+		}
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		path = routeVariable.ReplaceAllString(path, "{$1}")
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			// Routes without methods answer any, they are documented as GET
+			methods = []string{http.MethodGet}
+		}
+		for _, method := range methods {
+			routes[method+" "+path] = true
+		}
+		return nil
+	})
+
+	doc, err := openapi3.NewLoader().LoadFromData(guestlist.APISpec)
+	assert.NoError(t, err)
+
+	documented := make(map[string]bool)
+	for path, item := range doc.Paths {
+		for method := range item.Operations() {
+			documented[method+" "+path] = true
+		}
+	}
+
+	assert.Empty(t, missing(routes, documented), "routes missing from the API spec")
+	assert.Empty(t, missing(documented, routes), "operations of the API spec without a route")
+}
+
+// Returns the keys of from that aren't in in, sorted.
+func missing(from map[string]bool, in map[string]bool) []string {
+	var keys []string
+	for key := range from {
+		if !in[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func Test_Routes_Versions(t *testing.T) {
+	cfg := config.LoadFromEnv()
+	cfg.LegacyDeprecatedAt = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	cfg.LegacySunset = time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC)
+	router := newTestRouter(t, cfg)
+
+	// The body doesn't match the spec, so the request is answered before reaching the database
+	send := func(target string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodPost, target, strings.NewReader(`{"table": "one"}`))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Serves_Versioned_Routes_Without_Deprecation", func(t *testing.T) {
+		for _, target := range []string{"/v1/guest_list/Ana", "/v2/guests/Ana"} {
+			rec := send(target)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, target)
+			assert.Empty(t, rec.Header().Values("Deprecation"), target)
+			assert.Empty(t, rec.Header().Values("Sunset"), target)
+		}
+	})
+
+	t.Run("Serves_Legacy_Routes_As_Deprecated", func(t *testing.T) {
+		rec := send("/guest_list/Ana")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "@1790812800", rec.Header().Get("Deprecation"))
+		assert.Equal(t, "Thu, 01 Apr 2027 00:00:00 GMT", rec.Header().Get("Sunset"))
+		assert.Equal(t, `</v1/guest_list/Ana>; rel="successor-version"`, rec.Header().Get("Link"))
+	})
+
+	t.Run("Returns_NotFound_For_Legacy_Path_Under_V2", func(t *testing.T) {
+		rec := send("/v2/guest_list/Ana")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func Test_Routes_Staff(t *testing.T) {
+	cfg := config.LoadFromEnv()
+	cfg.StaffAPIKeys = []string{"staff-key"}
+	router := newTestRouter(t, cfg)
+
+	// The format doesn't match the spec, so the request is answered before reaching the database
+	send := func(target string, apiKey string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodGet, target+"?format=gif", http.NoBody)
+		if apiKey != "" {
+			req.Header.Set("X-API-Key", apiKey)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Serves_Guest_Pass_To_Staff_Keys", func(t *testing.T) {
+		for _, target := range []string{"/guests/7/pass", "/v1/guests/7/pass", "/v2/passes/7"} {
+			assert.Equal(t, http.StatusBadRequest, send(target, "staff-key").Code, target)
+		}
+	})
+
+	t.Run("Returns_Unauthorized_Without_Staff_Key", func(t *testing.T) {
+		for _, target := range []string{"/guests/7/pass", "/v1/guests/7/pass", "/v2/passes/7", "/v1/sync/snapshot", "/v2/sync/conflicts"} {
+			assert.Equal(t, http.StatusUnauthorized, send(target, "").Code, target)
+			assert.Equal(t, http.StatusUnauthorized, send(target, "made-up").Code, target)
+		}
+	})
+}
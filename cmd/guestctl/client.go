@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	mw "github.com/fpetrikovich/go-guestlist/pkg/middleware"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `apiError` struct is returned when the API answers a request with an error status.
+`Message` is the body of the answer, which the API fills with the reason of the error.
+*/
+type apiError struct {
+	Status  int
+	Message string
+}
+
+func (e *apiError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("the API answered %d %s", e.Status, http.StatusText(e.Status))
+	}
+	return fmt.Sprintf("the API answered %d %s: %s", e.Status, http.StatusText(e.Status), e.Message)
+}
+
+/*
+The `client` struct talks to the REST API of the guest list at `baseURL`, using the routes of `/v2`.
+When `apiKey` is set it is sent in the `X-API-Key` header, so the requests of the CLI get their own rate limit when the API knows the key.
+The station and staff member of `checkpoint` are sent in the `X-Station-ID` and `X-Staff` headers when set,
+so the guests let in or out with the CLI are recorded at them.
+*/
+type client struct {
+	baseURL    string
+	apiKey     string
+	checkpoint model.Checkpoint
+	http       *http.Client
+}
+
+func newClient(baseURL string, apiKey string, checkpoint model.Checkpoint, httpClient *http.Client) *client {
+	return &client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		checkpoint: checkpoint,
+		http:       httpClient,
+	}
+}
+
+/**
+ * Sends a request to the API, encoding body as JSON when it isn't nil, and decodes the JSON answer
+ * into out when it isn't nil. Answers with an error status are returned as an apiError.
+ *
+ * @param   method   HTTP method of the request
+ * @param   path     path of the route, relative to the base URL
+ * @param   headers  extra headers of the request
+ * @param   body     value sent as the JSON body, or nil
+ * @param   out      pointer the JSON answer is decoded into, or nil
+ * @return           headers of the answer
+ */
+func (c *client) do(ctx context.Context, method string, path string, headers map[string]string, body interface{}, out interface{}) (http.Header, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set(mw.APIKeyHeader, c.apiKey)
+	}
+	if c.checkpoint.Station != "" {
+		req.Header.Set(mw.StationHeader, c.checkpoint.Station)
+	}
+	if c.checkpoint.Staff != "" {
+		req.Header.Set(mw.StaffHeader, c.checkpoint.Staff)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 64*1024))
+		return res.Header, &apiError{Status: res.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+
+	if out != nil && res.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return res.Header, fmt.Errorf("decoding the answer of %s %s: %w", method, path, err)
+		}
+	}
+	return res.Header, nil
+}
@@ -0,0 +1,446 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+// A command of the CLI, run with the arguments that follow its resource and name.
+type command func(ctx context.Context, c *cli, args []string) error
+
+// Commands of the CLI, by their resource and name.
+var commands = map[string]command{
+	"tables list":   tablesList,
+	"tables create": tablesCreate,
+	"tables delete": tablesDelete,
+	"guests add":    guestsAdd,
+	"guests arrive": guestsArrive,
+	"guests leave":  guestsLeave,
+	"guests import": guestsImport,
+	"guests export": guestsExport,
+	"seats free":    seatsFree,
+}
+
+// The `usageError` is returned when a command is called with the wrong arguments.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// Columns of the CSV files of guests, the first three are the ones of the guest list export.
+var guestColumns = []string{"name", "table", "accompanying_guests", "email", "phone", "diet", "diet_notes", "allergies", "accessibility_needs", "notes"}
+
+/**
+ * Parses the flags of fs wherever they are among args, so they can follow the positional arguments
+ * like in `guests add <name> -table 1`.
+ *
+ * @param   use       arguments of the command, shown when they are wrong
+ * @param   min, max  number of positional arguments the command takes
+ * @return            the positional arguments
+ */
+func parseArgs(fs *flag.FlagSet, args []string, use string, min int, max int) ([]string, error) {
+	fs.SetOutput(io.Discard)
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, &usageError{msg: "usage: guestctl " + use}
+			}
+			return nil, &usageError{msg: fmt.Sprintf("%v\nusage: guestctl %s", err, use)}
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	if len(positional) < min || len(positional) > max {
+		return nil, &usageError{msg: "usage: guestctl " + use}
+	}
+	return positional, nil
+}
+
+// Lists the tables of the event with their capacity.
+func tablesList(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("tables list", flag.ContinueOnError)
+	if _, err := parseArgs(fs, args, "tables list", 0, 0); err != nil {
+		return err
+	}
+
+	var res struct {
+		Tables []model.EventTable `json:"tables"`
+	}
+	if _, err := c.client.do(ctx, http.MethodGet, "/v2/tables", nil, nil, &res); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(res.Tables))
+	for _, table := range res.Tables {
+		rows = append(rows, []string{strconv.Itoa(table.TableID), strconv.Itoa(table.Capacity), strconv.Itoa(table.Version)})
+	}
+	return c.out.print(res.Tables, []string{"ID", "CAPACITY", "VERSION"}, rows)
+}
+
+// Adds a table with the capacity of the -capacity flag.
+func tablesCreate(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("tables create", flag.ContinueOnError)
+	capacity := fs.Int("capacity", 0, "number of seats of the table")
+	if _, err := parseArgs(fs, args, "tables create -capacity <n>", 0, 0); err != nil {
+		return err
+	}
+	if *capacity <= 0 {
+		return &usageError{msg: "the -capacity of the table must be a positive number"}
+	}
+
+	var res struct {
+		ID       int `json:"id"`
+		Capacity int `json:"capacity"`
+	}
+	body := struct {
+		Capacity int `json:"capacity"`
+	}{Capacity: *capacity}
+	if _, err := c.client.do(ctx, http.MethodPost, "/v2/tables", nil, body, &res); err != nil {
+		return err
+	}
+
+	return c.out.print(res, []string{"ID", "CAPACITY"}, [][]string{{strconv.Itoa(res.ID), strconv.Itoa(res.Capacity)}})
+}
+
+// Deletes the table with the given id, refused by the API while guests are still sat at it.
+func tablesDelete(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("tables delete", flag.ContinueOnError)
+	positional, err := parseArgs(fs, args, "tables delete <id>", 1, 1)
+	if err != nil {
+		return err
+	}
+	id, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return &usageError{msg: fmt.Sprintf("the table id %q is not a number", positional[0])}
+	}
+
+	if _, err := c.client.do(ctx, http.MethodDelete, "/v2/tables/"+strconv.Itoa(id), nil, nil, nil); err != nil {
+		return err
+	}
+
+	c.out.done("Deleted table %d.", id)
+	return nil
+}
+
+// Counts the empty seats of all the tables.
+func seatsFree(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("seats free", flag.ContinueOnError)
+	if _, err := parseArgs(fs, args, "seats free", 0, 0); err != nil {
+		return err
+	}
+
+	var res struct {
+		Seats int `json:"seats_empty"`
+	}
+	if _, err := c.client.do(ctx, http.MethodGet, "/v2/seats", nil, nil, &res); err != nil {
+		return err
+	}
+
+	return c.out.print(res, []string{"SEATS_EMPTY"}, [][]string{{strconv.Itoa(res.Seats)}})
+}
+
+// Adds a guest to the guest list, seated at the table of the -table flag.
+func guestsAdd(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("guests add", flag.ContinueOnError)
+	table := fs.Int("table", 0, "id of the table of the guest")
+	entourage := fs.Int("entourage", 0, "number of people accompanying the guest")
+	email := fs.String("email", "", "email address of the guest")
+	phone := fs.String("phone", "", "phone number of the guest")
+	diet := fs.String("diet", "", "diet of the guest")
+	positional, err := parseArgs(fs, args, "guests add <name> -table <id> [-entourage <n>] [-email <address>] [-phone <number>] [-diet <diet>]", 1, 1)
+	if err != nil {
+		return err
+	}
+	if *table <= 0 {
+		return &usageError{msg: "the -table of the guest is required"}
+	}
+
+	guest := model.GuestData{
+		Name:                positional[0],
+		Table:               *table,
+		Accompanying_guests: *entourage,
+		GuestProfile:        model.GuestProfile{Email: *email, Phone: *phone, Diet: model.DietType(*diet)},
+	}
+	if err := addGuest(ctx, c, guest); err != nil {
+		return err
+	}
+
+	return c.out.print(guest, []string{"NAME", "TABLE", "ENTOURAGE"},
+		[][]string{{guest.Name, strconv.Itoa(guest.Table), strconv.Itoa(guest.Accompanying_guests)}})
+}
+
+// Lets in a guest, with the entourage they were expected with unless -entourage says otherwise.
+func guestsArrive(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("guests arrive", flag.ContinueOnError)
+	entourage := fs.Int("entourage", -1, "number of people arriving with the guest, the expected entourage by default")
+	positional, err := parseArgs(fs, args, "guests arrive <name> [-entourage <n>]", 1, 1)
+	if err != nil {
+		return err
+	}
+	name := positional[0]
+
+	// The arrival requires the version of the guest, read along with its expected entourage
+	var guest model.Guest
+	headers, err := c.client.do(ctx, http.MethodGet, guestPath(name), nil, nil, &guest)
+	if err != nil {
+		return err
+	}
+	if *entourage < 0 {
+		*entourage = guest.Entourage
+	}
+
+	body := struct {
+		Accompanying_guests int `json:"accompanying_guests"`
+	}{Accompanying_guests: *entourage}
+	ifMatch := map[string]string{"If-Match": headers.Get("ETag")}
+	if _, err := c.client.do(ctx, http.MethodPut, "/v2/arrivals/"+url.PathEscape(name), ifMatch, body, nil); err != nil {
+		return err
+	}
+
+	return printGuest(ctx, c, name)
+}
+
+// Sets a guest as left, freeing their seats.
+func guestsLeave(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("guests leave", flag.ContinueOnError)
+	positional, err := parseArgs(fs, args, "guests leave <name>", 1, 1)
+	if err != nil {
+		return err
+	}
+	name := positional[0]
+
+	if _, err := c.client.do(ctx, http.MethodDelete, "/v2/arrivals/"+url.PathEscape(name), nil, nil, nil); err != nil {
+		return err
+	}
+
+	return printGuest(ctx, c, name)
+}
+
+/*
+Adds the guests of a CSV file, or of stdin when there is no file or it is `-`. The first row
+names the columns: `name` and `table` are required, the rest of guestColumns are optional.
+Each guest is added on its own, so the guests before and after a failing row are still added.
+*/
+func guestsImport(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("guests import", flag.ContinueOnError)
+	positional, err := parseArgs(fs, args, "guests import [file]", 0, 1)
+	if err != nil {
+		return err
+	}
+
+	in := c.stdin
+	if len(positional) == 1 && positional[0] != "-" {
+		file, err := os.Open(positional[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	guests, err := readGuests(in)
+	if err != nil {
+		return err
+	}
+
+	type result struct {
+		Name  string `json:"name"`
+		Added bool   `json:"added"`
+		Error string `json:"error,omitempty"`
+	}
+	results := make([]result, 0, len(guests))
+	rows := make([][]string, 0, len(guests))
+	failed := 0
+	for _, guest := range guests {
+		res := result{Name: guest.Name, Added: true}
+		status := "added"
+		if err := addGuest(ctx, c, guest); err != nil {
+			res = result{Name: guest.Name, Error: err.Error()}
+			status = err.Error()
+			failed++
+		}
+		results = append(results, res)
+		rows = append(rows, []string{guest.Name, status})
+	}
+
+	if err := c.out.print(results, []string{"NAME", "RESULT"}, rows); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d guests could not be added", failed, len(guests))
+	}
+	return nil
+}
+
+// Writes the guest list as CSV, or as JSON with `-o json`, to the given file or to stdout.
+func guestsExport(ctx context.Context, c *cli, args []string) error {
+	fs := flag.NewFlagSet("guests export", flag.ContinueOnError)
+	positional, err := parseArgs(fs, args, "guests export [file]", 0, 1)
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Guests []model.GuestData `json:"guests"`
+	}
+	if _, err := c.client.do(ctx, http.MethodGet, "/v2/guests", nil, nil, &res); err != nil {
+		return err
+	}
+
+	out := c.stdout
+	if len(positional) == 1 && positional[0] != "-" {
+		file, err := os.Create(positional[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if c.out.json {
+		return (&printer{w: out, json: true}).print(res.Guests, nil, nil)
+	}
+
+	writer := csv.NewWriter(out)
+	writer.Write(guestColumns[:3])
+	for _, guest := range res.Guests {
+		writer.Write([]string{guest.Name, strconv.Itoa(guest.Table), strconv.Itoa(guest.Accompanying_guests)})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Adds the guest to the guest list, the name goes in the path and the rest of the guest in the body.
+func addGuest(ctx context.Context, c *cli, guest model.GuestData) error {
+	body := struct {
+		Table               int `json:"table"`
+		Accompanying_guests int `json:"accompanying_guests"`
+		model.GuestProfile
+	}{
+		Table:               guest.Table,
+		Accompanying_guests: guest.Accompanying_guests,
+		GuestProfile:        guest.GuestProfile,
+	}
+	_, err := c.client.do(ctx, http.MethodPost, guestPath(guest.Name), nil, body, nil)
+	return err
+}
+
+// Fetches the guest and prints their arrival.
+func printGuest(ctx context.Context, c *cli, name string) error {
+	var guest model.Guest
+	if _, err := c.client.do(ctx, http.MethodGet, guestPath(name), nil, nil, &guest); err != nil {
+		return err
+	}
+
+	return c.out.print(guest, []string{"NAME", "STATUS", "ENTOURAGE", "VERSION"},
+		[][]string{{guest.Name, string(guest.ArrivalStatus), strconv.Itoa(guest.Entourage), strconv.Itoa(guest.Version)}})
+}
+
+// Path of the guest with the given name in /v2.
+func guestPath(name string) string {
+	return "/v2/guests/" + url.PathEscape(name)
+}
+
+/**
+ * Reads the guests of a CSV file whose first row names its columns.
+ *
+ * @param   in  the CSV file
+ * @return      the guests, in the order of the file
+ */
+func readGuests(in io.Reader) ([]model.GuestData, error) {
+	reader := csv.NewReader(in)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV file is empty, its first row must name its columns")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !isGuestColumn(column) {
+			return nil, fmt.Errorf("unknown column %q, the columns are %s", column, strings.Join(guestColumns, ", "))
+		}
+		columns[column] = i
+	}
+	for _, required := range guestColumns[:2] {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("the CSV file has no %q column", required)
+		}
+	}
+
+	cell := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var guests []model.GuestData
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return guests, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		guest := model.GuestData{
+			Name: cell(record, "name"),
+			GuestProfile: model.GuestProfile{
+				Email:              cell(record, "email"),
+				Phone:              cell(record, "phone"),
+				Diet:               model.DietType(cell(record, "diet")),
+				DietNotes:          cell(record, "diet_notes"),
+				Allergies:          cell(record, "allergies"),
+				AccessibilityNeeds: cell(record, "accessibility_needs"),
+				Notes:              cell(record, "notes"),
+			},
+		}
+		if guest.Table, err = strconv.Atoi(cell(record, "table")); err != nil {
+			return nil, fmt.Errorf("line %d: the table %q is not a number", line, cell(record, "table"))
+		}
+		if entourage := cell(record, "accompanying_guests"); entourage != "" {
+			if guest.Accompanying_guests, err = strconv.Atoi(entourage); err != nil {
+				return nil, fmt.Errorf("line %d: the accompanying guests %q are not a number", line, entourage)
+			}
+		}
+		guests = append(guests, guest)
+	}
+}
+
+// Tells whether column is one of guestColumns.
+func isGuestColumn(column string) bool {
+	for _, known := range guestColumns {
+		if column == known {
+			return true
+		}
+	}
+	return false
+}
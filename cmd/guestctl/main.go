@@ -0,0 +1,151 @@
+/*
+guestctl operates the guest list from the command line, talking to its REST API.
+
+Usage:
+
+	guestctl [flags] <resource> <command> [arguments]
+
+The flags are:
+
+	-url      base URL of the API, defaults to $GUESTCTL_URL or http://localhost:3000
+	-api-key  key sent in the X-API-Key header, defaults to $GUESTCTL_API_KEY
+	-station  station the guests are let in or out at, defaults to $GUESTCTL_STATION
+	-staff    staff member letting the guests in or out, defaults to $GUESTCTL_STAFF
+	-o        output format, table or json
+	-timeout  deadline of each request to the API
+
+The commands are:
+
+	tables list
+	tables create -capacity <n>
+	tables delete <id>
+	guests add <name> -table <id> [-entourage <n>] [-email <address>] [-phone <number>] [-diet <diet>]
+	guests arrive <name> [-entourage <n>]
+	guests leave <name>
+	guests import [file]
+	guests export [file]
+	seats free
+*/
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+// Exit statuses of the CLI.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const usage = `Usage: guestctl [flags] <resource> <command> [arguments]
+
+Commands:
+  tables list                      list the tables with their capacity
+  tables create -capacity <n>      add a table
+  tables delete <id>               delete a table without guests sat at it           
+  guests add <name> -table <id>    add a guest to the guest list
+  guests arrive <name>             let in a guest, with -entourage if it changed
+  guests leave <name>              set a guest as left
+  guests import [file]             add the guests of a CSV file, or of stdin
+  guests export [file]             write the guest list as CSV, to stdout by default
+  seats free                       count the empty seats
+
+Flags:
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, os.Stdin, os.Stdout, os.Stderr))
+}
+
+/*
+The `cli` struct holds what every command needs: the client of the API, the printer of the
+results, and the input and output of the process.
+*/
+type cli struct {
+	client *client
+	out    *printer
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+/**
+ * Runs the command in args, reading the defaults of the flags with getenv.
+ *
+ * @param   args    arguments of the process, without the program name
+ * @param   getenv  looks up environment variables
+ * @return          exit status of the process
+ */
+func run(args []string, getenv func(string) string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("guestctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	baseURL := flags.String("url", envOr(getenv, "GUESTCTL_URL", "http://localhost:3000"), "base URL of the API")
+	apiKey := flags.String("api-key", getenv("GUESTCTL_API_KEY"), "key sent in the X-API-Key header")
+	station := flags.String("station", getenv("GUESTCTL_STATION"), "station the guests are let in or out at, sent in the X-Station-ID header")
+	staff := flags.String("staff", getenv("GUESTCTL_STAFF"), "staff member letting the guests in or out, sent in the X-Staff header")
+	output := flags.String("o", outputTable, "output format, table or json")
+	timeout := flags.Duration("timeout", 10*time.Second, "deadline of each request to the API")
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *output != outputTable && *output != outputJSON {
+		fmt.Fprintf(stderr, "guestctl: unknown output format %q, use table or json\n", *output)
+		return exitUsage
+	}
+	if flags.NArg() < 2 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	c := &cli{
+		client: newClient(*baseURL, *apiKey, model.Checkpoint{Station: *station, Staff: *staff}, &http.Client{Timeout: *timeout}),
+		out:    &printer{w: stdout, json: *output == outputJSON},
+		stdin:  stdin,
+		stdout: stdout,
+	}
+
+	command, ok := commands[flags.Arg(0)+" "+flags.Arg(1)]
+	if !ok {
+		fmt.Fprintf(stderr, "guestctl: unknown command %q\n\n", flags.Arg(0)+" "+flags.Arg(1))
+		flags.Usage()
+		return exitUsage
+	}
+
+	err := command(context.Background(), c, flags.Args()[2:])
+
+	var usageErr *usageError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(stderr, "guestctl: %v\n", err)
+		return exitUsage
+	default:
+		fmt.Fprintf(stderr, "guestctl: %v\n", err)
+		return exitError
+	}
+}
+
+// Returns the environment variable key, or fallback when it isn't set.
+func envOr(getenv func(string) string, key string, fallback string) string {
+	if value := getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
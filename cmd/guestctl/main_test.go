@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	guestlist "github.com/fpetrikovich/go-guestlist"
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/graph"
+	"github.com/fpetrikovich/go-guestlist/pkg/handler"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/routes"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+// The API the CLI is tested against: the real router, on mocks of the guest and table services.
+type testAPI struct {
+	url      string
+	guests   *service.MockIGuestService
+	tables   *service.MockIEventTableService
+	apiKeys  []string
+	stations []string
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	ctrl := gomock.NewController(t)
+	api := &testAPI{
+		guests: service.NewMockIGuestService(ctrl),
+		tables: service.NewMockIEventTableService(ctrl),
+	}
+	logger := logging.NewNop()
+
+	schema, err := graph.NewSchema(api.guests, api.tables)
+	assert.NoError(t, err)
+	docs, err := handler.NewDocsHandler(guestlist.APISpec, "", "test")
+	assert.NoError(t, err)
+
+	// Only the guests and tables are used by the CLI, the rest of the handlers have no service
+	router, err := routes.NewRouter(&routes.Handlers{
+		Table:        handler.NewEventTableHandler(api.tables, logger),
+		Guest:        handler.NewGuestHandler(api.guests, logger),
+		Tag:          handler.NewTagHandler(nil, logger),
+		RSVP:         handler.NewRSVPHandler(nil, logger),
+		Waitlist:     handler.NewWaitlistHandler(nil, logger),
+		Pass:         handler.NewPassHandler(nil, logger),
+		Notification: handler.NewNotificationHandler(nil, logger),
+		Report:       handler.NewReportHandler(nil, logger),
+		Sync:         handler.NewSyncHandler(nil, logger),
+		Station:      handler.NewStationHandler(nil, logger),
+		GraphQL:      handler.NewGraphQLHandler(schema, logger),
+		Health:       handler.NewHealthHandler(nil),
+		Docs:         docs,
+	}, testConfig(), logger)
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.apiKeys = append(api.apiKeys, r.Header.Get("X-API-Key"))
+		api.stations = append(api.stations, r.Header.Get("X-Station-ID"))
+		router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	api.url = server.URL
+	return api
+}
+
+// The configuration of the API, with limits high enough for the tests to never hit them.
+func testConfig() *config.Config {
+	return &config.Config{
+		ServiceName:       "guestlist",
+		ReadTimeout:       5 * time.Second,
+		WriteTimeout:      5 * time.Second,
+		ReadRateLimit:     1000,
+		ReadRateBurst:     1000,
+		WriteRateLimit:    1000,
+		WriteRateBurst:    1000,
+		MaxBodyBytes:      64 * 1024,
+		IdempotencyWindow: time.Minute,
+		StationID:         "main-door",
+	}
+}
+
+// Runs the CLI against the API with the given arguments and stdin, returning its exit status and output.
+func (api *testAPI) run(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	getenv := func(key string) string {
+		return map[string]string{"GUESTCTL_URL": api.url, "GUESTCTL_API_KEY": "door-1"}[key]
+	}
+	status := run(args, getenv, strings.NewReader(stdin), &stdout, &stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func Test_Tables_List(t *testing.T) {
+	t.Run("Prints_Table_With_API_Key", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.tables.EXPECT().GetTables(gomock.Any()).Return([]model.EventTable{{TableID: 1, Capacity: 10, Version: 2}}, nil).Times(1)
+
+		status, stdout, _ := api.run("", "tables", "list")
+
+		assert.Equal(t, exitOK, status)
+		assert.Equal(t, "ID  CAPACITY  VERSION\n1   10        2\n", stdout)
+		assert.Equal(t, []string{"door-1"}, api.apiKeys)
+	})
+
+	t.Run("Prints_JSON", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.tables.EXPECT().GetTables(gomock.Any()).Return([]model.EventTable{{TableID: 1, Capacity: 10}}, nil).Times(1)
+
+		status, stdout, _ := api.run("", "-o", "json", "tables", "list")
+
+		assert.Equal(t, exitOK, status)
+		var tables []model.EventTable
+		assert.NoError(t, json.Unmarshal([]byte(stdout), &tables))
+		assert.Equal(t, []model.EventTable{{TableID: 1, Capacity: 10}}, tables)
+	})
+
+	t.Run("Fails_When_API_Errors", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.tables.EXPECT().GetTables(gomock.Any()).Return(nil, assert.AnError).Times(1)
+
+		status, stdout, stderr := api.run("", "tables", "list")
+
+		assert.Equal(t, exitError, status)
+		assert.Empty(t, stdout)
+		assert.Contains(t, stderr, "500")
+	})
+}
+
+func Test_Tables_Create(t *testing.T) {
+	t.Run("Creates_Table", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.tables.EXPECT().CreateTable(gomock.Any(), &model.EventTable{Capacity: 8}).Return(&model.EventTable{TableID: 4, Capacity: 8}, nil).Times(1)
+
+		status, stdout, _ := api.run("", "tables", "create", "-capacity", "8")
+
+		assert.Equal(t, exitOK, status)
+		assert.Equal(t, "ID  CAPACITY\n4   8\n", stdout)
+	})
+
+	t.Run("Fails_Without_Capacity", func(t *testing.T) {
+		api := newTestAPI(t)
+
+		status, _, stderr := api.run("", "tables", "create")
+
+		assert.Equal(t, exitUsage, status)
+		assert.Contains(t, stderr, "-capacity")
+		assert.Empty(t, api.apiKeys)
+	})
+}
+
+func Test_Tables_Delete(t *testing.T) {
+	t.Run("Deletes_Table", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.tables.EXPECT().DeleteTable(gomock.Any(), 3).Return(nil).Times(1)
+
+		status, stdout, _ := api.run("", "tables", "delete", "3")
+
+		assert.Equal(t, exitOK, status)
+		assert.Equal(t, "Deleted table 3.\n", stdout)
+	})
+
+	t.Run("Fails_When_Table_Not_Found", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.tables.EXPECT().DeleteTable(gomock.Any(), 3).Return(e.NewNotFoundError("3", "tableID", "table")).Times(1)
+
+		status, _, stderr := api.run("", "tables", "delete", "3")
+
+		assert.Equal(t, exitError, status)
+		assert.Contains(t, stderr, "404")
+	})
+
+	t.Run("Fails_When_ID_Not_A_Number", func(t *testing.T) {
+		api := newTestAPI(t)
+
+		status, _, stderr := api.run("", "tables", "delete", "three")
+
+		assert.Equal(t, exitUsage, status)
+		assert.Contains(t, stderr, "not a number")
+	})
+}
+
+func Test_Seats_Free(t *testing.T) {
+	api := newTestAPI(t)
+	api.tables.EXPECT().GetEmptySeats(gomock.Any()).Return(12, nil).Times(1)
+
+	status, stdout, _ := api.run("", "seats", "free")
+
+	assert.Equal(t, exitOK, status)
+	assert.Equal(t, "SEATS_EMPTY\n12\n", stdout)
+}
+
+func Test_Guests_Add(t *testing.T) {
+	api := newTestAPI(t)
+	api.guests.EXPECT().CreateGuest(gomock.Any(), &model.GuestData{
+		Name:                "Ana María",
+		Table:               2,
+		Accompanying_guests: 1,
+		GuestProfile:        model.GuestProfile{Email: "ana@example.com"},
+	}).Return(nil).Times(1)
+
+	// Flags can follow the name of the guest
+	status, stdout, _ := api.run("", "guests", "add", "Ana María", "-table", "2", "-entourage", "1", "-email", "ana@example.com")
+
+	assert.Equal(t, exitOK, status)
+	assert.Equal(t, "NAME       TABLE  ENTOURAGE\nAna María  2      1\n", stdout)
+}
+
+func Test_Guests_Arrive(t *testing.T) {
+	t.Run("Arrives_With_Expected_Entourage", func(t *testing.T) {
+		api := newTestAPI(t)
+		gomock.InOrder(
+			api.guests.EXPECT().GetGuest(gomock.Any(), "ana").Return(&model.Guest{Name: "ana", Entourage: 2, ArrivalStatus: model.NotArrived, Version: 4}, nil),
+			api.guests.EXPECT().UpdateGuest(gomock.Any(), &model.GuestData{Name: "ana", Accompanying_guests: 2}, 4).Return(&model.Guest{Name: "ana", Version: 5}, nil),
+			api.guests.EXPECT().GetGuest(gomock.Any(), "ana").Return(&model.Guest{Name: "ana", Entourage: 2, ArrivalStatus: model.Arrived, Version: 5}, nil),
+		)
+
+		status, stdout, _ := api.run("", "guests", "arrive", "ana")
+
+		assert.Equal(t, exitOK, status)
+		assert.Equal(t, "NAME  STATUS   ENTOURAGE  VERSION\nana   arrived  2          5\n", stdout)
+	})
+
+	t.Run("Arrives_With_Given_Entourage", func(t *testing.T) {
+		api := newTestAPI(t)
+		gomock.InOrder(
+			api.guests.EXPECT().GetGuest(gomock.Any(), "ana").Return(&model.Guest{Name: "ana", Entourage: 2, Version: 4}, nil),
+			api.guests.EXPECT().UpdateGuest(gomock.Any(), &model.GuestData{Name: "ana", Accompanying_guests: 0}, 4).Return(&model.Guest{Name: "ana", Version: 5}, nil),
+			api.guests.EXPECT().GetGuest(gomock.Any(), "ana").Return(&model.Guest{Name: "ana", ArrivalStatus: model.Arrived, Version: 5}, nil),
+		)
+
+		status, _, _ := api.run("", "guests", "arrive", "-entourage", "0", "ana")
+
+		assert.Equal(t, exitOK, status)
+	})
+
+	t.Run("Fails_When_Guest_Not_Found", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.guests.EXPECT().GetGuest(gomock.Any(), "ana").Return(nil, e.NewNotFoundError("ana", "name", "guest")).Times(1)
+
+		status, _, stderr := api.run("", "guests", "arrive", "ana")
+
+		assert.Equal(t, exitError, status)
+		assert.Contains(t, stderr, "404")
+	})
+}
+
+func Test_Guests_Leave(t *testing.T) {
+	api := newTestAPI(t)
+	api.guests.EXPECT().DeleteGuest(gomock.Any(), "ana").Return(nil).Times(1)
+	api.guests.EXPECT().GetGuest(gomock.Any(), "ana").Return(&model.Guest{Name: "ana", ArrivalStatus: model.Left, Version: 6}, nil).Times(1)
+
+	status, stdout, _ := api.run("", "-o", "json", "-station", "north-door", "guests", "leave", "ana")
+
+	assert.Equal(t, exitOK, status)
+	var guest model.Guest
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &guest))
+	assert.Equal(t, model.GuestStatus(model.Left), guest.ArrivalStatus)
+	assert.Equal(t, []string{"north-door", "north-door"}, api.stations)
+}
+
+func Test_Guests_Import(t *testing.T) {
+	t.Run("Reports_Each_Row", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.guests.EXPECT().CreateGuest(gomock.Any(), &model.GuestData{Name: "ana", Table: 1, Accompanying_guests: 2, GuestProfile: model.GuestProfile{Diet: model.DietType("vegan")}}).Return(nil).Times(1)
+		api.guests.EXPECT().CreateGuest(gomock.Any(), &model.GuestData{Name: "bob", Table: 9}).Return(e.NewNotFoundError("9", "tableID", "table")).Times(1)
+		api.guests.EXPECT().CreateGuest(gomock.Any(), &model.GuestData{Name: "cam", Table: 1, Accompanying_guests: 1}).Return(nil).Times(1)
+
+		csv := "name,table,accompanying_guests,diet\nana,1,2,vegan\nbob,9,,\ncam,1,1,\n"
+		status, stdout, stderr := api.run(csv, "guests", "import")
+
+		assert.Equal(t, exitError, status)
+		assert.Contains(t, stdout, "ana   added")
+		assert.Contains(t, stdout, "bob   the API answered 404")
+		assert.Contains(t, stdout, "cam   added")
+		assert.Contains(t, stderr, "1 of 3 guests could not be added")
+	})
+
+	t.Run("Reads_File", func(t *testing.T) {
+		api := newTestAPI(t)
+		api.guests.EXPECT().CreateGuest(gomock.Any(), &model.GuestData{Name: "ana", Table: 1}).Return(nil).Times(1)
+
+		path := filepath.Join(t.TempDir(), "guests.csv")
+		assert.NoError(t, os.WriteFile(path, []byte("table,name\n1,ana\n"), 0o600))
+
+		status, _, _ := api.run("", "guests", "import", path)
+
+		assert.Equal(t, exitOK, status)
+	})
+
+	t.Run("Fails_On_Unknown_Column_Before_Adding", func(t *testing.T) {
+		api := newTestAPI(t)
+
+		status, _, stderr := api.run("name,table,seat\nana,1,4\n", "guests", "import")
+
+		assert.Equal(t, exitError, status)
+		assert.Contains(t, stderr, `unknown column "seat"`)
+		assert.Empty(t, api.apiKeys)
+	})
+}
+
+func Test_Guests_Export(t *testing.T) {
+	api := newTestAPI(t)
+	api.guests.EXPECT().GetGuestList(gomock.Any(), "").Return([]model.GuestData{{Name: "ana", Table: 1, Accompanying_guests: 2}, {Name: "Smith, Bob", Table: 3}}, nil).Times(1)
+
+	status, stdout, _ := api.run("", "guests", "export")
+
+	assert.Equal(t, exitOK, status)
+	assert.Equal(t, "name,table,accompanying_guests\nana,1,2\n\"Smith, Bob\",3,0\n", stdout)
+}
+
+func Test_Usage(t *testing.T) {
+	api := newTestAPI(t)
+
+	status, _, stderr := api.run("", "tables", "burn")
+	assert.Equal(t, exitUsage, status)
+	assert.Contains(t, stderr, `unknown command "tables burn"`)
+
+	status, _, stderr = api.run("", "guests", "leave")
+	assert.Equal(t, exitUsage, status)
+	assert.Contains(t, stderr, "usage: guestctl guests leave <name>")
+
+	status, _, _ = api.run("", "-o", "yaml", "seats", "free")
+	assert.Equal(t, exitUsage, status)
+
+	assert.Empty(t, api.apiKeys)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Formats the results of the commands are printed in.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+/*
+The `printer` writes the results of the commands to `w`, as aligned columns with a header
+or, when `json` is set, as the indented JSON of the result.
+*/
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+/**
+ * Prints the result, as JSON, or as the rows under the header when printing a table.
+ *
+ * @param  result  value printed as JSON
+ * @param  header  names of the columns of the table
+ * @param  rows    cells of each row of the table
+ */
+func (p *printer) print(result interface{}, header []string, rows [][]string) error {
+	if p.json {
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+/**
+ * Prints a message confirming a command that has no result. Nothing is printed as JSON,
+ * the exit status of the command tells whether it succeeded.
+ *
+ * @param  format  format of the message, as in fmt.Printf
+ */
+func (p *printer) done(format string, args ...interface{}) {
+	if p.json {
+		return
+	}
+	fmt.Fprintf(p.w, format+"\n", args...)
+}
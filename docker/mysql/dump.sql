@@ -1,11 +1,20 @@
 DROP TABLE IF EXISTS `event_table`;
 DROP TABLE IF EXISTS `guest`;
+DROP TABLE IF EXISTS `tag`;
+DROP TABLE IF EXISTS `guest_tag`;
 DROP TABLE IF EXISTS `seating`;
+DROP TABLE IF EXISTS `notification_outbox`;
+DROP TABLE IF EXISTS `waitlist_entry`;
+DROP TABLE IF EXISTS `guest_status_change`;
+DROP TABLE IF EXISTS `station`;
+DROP TABLE IF EXISTS `sync_operation`;
+DROP TABLE IF EXISTS `schema_version`;
 DROP VIEW IF EXISTS `seating_usage`;
 
 CREATE TABLE `event_table` (
   `table_id` INT NOT NULL auto_increment, 
   `capacity` INT UNSIGNED,
+  `version` INT UNSIGNED NOT NULL DEFAULT 1,
   `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY(`table_id`)
@@ -14,14 +23,59 @@ CREATE TABLE `event_table` (
 CREATE TABLE `guest` (
   `guest_id` INT NOT NULL auto_increment, 
   `name` CHAR(100) NOT NULL UNIQUE, 
+  `email` VARCHAR(254) NULL DEFAULT NULL,
+  `phone` VARCHAR(32) NOT NULL DEFAULT '',
+  `diet` ENUM('none', 'vegetarian', 'vegan', 'pescatarian', 'gluten_free', 'halal', 'kosher', 'other') DEFAULT 'none',
+  `diet_notes` VARCHAR(255) NOT NULL DEFAULT '',
+  `allergies` VARCHAR(255) NOT NULL DEFAULT '',
+  `accessibility_needs` VARCHAR(255) NOT NULL DEFAULT '',
+  `notes` TEXT,
   `entourage` INT UNSIGNED DEFAULT 0,
-  `arrival_status` ENUM('not_arrived', 'arrived', 'left', 'rejected', 'allocate') DEFAULT 'not_arrived',
+  `expected_entourage` INT UNSIGNED NULL DEFAULT NULL,
+  `arrival_status` ENUM('not_arrived', 'arrived', 'left', 'rejected', 'allocate', 'no_show') DEFAULT 'not_arrived',
   `arrived_at` TIMESTAMP NULL DEFAULT NULL,
+  `rsvp_status` ENUM('invited', 'accepted', 'declined', 'tentative') DEFAULT 'invited',
+  `rsvp_at` TIMESTAMP NULL DEFAULT NULL,
+  `invitation_token` CHAR(32) UNIQUE,
+  `version` INT UNSIGNED NOT NULL DEFAULT 1,
+  `pass_version` INT UNSIGNED NOT NULL DEFAULT 0,
   `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
   PRIMARY KEY(`guest_id`)
 );
 
+CREATE TABLE `station` (
+  `station_id` VARCHAR(64) NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(`station_id`)
+);
+
+CREATE TABLE `guest_status_change` (
+  `change_id` INT NOT NULL auto_increment,
+  `operation_id` CHAR(36) NOT NULL,
+  `guest_id` INT NOT NULL,
+  `from_status` ENUM('not_arrived', 'arrived', 'left', 'rejected', 'allocate', 'no_show') NOT NULL,
+  `from_entourage` INT UNSIGNED NOT NULL,
+  `from_expected_entourage` INT UNSIGNED NULL DEFAULT NULL,
+  `from_arrived_at` TIMESTAMP NULL DEFAULT NULL,
+  `to_status` ENUM('not_arrived', 'arrived', 'left', 'rejected', 'allocate', 'no_show') NOT NULL,
+  `to_entourage` INT UNSIGNED NOT NULL,
+  `to_arrived_at` TIMESTAMP NULL DEFAULT NULL,
+  `changed_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `reverted_at` TIMESTAMP NULL DEFAULT NULL,
+  `synced_at` TIMESTAMP NULL DEFAULT NULL,
+  `station_id` VARCHAR(64) NULL DEFAULT NULL,
+  `staff` VARCHAR(100) NULL DEFAULT NULL,
+  PRIMARY KEY(`change_id`),
+  UNIQUE KEY `UQ_operation_id` (`operation_id`),
+  KEY `IDX_guest_reverted` (`guest_id`, `reverted_at`),
+  KEY `IDX_synced` (`synced_at`),
+  KEY `IDX_station_changed` (`station_id`, `changed_at`),
+  CONSTRAINT `FK_status_change_guest_id` FOREIGN KEY (`guest_id`) REFERENCES `guest` (`guest_id`) ON DELETE CASCADE,
+  CONSTRAINT `FK_status_change_station_id` FOREIGN KEY (`station_id`) REFERENCES `station` (`station_id`)
+);
+
 CREATE TABLE `seating` (
   `guest_id` INT NOT NULL,
   `table_id` INT NOT NULL,
@@ -32,6 +86,88 @@ CREATE TABLE `seating` (
   CONSTRAINT `FK_table_id` FOREIGN KEY (`table_id`) REFERENCES `event_table` (`table_id`) ON DELETE CASCADE
 );
 
+CREATE TABLE `tag` (
+  `tag_id` INT NOT NULL auto_increment,
+  `name` VARCHAR(32) NOT NULL UNIQUE,
+  `description` VARCHAR(255) NOT NULL DEFAULT '',
+  `version` INT UNSIGNED NOT NULL DEFAULT 1,
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY(`tag_id`)
+);
+
+CREATE TABLE `guest_tag` (
+  `guest_id` INT NOT NULL,
+  `tag_id` INT NOT NULL,
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(`guest_id`, `tag_id`),
+  KEY `IDX_tag_id` (`tag_id`),
+  CONSTRAINT `FK_guest_tag_guest_id` FOREIGN KEY (`guest_id`) REFERENCES `guest` (`guest_id`) ON DELETE CASCADE,
+  CONSTRAINT `FK_guest_tag_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tag` (`tag_id`) ON DELETE CASCADE
+);
+
+INSERT INTO `tag` (`name`, `description`) VALUES
+  ('vip', 'Can use the seats held back for VIPs'),
+  ('press', 'Member of the press'),
+  ('staff', 'Works at the event'),
+  ('speaker', 'Gives a talk at the event');
+
+CREATE TABLE `notification_outbox` (
+  `notification_id` INT NOT NULL auto_increment,
+  `guest_id` INT NULL DEFAULT NULL,
+  `recipient` VARCHAR(254) NOT NULL,
+  `kind` ENUM('invitation', 'reminder', 'seat_assignment', 'waitlist_offer') NOT NULL,
+  `subject` VARCHAR(255) NOT NULL,
+  `text_body` TEXT NOT NULL,
+  `html_body` TEXT NOT NULL,
+  `status` ENUM('pending', 'sent', 'failed') DEFAULT 'pending',
+  `attempts` INT UNSIGNED NOT NULL DEFAULT 0,
+  `last_error` VARCHAR(1024) NULL DEFAULT NULL,
+  `claim_token` CHAR(32) NULL DEFAULT NULL,
+  `next_attempt_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `sent_at` TIMESTAMP NULL DEFAULT NULL,
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY(`notification_id`),
+  KEY `IDX_status_next_attempt` (`status`, `next_attempt_at`),
+  CONSTRAINT `FK_notification_guest_id` FOREIGN KEY (`guest_id`) REFERENCES `guest` (`guest_id`) ON DELETE CASCADE
+);
+
+CREATE TABLE `waitlist_entry` (
+  `entry_id` INT NOT NULL auto_increment,
+  `name` CHAR(100) NOT NULL,
+  `email` VARCHAR(254) NULL DEFAULT NULL,
+  `party_size` INT UNSIGNED NOT NULL,
+  `priority` INT NOT NULL DEFAULT 0,
+  `status` ENUM('waiting', 'offered', 'accepted', 'declined', 'expired', 'cancelled') DEFAULT 'waiting',
+  `table_id` INT NULL DEFAULT NULL,
+  `offer_token` CHAR(32) UNIQUE,
+  `offer_expires_at` TIMESTAMP NULL DEFAULT NULL,
+  `version` INT UNSIGNED NOT NULL DEFAULT 1,
+  `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY(`entry_id`),
+  KEY `IDX_status_priority` (`status`, `priority`),
+  CONSTRAINT `FK_waitlist_table_id` FOREIGN KEY (`table_id`) REFERENCES `event_table` (`table_id`) ON DELETE SET NULL
+);
+
+CREATE TABLE `sync_operation` (
+  `station` VARCHAR(64) NOT NULL,
+  `operation_id` CHAR(36) NOT NULL,
+  `guest_id` INT NULL DEFAULT NULL,
+  `name` CHAR(100) NOT NULL,
+  `kind` ENUM('arrive', 'leave') NOT NULL,
+  `accompanying_guests` INT UNSIGNED NOT NULL,
+  `recorded_at` TIMESTAMP NULL DEFAULT NULL,
+  `staff` VARCHAR(100) NOT NULL DEFAULT '',
+  `result` ENUM('applied', 'duplicate', 'conflict') NOT NULL,
+  `conflict` ENUM('guest_not_found', 'duplicate_arrival', 'table_overbooked', 'not_arrived') NULL DEFAULT NULL,
+  `detail` VARCHAR(255) NOT NULL DEFAULT '',
+  `synced_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY(`station`, `operation_id`),
+  KEY `IDX_result` (`result`, `synced_at`)
+);
+
 CREATE VIEW `seating_usage` AS (
   SELECT tab.table_id, 
          tab.capacity, 
@@ -42,7 +178,15 @@ CREATE VIEW `seating_usage` AS (
                      seating.table_id as table_id 
               FROM `guest` 
               JOIN `seating` ON guest.guest_id=seating.guest_id 
-              WHERE FIELD(guest.arrival_status, "not_arrived", "arrived")
+              WHERE guest.arrival_status = "arrived" OR (guest.arrival_status = "not_arrived" AND guest.rsvp_status != "declined")
   ) as `filtered_guest` ON tab.table_id=filtered_guest.table_id
   GROUP BY tab.table_id
-);
\ No newline at end of file
+);
+
+-- Bumped with every change to this file, along with `SchemaVersion` in pkg/repository/health_repository.go
+CREATE TABLE `schema_version` (
+  `version` INT NOT NULL,
+  PRIMARY KEY(`version`)
+);
+
+INSERT INTO `schema_version` (`version`) VALUES (2);
@@ -0,0 +1,117 @@
+package central
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	mw "github.com/fpetrikovich/go-guestlist/pkg/middleware"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IClient` interface defines how a door station talks to the central instance.
+*/
+type IClient interface {
+	// Sends the operations of the door station, returning the outcome of each one.
+	PushOperations(ctx context.Context, station string, operations []model.DoorOperation) (*model.SyncReport, error)
+	// Retrieves the tables and guests of the central instance.
+	GetSnapshot(ctx context.Context) (*model.Snapshot, error)
+}
+
+/*
+The `HTTPClient` struct talks to the REST API of the central instance at `baseURL`, using the routes of `/v2`.
+When `apiKey` is set it is sent in the `X-API-Key` header, which the central instance requires on its sync routes
+and gives each door station its own rate limit with.
+*/
+type HTTPClient struct {
+	baseURL string
+	apiKey  string
+	http    *http.Client
+}
+
+func NewHTTPClient(baseURL string, apiKey string, timeout time.Duration) *HTTPClient {
+	return &HTTPClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		http:    &http.Client{Timeout: timeout},
+	}
+}
+
+/**
+ * Sends the operations to `POST /v2/sync/operations`.
+ *
+ * @param   station     door station that recorded the operations
+ * @param   operations  operations to sync, in the order they were recorded
+ * @return              pointer to the SyncReport with the outcome of each operation
+ */
+func (c *HTTPClient) PushOperations(ctx context.Context, station string, operations []model.DoorOperation) (*model.SyncReport, error) {
+	body := struct {
+		Station    string                `json:"station"`
+		Operations []model.DoorOperation `json:"operations"`
+	}{Station: station, Operations: operations}
+
+	var report model.SyncReport
+	if err := c.do(ctx, http.MethodPost, "/v2/sync/operations", body, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+/**
+ * Fetches the snapshot of `GET /v2/sync/snapshot`.
+ *
+ * @return  pointer to the Snapshot of the central instance
+ */
+func (c *HTTPClient) GetSnapshot(ctx context.Context) (*model.Snapshot, error) {
+	var snapshot model.Snapshot
+	if err := c.do(ctx, http.MethodGet, "/v2/sync/snapshot", nil, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Sends a request with body as JSON when it isn't nil, decoding the JSON answer into out.
+func (c *HTTPClient) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set(mw.APIKeyHeader, c.apiKey)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 4*1024))
+		return fmt.Errorf("central instance answered %s %s with %d: %s", method, path, res.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding the answer of %s %s: %w", method, path, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/central/client.go
+
+// Package central is a generated GoMock package.
+package central
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIClient is a mock of IClient interface.
+type MockIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIClientMockRecorder
+}
+
+// MockIClientMockRecorder is the mock recorder for MockIClient.
+type MockIClientMockRecorder struct {
+	mock *MockIClient
+}
+
+// NewMockIClient creates a new mock instance.
+func NewMockIClient(ctrl *gomock.Controller) *MockIClient {
+	mock := &MockIClient{ctrl: ctrl}
+	mock.recorder = &MockIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIClient) EXPECT() *MockIClientMockRecorder {
+	return m.recorder
+}
+
+// GetSnapshot mocks base method.
+func (m *MockIClient) GetSnapshot(ctx context.Context) (*model.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx)
+	ret0, _ := ret[0].(*model.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockIClientMockRecorder) GetSnapshot(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockIClient)(nil).GetSnapshot), ctx)
+}
+
+// PushOperations mocks base method.
+func (m *MockIClient) PushOperations(ctx context.Context, station string, operations []model.DoorOperation) (*model.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushOperations", ctx, station, operations)
+	ret0, _ := ret[0].(*model.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushOperations indicates an expected call of PushOperations.
+func (mr *MockIClientMockRecorder) PushOperations(ctx, station, operations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushOperations", reflect.TypeOf((*MockIClient)(nil).PushOperations), ctx, station, operations)
+}
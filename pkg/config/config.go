@@ -0,0 +1,251 @@
+package config
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+The `Config` struct holds the runtime configuration of the application.
+
+Values are read from environment variables so the same binary can be pointed at
+different collaborators (e.g. a local OpenTelemetry collector) without rebuilding.
+
+The struct has the following fields:
+- `Port`: the port the HTTP server listens on.
+- `GRPCPort`: the port the gRPC server listens on.
+- `ShutdownTimeout`: how long in-flight requests are given to finish after a termination signal.
+- `ServiceName`: the name reported by the application in telemetry data.
+- `ServiceVersion`: the version reported by the application in telemetry data and in the API specification it serves.
+- `TracingExporter`: where spans are exported to. One of `otlp`, `stdout` or `none`.
+- `OTLPEndpoint`: the host:port of the OTLP/HTTP collector, used by the `otlp` exporter.
+- `ReadTimeout`: the deadline given to routes that only read data.
+- `WriteTimeout`: the deadline given to routes that create or update data.
+- `ReadRateLimit`, `ReadRateBurst`: requests per second and burst allowed per client on each route that only reads data.
+- `WriteRateLimit`, `WriteRateBurst`: requests per second and burst allowed per client on each route that creates or updates data.
+- `RateLimitKeys`: the `X-API-Key` values that get their own rate limit. Clients sending any other key are limited by their IP address.
+- `StaffAPIKeys`: the `X-API-Key` values that reach the routes meant for the staff. Those routes can't be reached when empty.
+- `MaxBodyBytes`: the maximum size of a request body.
+- `IdempotencyWindow`: how long the response to a request with an `Idempotency-Key` is kept for replay.
+- `LegacyDeprecatedAt`: the time the unversioned routes were deprecated at, reported in their `Deprecation` header. Zero when not set.
+- `LegacySunset`: the time the unversioned routes stop being served at, reported in their `Sunset` header. Zero when not set.
+- `LogLevel`: the minimum level of the lines logged. One of `debug`, `info`, `warn` or `error`.
+- `LogFormat`: the format of the log lines. One of `json` or `logfmt`.
+- `LogRedactPII`: whether guest names are redacted from the logs.
+- `PassSecret`: the key check-in passes are signed with. When empty, a random key is used and passes don't survive restarts.
+- `EventName`: the name of the event shown in the notifications.
+- `PublicBaseURL`: the address guests reach the API at, used to build the links in the notifications.
+- `SMTPHost`, `SMTPPort`: the SMTP server notifications are sent through.
+- `SMTPUsername`, `SMTPPassword`: the credentials of the SMTP server. No authentication is used when the username is empty.
+- `SMTPFrom`: the sender address of the notifications.
+- `OutboxPollInterval`: how often the outbox is checked for notifications to send.
+- `OutboxMaxAttempts`: how many times a notification is attempted before giving up.
+- `VIPReserveSeats`: the free seats of each table held back for the guests tagged as VIP, which other guests can't be added, invited or let in to.
+- `UndoWindow`: how long after a guest arrives, leaves or is marked as a no-show the change can be undone.
+- `WaitlistOfferTTL`: how long a party on the waitlist has to answer the seats offered to them.
+- `WaitlistPollInterval`: how often expired offers are checked, besides every time seats free up.
+- `DoorsOpen`: the time the doors of the event open. Zero when not set.
+- `NoShowCutoff`: the time after which guests that haven't arrived are marked as no-shows. Zero when not set, so no one is marked.
+- `NoShowPollInterval`: how often the guests that haven't arrived are checked once the cutoff passed.
+- `MySQLAddress`: the host:port of the MySQL database. A door station points it at its own local database.
+- `StationMode`: whether the instance is the `central` one or a `door` station syncing with it.
+- `StationID`: the id of the station of the instance, unique among the stations of the event. Changes made without a station are recorded at it.
+- `CentralURL`: the base URL of the central instance a door station syncs with.
+- `CentralAPIKey`: the key a door station sends in the `X-API-Key` header to the central instance, one of its `StaffAPIKeys`.
+- `SyncInterval`: how often a door station syncs with the central instance.
+*/
+type Config struct {
+	Port                 string
+	GRPCPort             string
+	ShutdownTimeout      time.Duration
+	ServiceName          string
+	ServiceVersion       string
+	TracingExporter      string
+	OTLPEndpoint         string
+	ReadTimeout          time.Duration
+	WriteTimeout         time.Duration
+	ReadRateLimit        float64
+	ReadRateBurst        int
+	WriteRateLimit       float64
+	WriteRateBurst       int
+	RateLimitKeys        []string
+	StaffAPIKeys         []string
+	MaxBodyBytes         int64
+	IdempotencyWindow    time.Duration
+	LegacyDeprecatedAt   time.Time
+	LegacySunset         time.Time
+	LogLevel             string
+	LogFormat            string
+	LogRedactPII         bool
+	PassSecret           string
+	EventName            string
+	PublicBaseURL        string
+	SMTPHost             string
+	SMTPPort             string
+	SMTPUsername         string
+	SMTPPassword         string
+	SMTPFrom             string
+	OutboxPollInterval   time.Duration
+	OutboxMaxAttempts    int
+	VIPReserveSeats      int
+	UndoWindow           time.Duration
+	WaitlistOfferTTL     time.Duration
+	WaitlistPollInterval time.Duration
+	DoorsOpen            time.Time
+	NoShowCutoff         time.Time
+	NoShowPollInterval   time.Duration
+	MySQLAddress         string
+	StationMode          string
+	StationID            string
+	CentralURL           string
+	CentralAPIKey        string
+	SyncInterval         time.Duration
+}
+
+// Modes an instance can run in, set by `STATION_MODE`.
+const (
+	CentralStation = "central"
+	DoorStation    = "door"
+)
+
+/**
+ * Builds a Config from the environment, falling back to defaults
+ * suitable for running the application locally with docker-compose.
+ *
+ * @return  pointer to an instance of Config
+ */
+func LoadFromEnv() *Config {
+	return &Config{
+		Port:                 getEnv("PORT", "3000"),
+		GRPCPort:             getEnv("GRPC_PORT", "50051"),
+		ShutdownTimeout:      getEnvDuration("SHUTDOWN_TIMEOUT", 15*time.Second),
+		ServiceName:          getEnv("SERVICE_NAME", "guestlist"),
+		ServiceVersion:       getEnv("SERVICE_VERSION", "1.0.0"),
+		TracingExporter:      getEnv("TRACING_EXPORTER", "none"),
+		OTLPEndpoint:         getEnv("OTLP_ENDPOINT", "localhost:4318"),
+		ReadTimeout:          getEnvDuration("READ_TIMEOUT", 2*time.Second),
+		WriteTimeout:         getEnvDuration("WRITE_TIMEOUT", 5*time.Second),
+		ReadRateLimit:        getEnvFloat("RATE_LIMIT_READ_RPS", 20),
+		ReadRateBurst:        getEnvInt("RATE_LIMIT_READ_BURST", 40),
+		WriteRateLimit:       getEnvFloat("RATE_LIMIT_WRITE_RPS", 5),
+		WriteRateBurst:       getEnvInt("RATE_LIMIT_WRITE_BURST", 10),
+		RateLimitKeys:        getEnvList("RATE_LIMIT_API_KEYS"),
+		StaffAPIKeys:         getEnvList("STAFF_API_KEYS"),
+		MaxBodyBytes:         int64(getEnvInt("MAX_BODY_BYTES", 64*1024)),
+		IdempotencyWindow:    getEnvDuration("IDEMPOTENCY_WINDOW", 24*time.Hour),
+		LegacyDeprecatedAt:   getEnvTime("LEGACY_ROUTES_DEPRECATED_AT"),
+		LegacySunset:         getEnvTime("LEGACY_ROUTES_SUNSET"),
+		LogLevel:             getEnv("LOG_LEVEL", "info"),
+		LogFormat:            getEnv("LOG_FORMAT", "json"),
+		LogRedactPII:         getEnvBool("LOG_REDACT_PII", true),
+		PassSecret:           getEnv("PASS_SECRET", ""),
+		EventName:            getEnv("EVENT_NAME", "End of Year Party"),
+		PublicBaseURL:        getEnv("PUBLIC_BASE_URL", "http://localhost:3000"),
+		SMTPHost:             getEnv("SMTP_HOST", "localhost"),
+		SMTPPort:             getEnv("SMTP_PORT", "1025"),
+		SMTPUsername:         getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:         getEnv("SMTP_PASSWORD", ""),
+		SMTPFrom:             getEnv("SMTP_FROM", "Guest List <guestlist@localhost>"),
+		OutboxPollInterval:   getEnvDuration("OUTBOX_POLL_INTERVAL", 5*time.Second),
+		OutboxMaxAttempts:    getEnvInt("OUTBOX_MAX_ATTEMPTS", 5),
+		VIPReserveSeats:      getEnvInt("VIP_RESERVE_SEATS", 0),
+		UndoWindow:           getEnvDuration("UNDO_WINDOW", 5*time.Minute),
+		WaitlistOfferTTL:     getEnvDuration("WAITLIST_OFFER_TTL", 2*time.Hour),
+		WaitlistPollInterval: getEnvDuration("WAITLIST_POLL_INTERVAL", time.Minute),
+		DoorsOpen:            getEnvTime("EVENT_DOORS_OPEN"),
+		NoShowCutoff:         getEnvTime("NO_SHOW_CUTOFF"),
+		NoShowPollInterval:   getEnvDuration("NO_SHOW_POLL_INTERVAL", time.Minute),
+		MySQLAddress:         getEnv("MYSQL_ADDRESS", "guestlist-mysql:3306"),
+		StationMode:          getEnv("STATION_MODE", CentralStation),
+		StationID:            getEnv("STATION_ID", ""),
+		CentralURL:           getEnv("CENTRAL_URL", ""),
+		CentralAPIKey:        getEnv("CENTRAL_API_KEY", ""),
+		SyncInterval:         getEnvDuration("SYNC_INTERVAL", 15*time.Second),
+	}
+}
+
+func getEnv(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+// Reads a comma-separated list, dropping empty entries.
+func getEnvList(key string) []string {
+	var list []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		slog.Warn("Invalid duration in environment, using default.", "key", key, "value", value, "default", fallback)
+		return fallback
+	}
+	return duration
+}
+
+func getEnvTime(key string) time.Time {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return time.Time{}
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		slog.Warn("Invalid RFC 3339 time in environment, leaving it unset.", "key", key, "value", value)
+		return time.Time{}
+	}
+	return parsed
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		slog.Warn("Invalid boolean in environment, using default.", "key", key, "value", value, "default", fallback)
+		return fallback
+	}
+	return parsed
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed <= 0 {
+		slog.Warn("Invalid positive number in environment, using default.", "key", key, "value", value, "default", fallback)
+		return fallback
+	}
+	return parsed
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		slog.Warn("Invalid positive integer in environment, using default.", "key", key, "value", value, "default", fallback)
+		return fallback
+	}
+	return parsed
+}
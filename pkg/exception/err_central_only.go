@@ -0,0 +1,17 @@
+package exception
+
+import "fmt"
+
+type CentralOnlyError struct {
+	Action string
+}
+
+func (e *CentralOnlyError) Error() string {
+	return fmt.Sprintf("%s only at the central instance, this is a door station.", e.Action)
+}
+
+func NewCentralOnlyError(action string) error {
+	return &CentralOnlyError{
+		Action: action,
+	}
+}
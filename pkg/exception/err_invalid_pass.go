@@ -0,0 +1,11 @@
+package exception
+
+type InvalidPassError struct{}
+
+func (e *InvalidPassError) Error() string {
+	return "The pass is not valid."
+}
+
+func NewInvalidPassError() error {
+	return &InvalidPassError{}
+}
@@ -0,0 +1,17 @@
+package exception
+
+import "fmt"
+
+type PassAlreadyUsedError struct {
+	ArrivalStatus string
+}
+
+func (e *PassAlreadyUsedError) Error() string {
+	return fmt.Sprintf("The pass was already used, guest is %s.", e.ArrivalStatus)
+}
+
+func NewPassAlreadyUsedError(arrivalStatus string) error {
+	return &PassAlreadyUsedError{
+		ArrivalStatus: arrivalStatus,
+	}
+}
@@ -0,0 +1,17 @@
+package exception
+
+import "fmt"
+
+type PayloadTooLargeError struct {
+	Limit int64
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("Request body exceeds the limit of %d bytes.", e.Limit)
+}
+
+func NewPayloadTooLargeError(limit int64) error {
+	return &PayloadTooLargeError{
+		Limit: limit,
+	}
+}
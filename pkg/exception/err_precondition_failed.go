@@ -0,0 +1,19 @@
+package exception
+
+import "fmt"
+
+type PreconditionFailedError struct {
+	Resource string
+	Version  int
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("%s has been modified since version %d.", e.Resource, e.Version)
+}
+
+func NewPreconditionFailedError(resource string, version int) error {
+	return &PreconditionFailedError{
+		Resource: resource,
+		Version:  version,
+	}
+}
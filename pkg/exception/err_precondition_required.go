@@ -0,0 +1,17 @@
+package exception
+
+import "fmt"
+
+type PreconditionRequiredError struct {
+	Header string
+}
+
+func (e *PreconditionRequiredError) Error() string {
+	return fmt.Sprintf("The %s header is required.", e.Header)
+}
+
+func NewPreconditionRequiredError(header string) error {
+	return &PreconditionRequiredError{
+		Header: header,
+	}
+}
@@ -0,0 +1,19 @@
+package exception
+
+import "fmt"
+
+type TableNotEmptyError struct {
+	ID     string
+	Guests int
+}
+
+func (e *TableNotEmptyError) Error() string {
+	return fmt.Sprintf("table %s still has %d guests seated, move them to another table first.", e.ID, e.Guests)
+}
+
+func NewTableNotEmptyError(id string, guests int) error {
+	return &TableNotEmptyError{
+		ID:     id,
+		Guests: guests,
+	}
+}
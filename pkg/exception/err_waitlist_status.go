@@ -0,0 +1,17 @@
+package exception
+
+import "fmt"
+
+type WaitlistStatusError struct {
+	Status string
+}
+
+func (e *WaitlistStatusError) Error() string {
+	return fmt.Sprintf("The waitlist entry is %s.", e.Status)
+}
+
+func NewWaitlistStatusError(status string) error {
+	return &WaitlistStatusError{
+		Status: status,
+	}
+}
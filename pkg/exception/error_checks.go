@@ -1,9 +1,12 @@
 package exception
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/mail"
 	"strings"
 
 	"github.com/VividCortex/mysqlerr"
@@ -24,6 +27,24 @@ func ValidateStringInput(input string) error {
 	return nil
 }
 
+// Longest email address accepted, matching the `email` columns of the database.
+const maxEmailLength = 254
+
+// Checks the input is a single email address, without display name, that fits the database. Empty input is valid.
+func ValidateEmailInput(input string) error {
+	if input == "" {
+		return nil
+	}
+	if len(input) > maxEmailLength {
+		return &BadInputError{Input: "email longer than 254 characters"}
+	}
+	address, err := mail.ParseAddress(input)
+	if err != nil || address.Address != input {
+		return &BadInputError{Input: input}
+	}
+	return nil
+}
+
 func CheckDatabaseError(err error, id string, idType string, resource string) error {
 
 	if err == nil {
@@ -43,14 +64,34 @@ func CheckDatabaseError(err error, id string, idType string, resource string) er
 	return err
 }
 
+// Returns the `504 Gateway Timeout` error when the request deadline was hit somewhere down the layers, nil otherwise.
+func CheckTimeoutError(err error) *AppError {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &AppError{Error: err, Message: "[ERROR] Request timed out.", Code: http.StatusGatewayTimeout}
+	}
+	return nil
+}
+
 func ErrorCaseHanding(err error) *AppError {
+	if appErr := CheckTimeoutError(err); appErr != nil {
+		return appErr
+	}
+
 	switch err.(type) {
 	case *NotFoundError:
 		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusNotFound}
-	case *AlreadyExistsError:
+	case *AlreadyExistsError, *PassAlreadyUsedError, *WaitlistStatusError, *TableNotEmptyError, *CentralOnlyError:
 		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusConflict}
 	case *BadInputError, *ExceedsCapacityError, *ArrivalStatusError:
 		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusBadRequest}
+	case *InvalidPassError:
+		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusForbidden}
+	case *PreconditionFailedError:
+		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusPreconditionFailed}
+	case *PreconditionRequiredError:
+		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusPreconditionRequired}
+	case *PayloadTooLargeError:
+		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusRequestEntityTooLarge}
 	case *MissingDataError:
 		return &AppError{Error: err, Message: "[ERROR] " + err.Error(), Code: http.StatusInternalServerError}
 	default:
@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"strings"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+)
+
+/*
+The `queryError` struct is an error of a resolver as reported to the client, with the HTTP
+status the REST API answers the same error with in the `code` extension.
+*/
+type queryError struct {
+	message string
+	code    int
+}
+
+func (q *queryError) Error() string {
+	return q.message
+}
+
+func (q *queryError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": q.code}
+}
+
+/*
+`toError` turns the errors of the service layer into the errors of the resolvers, the same way
+`exception.ErrorCaseHanding` turns them into HTTP responses for the REST API.
+Unknown errors are reported without their message.
+*/
+func toError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if q, ok := err.(*queryError); ok {
+		return q
+	}
+
+	appErr := e.ErrorCaseHanding(err)
+	return &queryError{message: strings.TrimPrefix(appErr.Message, "[ERROR] "), code: appErr.Code}
+}
@@ -0,0 +1,176 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+// Time a loader waits for more keys before fetching a batch.
+const batchWait = 2 * time.Millisecond
+
+// Fetches the values of a batch of keys at once. Keys without a value are left out of the map.
+type batchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// The keys fetched together and, once `done` is closed, their values.
+type batch[K comparable, V any] struct {
+	keys   []K
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+/*
+The `loader` struct collects the keys requested by the resolvers of a query and fetches
+them in batches, so a list of N tables makes one query for their seats instead of N.
+Keys are gathered for `batchWait` after the first one is loaded. Resolvers returning a list
+can also queue the keys their children will load, so the whole list goes in a single
+batch no matter how many resolvers run at a time.
+Values are cached for the life of the loader, which is a single request.
+*/
+type loader[K comparable, V any] struct {
+	ctx     context.Context
+	fetch   batchFunc[K, V]
+	mu      sync.Mutex
+	cache   map[K]*batch[K, V]
+	pending *batch[K, V]
+	started bool
+}
+
+func newLoader[K comparable, V any](ctx context.Context, fetch batchFunc[K, V]) *loader[K, V] {
+	return &loader[K, V]{
+		ctx:   ctx,
+		fetch: fetch,
+		cache: make(map[K]*batch[K, V]),
+	}
+}
+
+/**
+ * Adds the keys to the next batch without waiting for it, so they are fetched along with
+ * the first key loaded.
+ *
+ * @param  keys  keys to fetch in the next batch
+ */
+func (l *loader[K, V]) Queue(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		l.add(key)
+	}
+}
+
+/**
+ * Returns the value of the key, waiting for the batch it belongs to. Keys without a value
+ * return the zero value of V.
+ *
+ * @param  key  key to load
+ * @return      value of the key
+ */
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b := l.add(key)
+	if b == l.pending && !l.started {
+		l.started = true
+		time.AfterFunc(batchWait, l.dispatch)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return b.values[key], b.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Returns the batch of the key, adding it to the pending batch if it wasn't requested yet. Must hold mu.
+func (l *loader[K, V]) add(key K) *batch[K, V] {
+	if b, ok := l.cache[key]; ok {
+		return b
+	}
+	if l.pending == nil {
+		l.pending = &batch[K, V]{done: make(chan struct{})}
+	}
+	l.pending.keys = append(l.pending.keys, key)
+	l.cache[key] = l.pending
+	return l.pending
+}
+
+// Fetches the pending batch, the keys added afterwards go in the next one.
+func (l *loader[K, V]) dispatch() {
+	l.mu.Lock()
+	b := l.pending
+	l.pending = nil
+	l.started = false
+	l.mu.Unlock()
+
+	defer close(b.done)
+	b.values, b.err = l.fetch(l.ctx, b.keys)
+}
+
+/*
+The `loaders` struct holds the loaders of a single request, fetching through the services.
+Errors of a batch are returned to every resolver waiting on it.
+*/
+type loaders struct {
+	guests      *loader[string, *model.Guest]
+	guestTables *loader[int, int]
+	tables      *loader[int, *model.EventTable]
+	seatsEmpty  *loader[int, int]
+	tableGuests *loader[int, []model.Guest]
+}
+
+func newLoaders(ctx context.Context, gs service.IGuestService, ts service.IEventTableService) *loaders {
+	return &loaders{
+		guests: newLoader(ctx, func(ctx context.Context, names []string) (map[string]*model.Guest, error) {
+			guests, err := gs.GetGuestsByName(ctx, names)
+			if err != nil {
+				return nil, err
+			}
+			byName := make(map[string]*model.Guest, len(guests))
+			for i := range guests {
+				byName[guests[i].Name] = &guests[i]
+			}
+			return byName, nil
+		}),
+		guestTables: newLoader(ctx, func(ctx context.Context, guestIDs []int) (map[int]int, error) {
+			seating, err := gs.GetSeating(ctx, guestIDs)
+			if err != nil {
+				return nil, err
+			}
+			byGuest := make(map[int]int, len(seating))
+			for _, seat := range seating {
+				byGuest[seat.GuestID] = seat.TableID
+			}
+			return byGuest, nil
+		}),
+		tables: newLoader(ctx, func(ctx context.Context, ids []int) (map[int]*model.EventTable, error) {
+			tables, err := ts.GetTablesByID(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[int]*model.EventTable, len(tables))
+			for i := range tables {
+				byID[tables[i].TableID] = &tables[i]
+			}
+			return byID, nil
+		}),
+		seatsEmpty:  newLoader(ctx, ts.GetEmptySeatsByTable),
+		tableGuests: newLoader(ctx, gs.GetGuestsAtTables),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
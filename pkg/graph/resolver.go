@@ -0,0 +1,303 @@
+package graph
+
+import (
+	"context"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+/*
+The `resolver` struct is the root of the schema, resolving its queries and mutations with the
+guest and table services. Fields nested in the results are fetched through the loaders of the
+request, so each level of a query makes a single lookup however many results it has.
+*/
+type resolver struct {
+	guestService service.IGuestService
+	tableService service.IEventTableService
+}
+
+func (r *resolver) Tables(ctx context.Context) ([]*tableResolver, error) {
+	tables, err := r.tableService.GetTables(ctx)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	l := loadersFrom(ctx)
+	result := make([]*tableResolver, len(tables))
+	for i := range tables {
+		result[i] = &tableResolver{table: &tables[i]}
+		l.seatsEmpty.Queue(tables[i].TableID)
+		l.tableGuests.Queue(tables[i].TableID)
+	}
+	return result, nil
+}
+
+func (r *resolver) Table(ctx context.Context, args struct{ ID int32 }) (*tableResolver, error) {
+	table, err := r.tableService.GetTable(ctx, int(args.ID))
+	if _, ok := err.(*e.NotFoundError); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &tableResolver{table: table}, nil
+}
+
+func (r *resolver) Guests(ctx context.Context, args struct{ Tag *string }) ([]*guestResolver, error) {
+	tag := ""
+	if args.Tag != nil {
+		tag = *args.Tag
+	}
+
+	guests, err := r.guestService.GetGuestList(ctx, tag)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	l := loadersFrom(ctx)
+	result := make([]*guestResolver, len(guests))
+	for i, guest := range guests {
+		result[i] = &guestResolver{name: guest.Name, tableID: guest.Table}
+		l.guests.Queue(guest.Name)
+		l.tables.Queue(guest.Table)
+	}
+	return result, nil
+}
+
+func (r *resolver) Guest(ctx context.Context, args struct{ Name string }) (*guestResolver, error) {
+	guest, err := r.guestService.GetGuest(ctx, args.Name)
+	if _, ok := err.(*e.NotFoundError); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &guestResolver{name: guest.Name, guest: guest}, nil
+}
+
+func (r *resolver) SeatsEmpty(ctx context.Context) (int32, error) {
+	free, err := r.tableService.GetEmptySeats(ctx)
+	return int32(free), toError(err)
+}
+
+// Arguments of the createGuest mutation.
+type createGuestInput struct {
+	Name               string
+	Table              int32
+	AccompanyingGuests int32
+	Email              *string
+}
+
+func (r *resolver) CreateGuest(ctx context.Context, args struct{ Input createGuestInput }) (*guestResolver, error) {
+	params := &model.GuestData{
+		Name:                args.Input.Name,
+		Table:               int(args.Input.Table),
+		Accompanying_guests: int(args.Input.AccompanyingGuests),
+	}
+	if args.Input.Email != nil {
+		params.Email = *args.Input.Email
+	}
+
+	if err := r.guestService.CreateGuest(ctx, params); err != nil {
+		return nil, toError(err)
+	}
+
+	guest, err := r.guestService.GetGuest(ctx, params.Name)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &guestResolver{name: guest.Name, guest: guest, tableID: params.Table}, nil
+}
+
+func (r *resolver) ArriveGuest(ctx context.Context, args struct {
+	Name               string
+	AccompanyingGuests int32
+	Version            *int32
+}) (*guestResolver, error) {
+	version := service.AnyVersion
+	if args.Version != nil {
+		version = int(*args.Version)
+	}
+
+	guest, err := r.guestService.UpdateGuest(ctx, &model.GuestData{Name: args.Name, Accompanying_guests: int(args.AccompanyingGuests)}, version)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &guestResolver{name: guest.Name, guest: guest}, nil
+}
+
+func (r *resolver) LeaveGuest(ctx context.Context, args struct{ Name string }) (*guestResolver, error) {
+	if err := r.guestService.DeleteGuest(ctx, args.Name); err != nil {
+		return nil, toError(err)
+	}
+
+	guest, err := r.guestService.GetGuest(ctx, args.Name)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &guestResolver{name: guest.Name, guest: guest}, nil
+}
+
+// Resolves the fields of a `Table`.
+type tableResolver struct {
+	table *model.EventTable
+}
+
+func (t *tableResolver) ID() int32 {
+	return int32(t.table.TableID)
+}
+
+func (t *tableResolver) Capacity() int32 {
+	return int32(t.table.Capacity)
+}
+
+func (t *tableResolver) Version() int32 {
+	return int32(t.table.Version)
+}
+
+func (t *tableResolver) SeatsEmpty(ctx context.Context) (int32, error) {
+	free, err := loadersFrom(ctx).seatsEmpty.Load(ctx, t.table.TableID)
+	return int32(free), toError(err)
+}
+
+func (t *tableResolver) Guests(ctx context.Context) ([]*guestResolver, error) {
+	guests, err := loadersFrom(ctx).tableGuests.Load(ctx, t.table.TableID)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	result := make([]*guestResolver, len(guests))
+	for i := range guests {
+		result[i] = &guestResolver{name: guests[i].Name, guest: &guests[i], table: t.table}
+	}
+	return result, nil
+}
+
+/*
+Resolves the fields of a `Guest`. Guests listed by name are only fetched when a field other
+than the name is requested, and their table is only looked up when it isn't known already.
+*/
+type guestResolver struct {
+	name    string
+	guest   *model.Guest
+	tableID int
+	table   *model.EventTable
+}
+
+func (g *guestResolver) load(ctx context.Context) (*model.Guest, error) {
+	if g.guest != nil {
+		return g.guest, nil
+	}
+
+	guest, err := loadersFrom(ctx).guests.Load(ctx, g.name)
+	if err != nil {
+		return nil, toError(err)
+	}
+	if guest == nil {
+		return nil, toError(e.NewNotFoundError(g.name, "name", "guest"))
+	}
+	return guest, nil
+}
+
+func (g *guestResolver) ID(ctx context.Context) (int32, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(guest.GuestID), nil
+}
+
+func (g *guestResolver) Name() string {
+	return g.name
+}
+
+func (g *guestResolver) AccompanyingGuests(ctx context.Context) (int32, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(guest.Entourage), nil
+}
+
+func (g *guestResolver) ArrivalStatus(ctx context.Context) (string, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(guest.ArrivalStatus), nil
+}
+
+func (g *guestResolver) ArrivedAt(ctx context.Context) (*string, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var arrivedAt string
+	switch v := guest.ArrivedAt.(type) {
+	case string:
+		arrivedAt = v
+	case []byte:
+		arrivedAt = string(v)
+	}
+	if arrivedAt == "" {
+		return nil, nil
+	}
+	return &arrivedAt, nil
+}
+
+func (g *guestResolver) RsvpStatus(ctx context.Context) (string, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(guest.RSVPStatus), nil
+}
+
+func (g *guestResolver) Version(ctx context.Context) (int32, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int32(guest.Version), nil
+}
+
+func (g *guestResolver) Tags(ctx context.Context) ([]string, error) {
+	guest, err := g.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if guest.Tags == nil {
+		return []string{}, nil
+	}
+	return guest.Tags, nil
+}
+
+func (g *guestResolver) Table(ctx context.Context) (*tableResolver, error) {
+	if g.table != nil {
+		return &tableResolver{table: g.table}, nil
+	}
+
+	l := loadersFrom(ctx)
+	tableID := g.tableID
+	if tableID == 0 {
+		guest, err := g.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if tableID, err = l.guestTables.Load(ctx, guest.GuestID); err != nil {
+			return nil, toError(err)
+		}
+		if tableID == 0 {
+			return nil, nil
+		}
+	}
+
+	table, err := l.tables.Load(ctx, tableID)
+	if err != nil || table == nil {
+		return nil, toError(err)
+	}
+	return &tableResolver{table: table}, nil
+}
@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/trace/otel"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+/*
+The `Schema` struct serves the GraphQL schema of the tables, guests and their seating,
+resolved with the guest and table services. Every query gets its own loaders, so
+results are batched and cached within a query but never shared between queries.
+*/
+type Schema struct {
+	schema       *graphql.Schema
+	guestService service.IGuestService
+	tableService service.IEventTableService
+}
+
+func NewSchema(gs service.IGuestService, ts service.IEventTableService) (*Schema, error) {
+	schema, err := graphql.ParseSchema(schemaString, &resolver{guestService: gs, tableService: ts}, graphql.Tracer(otel.DefaultTracer()))
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{schema: schema, guestService: gs, tableService: ts}, nil
+}
+
+/**
+ * Runs a query or mutation against the schema. Errors of the resolvers are part of the
+ * response, along with the data that could be resolved.
+ *
+ * @param  query          the GraphQL document
+ * @param  operationName  operation of the document to run, required when it has more than one
+ * @param  variables      values of the variables of the operation
+ * @return                pointer to the response with the data and errors
+ */
+func (s *Schema) Exec(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Response {
+	ctx = withLoaders(ctx, newLoaders(ctx, s.guestService, s.tableService))
+	return s.schema.Exec(ctx, query, operationName, variables)
+}
@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+func newTestSchema(t *testing.T) (*Schema, *service.MockIGuestService, *service.MockIEventTableService) {
+	ctrl := gomock.NewController(t)
+	gs := service.NewMockIGuestService(ctrl)
+	ts := service.NewMockIEventTableService(ctrl)
+
+	schema, err := NewSchema(gs, ts)
+	assert.NoError(t, err)
+	return schema, gs, ts
+}
+
+func Test_Schema_Tables(t *testing.T) {
+	t.Run("Batches_Seats_And_Guests_Of_Every_Table", func(t *testing.T) {
+		schema, gs, ts := newTestSchema(t)
+
+		tables := make([]model.EventTable, 25)
+		ids := make([]int, 25)
+		for i := range tables {
+			tables[i] = model.EventTable{TableID: i + 1, Capacity: 10, Version: 1}
+			ids[i] = i + 1
+		}
+		ts.EXPECT().GetTables(gomock.Any()).Return(tables, nil).Times(1)
+		ts.EXPECT().
+			GetEmptySeatsByTable(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys []int) (map[int]int, error) {
+				assert.ElementsMatch(t, ids, keys)
+				return map[int]int{1: 7, 2: 10}, nil
+			}).
+			Times(1)
+		gs.EXPECT().
+			GetGuestsAtTables(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys []int) (map[int][]model.Guest, error) {
+				assert.ElementsMatch(t, ids, keys)
+				return map[int][]model.Guest{1: {{GuestID: 4, Name: "Ana", Entourage: 2, ArrivalStatus: model.NotArrived}}}, nil
+			}).
+			Times(1)
+
+		response := schema.Exec(context.Background(), `{ tables { id seatsEmpty guests { name accompanyingGuests table { id } } } }`, "", nil)
+
+		assert.Empty(t, response.Errors)
+
+		var data struct {
+			Tables []struct {
+				ID         int
+				SeatsEmpty int
+				Guests     []struct {
+					Name               string
+					AccompanyingGuests int
+					Table              struct{ ID int }
+				}
+			}
+		}
+		assert.NoError(t, json.Unmarshal(response.Data, &data))
+		assert.Len(t, data.Tables, 25)
+		assert.Equal(t, 7, data.Tables[0].SeatsEmpty)
+		assert.Equal(t, "Ana", data.Tables[0].Guests[0].Name)
+		assert.Equal(t, 2, data.Tables[0].Guests[0].AccompanyingGuests)
+		assert.Equal(t, 1, data.Tables[0].Guests[0].Table.ID)
+		assert.Empty(t, data.Tables[1].Guests)
+	})
+
+	t.Run("Returns_Null_When_Table_Not_Found", func(t *testing.T) {
+		schema, _, ts := newTestSchema(t)
+
+		ts.EXPECT().GetTable(gomock.Any(), 9).Return(nil, e.NewNotFoundError("9", "tableID", "table")).Times(1)
+
+		response := schema.Exec(context.Background(), `{ table(id: 9) { id } }`, "", nil)
+
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"table": null}`, string(response.Data))
+	})
+}
+
+func Test_Schema_Guests(t *testing.T) {
+	t.Run("Batches_Guests_And_Tables_Of_The_List", func(t *testing.T) {
+		schema, gs, ts := newTestSchema(t)
+
+		gs.EXPECT().
+			GetGuestList(gomock.Any(), "vip").
+			Return([]model.GuestData{{Name: "Ana", Table: 1}, {Name: "Bob", Table: 2}, {Name: "Cat", Table: 1}}, nil).
+			Times(1)
+		gs.EXPECT().
+			GetGuestsByName(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, names []string) ([]model.Guest, error) {
+				assert.ElementsMatch(t, []string{"Ana", "Bob", "Cat"}, names)
+				return []model.Guest{
+					{GuestID: 1, Name: "Ana", ArrivalStatus: model.Arrived, ArrivedAt: []byte("2024-06-01 20:00:00")},
+					{GuestID: 2, Name: "Bob", ArrivalStatus: model.NotArrived},
+					{GuestID: 3, Name: "Cat", ArrivalStatus: model.NotArrived},
+				}, nil
+			}).
+			Times(1)
+		ts.EXPECT().
+			GetTablesByID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ids []int) ([]model.EventTable, error) {
+				assert.ElementsMatch(t, []int{1, 2}, ids)
+				return []model.EventTable{{TableID: 1, Capacity: 8}, {TableID: 2, Capacity: 4}}, nil
+			}).
+			Times(1)
+
+		response := schema.Exec(context.Background(), `query($tag: String) { guests(tag: $tag) { name arrivalStatus arrivedAt table { capacity } } }`, "", map[string]interface{}{"tag": "vip"})
+
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"guests": [
+			{"name": "Ana", "arrivalStatus": "arrived", "arrivedAt": "2024-06-01 20:00:00", "table": {"capacity": 8}},
+			{"name": "Bob", "arrivalStatus": "not_arrived", "arrivedAt": null, "table": {"capacity": 4}},
+			{"name": "Cat", "arrivalStatus": "not_arrived", "arrivedAt": null, "table": {"capacity": 8}}
+		]}`, string(response.Data))
+	})
+
+	t.Run("Looks_Up_Seating_Of_Single_Guest", func(t *testing.T) {
+		schema, gs, ts := newTestSchema(t)
+
+		gs.EXPECT().GetGuest(gomock.Any(), "Ana").Return(&model.Guest{GuestID: 4, Name: "Ana"}, nil).Times(1)
+		gs.EXPECT().GetSeating(gomock.Any(), []int{4}).Return([]model.Seating{{TableID: 3, GuestID: 4}}, nil).Times(1)
+		ts.EXPECT().GetTablesByID(gomock.Any(), []int{3}).Return([]model.EventTable{{TableID: 3, Capacity: 6}}, nil).Times(1)
+
+		response := schema.Exec(context.Background(), `{ guest(name: "Ana") { id table { id capacity } } }`, "", nil)
+
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"guest": {"id": 4, "table": {"id": 3, "capacity": 6}}}`, string(response.Data))
+	})
+}
+
+func Test_Schema_Mutations(t *testing.T) {
+	t.Run("Creates_Guest_At_Table", func(t *testing.T) {
+		schema, gs, _ := newTestSchema(t)
+
+		gs.EXPECT().
+			CreateGuest(gomock.Any(), &model.GuestData{Name: "Ana", Table: 2, Accompanying_guests: 1, GuestProfile: model.GuestProfile{Email: "ana@example.com"}}).
+			Return(nil).
+			Times(1)
+		gs.EXPECT().GetGuest(gomock.Any(), "Ana").Return(&model.Guest{GuestID: 5, Name: "Ana", Entourage: 1, Version: 1}, nil).Times(1)
+
+		response := schema.Exec(context.Background(), `mutation {
+			createGuest(input: {name: "Ana", table: 2, accompanyingGuests: 1, email: "ana@example.com"}) { id version }
+		}`, "", nil)
+
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"createGuest": {"id": 5, "version": 1}}`, string(response.Data))
+	})
+
+	t.Run("Arrives_Guest_At_Expected_Version", func(t *testing.T) {
+		schema, gs, _ := newTestSchema(t)
+
+		gs.EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: "Ana", Accompanying_guests: 3}, 2).
+			Return(&model.Guest{Name: "Ana", Entourage: 3, ArrivalStatus: model.Arrived, Version: 3}, nil).
+			Times(1)
+
+		response := schema.Exec(context.Background(), `mutation { arriveGuest(name: "Ana", accompanyingGuests: 3, version: 2) { arrivalStatus version } }`, "", nil)
+
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"arriveGuest": {"arrivalStatus": "arrived", "version": 3}}`, string(response.Data))
+	})
+
+	t.Run("Reports_Status_Code_Of_Service_Errors", func(t *testing.T) {
+		schema, gs, _ := newTestSchema(t)
+
+		gs.EXPECT().
+			UpdateGuest(gomock.Any(), gomock.Any(), service.AnyVersion).
+			Return(nil, e.NewPreconditionFailedError("guest", 2)).
+			Times(1)
+
+		response := schema.Exec(context.Background(), `mutation { arriveGuest(name: "Ana", accompanyingGuests: 3) { version } }`, "", nil)
+
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, 412, response.Errors[0].Extensions["code"])
+	})
+
+	t.Run("Leaves_Guest", func(t *testing.T) {
+		schema, gs, _ := newTestSchema(t)
+
+		gs.EXPECT().DeleteGuest(gomock.Any(), "Ana").Return(nil).Times(1)
+		gs.EXPECT().GetGuest(gomock.Any(), "Ana").Return(&model.Guest{Name: "Ana", ArrivalStatus: model.Left}, nil).Times(1)
+
+		response := schema.Exec(context.Background(), `mutation { leaveGuest(name: "Ana") { arrivalStatus } }`, "", nil)
+
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"leaveGuest": {"arrivalStatus": "left"}}`, string(response.Data))
+	})
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	swaggerFiles "github.com/swaggo/files"
+	"gopkg.in/yaml.v3"
+)
+
+// Files of the Swagger UI bundle the documentation page loads, the rest of the bundle isn't served.
+var docsAssets = map[string]bool{
+	"swagger-ui.css":                  true,
+	"swagger-ui-bundle.js":            true,
+	"swagger-ui-standalone-preset.js": true,
+	"favicon-32x32.png":               true,
+	"favicon-16x16.png":               true,
+}
+
+// Page showing the specification with Swagger UI. The validator is disabled, so the page doesn't reach out to the internet.
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>Guest List API</title>
+  <link rel="stylesheet" type="text/css" href="/docs/swagger-ui.css">
+  <link rel="icon" type="image/png" href="/docs/favicon-32x32.png" sizes="32x32">
+  <link rel="icon" type="image/png" href="/docs/favicon-16x16.png" sizes="16x16">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="/docs/swagger-ui-bundle.js"></script>
+  <script src="/docs/swagger-ui-standalone-preset.js"></script>
+  <script>
+    window.onload = function() {
+      window.ui = SwaggerUIBundle({
+        url: "/openapi.json",
+        dom_id: "#swagger-ui",
+        validatorUrl: null,
+        deepLinking: true,
+        presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+        layout: "StandaloneLayout"
+      });
+    };
+  </script>
+</body>
+</html>
+`
+
+/*
+The `DocsHandler` struct serves the OpenAPI specification of the API and a page to browse it,
+all from the binary so the documentation works offline.
+The specification is rendered once, when the handler is created.
+*/
+type DocsHandler struct {
+	specYAML []byte
+	specJSON []byte
+	assets   http.Handler
+}
+
+/*
+`NewDocsHandler` renders the specification in spec with `serverURL` as its only server and
+`version` as its version, failing if it isn't a valid OpenAPI document.
+*/
+func NewDocsHandler(spec []byte, serverURL string, version string) (*DocsHandler, error) {
+	specYAML, err := renderSpec(spec, serverURL, version)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := openapi3.NewLoader().LoadFromData(specYAML)
+	if err != nil {
+		return nil, err
+	}
+	specJSON, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DocsHandler{
+		specYAML: specYAML,
+		specJSON: specJSON,
+		assets:   http.FileServer(swaggerFiles.HTTP),
+	}, nil
+}
+
+/**
+ * Returns the OpenAPI specification of the API as YAML.
+ * CURL CMD: curl -X GET localhost:3000/openapi.yaml
+ */
+func (dh *DocsHandler) GetSpecYAML(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(dh.specYAML)
+}
+
+/**
+ * Returns the OpenAPI specification of the API as JSON.
+ * CURL CMD: curl -X GET localhost:3000/openapi.json
+ */
+func (dh *DocsHandler) GetSpecJSON(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(dh.specJSON)
+}
+
+/**
+ * Returns the page to browse and try out the API, meant to be opened in a browser.
+ * CURL CMD: curl -X GET localhost:3000/docs
+ */
+func (dh *DocsHandler) GetDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(docsPage))
+}
+
+/**
+ * Returns a file of the Swagger UI bundle loaded by the documentation page.
+ * CURL CMD: curl -X GET localhost:3000/docs/swagger-ui.css
+ */
+func (dh *DocsHandler) GetAsset(w http.ResponseWriter, r *http.Request) {
+	asset := mux.Vars(r)["asset"]
+	if !docsAssets[asset] {
+		http.NotFound(w, r)
+		return
+	}
+
+	r = r.Clone(r.Context())
+	r.URL.Path = "/" + asset
+	dh.assets.ServeHTTP(w, r)
+}
+
+// Replaces the servers and the version of the specification, keeping the order of its fields and its comments.
+func renderSpec(spec []byte, serverURL string, version string) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(spec, &root); err != nil {
+		return nil, err
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("the API specification is not a YAML mapping")
+	}
+	doc := root.Content[0]
+
+	info := mappingValue(doc, "info")
+	if info == nil || info.Kind != yaml.MappingNode {
+		return nil, errors.New("the API specification has no info")
+	}
+	setMappingValue(info, "version", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: version})
+
+	server := &yaml.Node{Kind: yaml.MappingNode}
+	setMappingValue(server, "url", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: serverURL})
+	setMappingValue(doc, "servers", &yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{server}})
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&root); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// Returns the value of the key in a mapping node, nil if the key isn't there.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// Sets the value of the key in a mapping node, adding the key at the end if it isn't there.
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	guestlist "github.com/fpetrikovich/go-guestlist"
+)
+
+func Test_DocsHandler_Spec(t *testing.T) {
+	newHandler := func(t *testing.T) *DocsHandler {
+		dh, err := NewDocsHandler(guestlist.APISpec, "https://party.example.com", "2.3.1")
+		assert.NoError(t, err)
+		return dh
+	}
+
+	t.Run("Returns_YAML_With_Server_And_Version_Of_Config", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/openapi.yaml", nil)
+		rec := httptest.NewRecorder()
+
+		newHandler(t).GetSpecYAML(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/yaml", rec.Header().Get("Content-Type"))
+
+		var spec struct {
+			Info    struct{ Title, Version string }
+			Servers []struct{ URL string }
+			Paths   map[string]interface{}
+		}
+		assert.NoError(t, yaml.Unmarshal(rec.Body.Bytes(), &spec))
+		assert.Equal(t, "Guest List API", spec.Info.Title)
+		assert.Equal(t, "2.3.1", spec.Info.Version)
+		assert.Equal(t, []struct{ URL string }{{URL: "https://party.example.com"}}, spec.Servers)
+		assert.Contains(t, spec.Paths, "/guest_list/{name}")
+	})
+
+	t.Run("Returns_JSON_With_Server_And_Version_Of_Config", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+		rec := httptest.NewRecorder()
+
+		newHandler(t).GetSpecJSON(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		var spec struct {
+			OpenAPI string
+			Info    struct{ Version string }
+			Servers []struct{ URL string }
+			Paths   map[string]interface{}
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+		assert.Equal(t, "3.0.2", spec.OpenAPI)
+		assert.Equal(t, "2.3.1", spec.Info.Version)
+		assert.Equal(t, []struct{ URL string }{{URL: "https://party.example.com"}}, spec.Servers)
+		assert.Contains(t, spec.Paths, "/guest_list/{name}")
+	})
+
+	t.Run("Fails_When_Spec_Is_Invalid", func(t *testing.T) {
+		_, err := NewDocsHandler([]byte("- not\n- a spec\n"), "https://party.example.com", "2.3.1")
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_DocsHandler_GetDocs(t *testing.T) {
+	dh, err := NewDocsHandler(guestlist.APISpec, "http://localhost:3000", "1.0.0")
+	assert.NoError(t, err)
+
+	t.Run("Returns_Page_Loading_The_Spec", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/docs", nil)
+		rec := httptest.NewRecorder()
+
+		dh.GetDocs(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), `url: "/openapi.json"`)
+		assert.Contains(t, rec.Body.String(), `src="/docs/swagger-ui-bundle.js"`)
+	})
+
+	t.Run("Returns_Assets_Of_The_Page", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/docs/swagger-ui.css", nil)
+		req = mux.SetURLVars(req, map[string]string{"asset": "swagger-ui.css"})
+		rec := httptest.NewRecorder()
+
+		dh.GetAsset(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Content-Type"), "text/css")
+		assert.NotEmpty(t, rec.Body.Bytes())
+	})
+
+	t.Run("Returns_NotFound_When_Asset_Is_Not_Used_By_The_Page", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/docs/index.html", nil)
+		req = mux.SetURLVars(req, map[string]string{"asset": "index.html"})
+		rec := httptest.NewRecorder()
+
+		dh.GetAsset(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/graph"
+)
+
+type GraphQLHandler struct {
+	schema *graph.Schema
+	logger *slog.Logger
+}
+
+func NewGraphQLHandler(schema *graph.Schema, logger *slog.Logger) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema, logger: logger}
+}
+
+/**
+ * Run a GraphQL query or mutation on the tables, guests and their seating.
+ * Errors of the query are part of the response, with a 200 status, like any GraphQL server.
+ * CURL CMD: curl -X POST localhost:3000/graphql -H 'Content-Type: application/json' -d '{ "query": "{ tables { id seatsEmpty guests { name } } }" }'
+ */
+func (gh *GraphQLHandler) Query(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+
+	// Try to decode the request body into the struct. If there is an error,
+	// respond to the client with the error message and a 400 status code.
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&params)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	if params.Query == "" {
+		return e.ErrorCaseHanding(e.NewBadInputError("missing query"))
+	}
+
+	gh.logger.InfoContext(r.Context(), "Running GraphQL operation.", "operation", params.OperationName)
+
+	response := gh.schema.Exec(r.Context(), params.Query, params.OperationName, params.Variables)
+
+	HandleJsonResponse(w, http.StatusOK, response)
+
+	return nil // success
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/graph"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+func Test_GraphQLHandler_Query(t *testing.T) {
+	newHandler := func(t *testing.T) (*GraphQLHandler, *service.MockIEventTableService) {
+		ctrl := gomock.NewController(t)
+		ts := service.NewMockIEventTableService(ctrl)
+		schema, err := graph.NewSchema(service.NewMockIGuestService(ctrl), ts)
+		assert.NoError(t, err)
+		return NewGraphQLHandler(schema, logging.NewNop()), ts
+	}
+
+	t.Run("Returns_OK_With_Data", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ seatsEmpty }"}`))
+		rec := httptest.NewRecorder()
+
+		gh, ts := newHandler(t)
+		ts.EXPECT().GetEmptySeats(gomock.Any()).Return(12, nil).Times(1)
+
+		err := gh.Query(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			Data struct{ SeatsEmpty int }
+		}
+		json.NewDecoder(rec.Body).Decode(&body)
+		assert.Equal(t, 12, body.Data.SeatsEmpty)
+	})
+
+	t.Run("Returns_OK_With_Errors_When_Query_Is_Invalid", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ chairs }"}`))
+		rec := httptest.NewRecorder()
+
+		gh, _ := newHandler(t)
+
+		err := gh.Query(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"errors"`)
+	})
+
+	t.Run("Returns_BadRequest_When_Query_Is_Missing", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"variables": {}}`))
+		rec := httptest.NewRecorder()
+
+		gh, _ := newHandler(t)
+
+		err := gh.Query(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+}
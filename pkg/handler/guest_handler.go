@@ -1,26 +1,29 @@
 package handler
 
 import (
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/gorilla/mux"
 
 	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
 	"github.com/fpetrikovich/go-guestlist/pkg/service"
 )
 
 type GuestHandler struct {
 	service service.IGuestService
+	logger  *slog.Logger
 }
 
-func NewGuestHandler(ms service.IGuestService) *GuestHandler {
-	return &GuestHandler{service: ms}
+func NewGuestHandler(ms service.IGuestService, logger *slog.Logger) *GuestHandler {
+	return &GuestHandler{service: ms, logger: logger}
 }
 
 /**
- * Retrieve the guest with name {name}
+ * Retrieve the guest with name {name}, with its version in the ETag header.
+ * Answers 304 Not Modified if the If-None-Match header matches the version.
  * CURL EX: curl -X GET localhost:3000/guest_list/{name}'
  */
 func (gh *GuestHandler) GetGuest(w http.ResponseWriter, r *http.Request) *e.AppError {
@@ -29,28 +32,35 @@ func (gh *GuestHandler) GetGuest(w http.ResponseWriter, r *http.Request) *e.AppE
 	params := mux.Vars(r)
 	name := params["name"]
 
-	log.Print("[INFO] Fetching guest with name: ", name)
+	gh.logger.InfoContext(r.Context(), "Fetching guest.", logging.GuestName(name))
 
-	guest, err := gh.service.GetGuest(name)
+	guest, err := gh.service.GetGuest(r.Context(), name)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
 	}
 
+	SetETag(w, guest.Version)
+	if MatchesIfNoneMatch(r, guest.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
 	HandleJsonResponse(w, http.StatusOK, guest)
 
 	return nil // success
 }
 
 /**
- * Retrieve all the guests
- * CURL EX: curl -X GET localhost:3000/guest_list'
+ * Retrieve all the guests, or only those with the tag in the `tag` query parameter.
+ * CURL EX: curl -X GET localhost:3000/guest_list?tag=vip'
  */
 func (gh *GuestHandler) GetGuestList(w http.ResponseWriter, r *http.Request) *e.AppError {
+	tag := r.URL.Query().Get("tag")
 
-	log.Print("[INFO] Fetching guest list...")
+	gh.logger.InfoContext(r.Context(), "Fetching guest list.", "tag", tag)
 
-	guests, err := gh.service.GetGuestList()
+	guests, err := gh.service.GetGuestList(r.Context(), tag)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
@@ -66,14 +76,15 @@ func (gh *GuestHandler) GetGuestList(w http.ResponseWriter, r *http.Request) *e.
 }
 
 /**
- * Retrieve the list of all the arrived guests.
- * CURL CMD: curl -X GET localhost:3000/guests'
+ * Retrieve the list of all the arrived guests, or only those with the tag in the `tag` query parameter.
+ * CURL CMD: curl -X GET localhost:3000/guests?tag=vip'
  */
 func (gh *GuestHandler) GetArrivedGuests(w http.ResponseWriter, r *http.Request) *e.AppError {
+	tag := r.URL.Query().Get("tag")
 
-	log.Print("[INFO] Fetching arrived guests...")
+	gh.logger.InfoContext(r.Context(), "Fetching arrived guests.", "tag", tag)
 
-	guests, err := gh.service.GetArrivedGuests()
+	guests, err := gh.service.GetArrivedGuests(r.Context(), tag)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
@@ -90,7 +101,7 @@ func (gh *GuestHandler) GetArrivedGuests(w http.ResponseWriter, r *http.Request)
 
 /**
  * Adds a guest to the guest list.
- * CURL CMD: curl -X POST localhost:3000/guest_list -H 'Content-Type: application/json' -d '{"table": int, "accompanying_guests": int}'
+ * CURL CMD: curl -X POST localhost:3000/guest_list -H 'Content-Type: application/json' -d '{"table": int, "accompanying_guests": int, "email": string}'
  */
 func (gh *GuestHandler) CreateGuest(w http.ResponseWriter, r *http.Request) *e.AppError {
 
@@ -99,7 +110,7 @@ func (gh *GuestHandler) CreateGuest(w http.ResponseWriter, r *http.Request) *e.A
 	pathParams := mux.Vars(r)
 	bodyParams.Name = pathParams["name"]
 
-	log.Printf("[INFO] Creating guest %s...", bodyParams.Name)
+	gh.logger.InfoContext(r.Context(), "Creating guest.", logging.GuestName(bodyParams.Name))
 
 	// Try to decode the request body into the struct. If there is an error,
 	// respond to the client with the error message and a 400 status code.
@@ -107,10 +118,10 @@ func (gh *GuestHandler) CreateGuest(w http.ResponseWriter, r *http.Request) *e.A
 	err := decoder.Decode(&bodyParams)
 
 	if err != nil {
-		return e.ErrorCaseHanding(e.NewBadInputError("Unknown field in body"))
+		return HandleDecodeError(err)
 	}
 
-	err = gh.service.CreateGuest(&bodyParams)
+	err = gh.service.CreateGuest(r.Context(), &bodyParams)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
@@ -124,29 +135,37 @@ func (gh *GuestHandler) CreateGuest(w http.ResponseWriter, r *http.Request) *e.A
 }
 
 /**
- * Set a guest as arrived.
- * CURL CMD: curl -X PUT "localhost:3000/guests/<name>" -H 'Content-Type: application/json' -d '{"accompanying_guests": int}'
+ * Set a guest as arrived. Requires the If-Match header with the version of the guest
+ * the client read, and returns the new version in the ETag header.
+ * CURL CMD: curl -X PUT "localhost:3000/guests/<name>" -H 'If-Match: "1"' -H 'Content-Type: application/json' -d '{"accompanying_guests": int}'
  */
 func (gh *GuestHandler) UpdateGuest(w http.ResponseWriter, r *http.Request) *e.AppError {
 	pathParams := mux.Vars(r)
 	name := pathParams["name"]
 
+	version, err := ParseIfMatch(r)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
 	var bodyParams model.GuestData
 	bodyParams.Name = name
 
 	decoder := CreateBodyDecoder(r)
-	err := decoder.Decode(&bodyParams)
+	err = decoder.Decode(&bodyParams)
 
 	if err != nil {
-		return e.ErrorCaseHanding(e.NewBadInputError("Unknown field in body"))
+		return HandleDecodeError(err)
 	}
 
-	err = gh.service.UpdateGuest(&bodyParams)
+	guest, err := gh.service.UpdateGuest(r.Context(), &bodyParams, version)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
 	}
 
+	SetETag(w, guest.Version)
+
 	HandleJsonResponse(w, http.StatusOK, struct {
 		Name string `json:"name"`
 	}{Name: name})
@@ -154,6 +173,69 @@ func (gh *GuestHandler) UpdateGuest(w http.ResponseWriter, r *http.Request) *e.A
 	return nil
 }
 
+/**
+ * Set a batch of guests as arrived in a single transaction, like a bus arriving at the door.
+ * Answers with the result of each guest: arrived, rejected or not found.
+ * CURL CMD: curl -X POST "localhost:3000/guests/arrivals" -H 'Content-Type: application/json' -d '{"arrivals": [{"name": string, "accompanying_guests": int}, {"guest_id": int, "accompanying_guests": int}]}'
+ */
+func (gh *GuestHandler) ArriveGuests(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams struct {
+		Arrivals []model.BatchArrival `json:"arrivals"`
+	}
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	report, err := gh.service.ArriveGuests(r.Context(), bodyParams.Arrivals)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, report)
+
+	return nil
+}
+
+/**
+ * Replace the contact details, diet and accessibility needs of a guest. Requires the If-Match
+ * header with the version of the guest the client read, and returns the new version in the ETag header.
+ * CURL CMD: curl -X PUT "localhost:3000/guest_list/<name>/profile" -H 'If-Match: "1"' -H 'Content-Type: application/json' -d '{"email": string, "phone": string, "diet": "vegan", "allergies": string}'
+ */
+func (gh *GuestHandler) UpdateGuestProfile(w http.ResponseWriter, r *http.Request) *e.AppError {
+	name := mux.Vars(r)["name"]
+
+	version, err := ParseIfMatch(r)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	var bodyParams model.GuestProfile
+
+	decoder := CreateBodyDecoder(r)
+	err = decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	guest, err := gh.service.UpdateGuestProfile(r.Context(), name, &bodyParams, version)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, guest.Version)
+
+	HandleJsonResponse(w, http.StatusOK, guest)
+
+	return nil
+}
+
 /**
  * Set a guest as left.
  * CURL CMD:  curl -X DELETE "localhost:3000/guests/<name>"
@@ -162,7 +244,7 @@ func (gh *GuestHandler) DeleteGuest(w http.ResponseWriter, r *http.Request) *e.A
 	pathParams := mux.Vars(r)
 	name := pathParams["name"]
 
-	err := gh.service.DeleteGuest(name)
+	err := gh.service.DeleteGuest(r.Context(), name)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
@@ -172,3 +254,32 @@ func (gh *GuestHandler) DeleteGuest(w http.ResponseWriter, r *http.Request) *e.A
 
 	return nil
 }
+
+/**
+ * Undo the last change of the arrival of a guest, like checking in the wrong guest. Requires
+ * the If-Match header with the version of the guest the client read, and returns the new
+ * version in the ETag header. Answers with the change undone.
+ * CURL CMD: curl -X POST "localhost:3000/guests/<name>/undo" -H 'If-Match: "2"'
+ */
+func (gh *GuestHandler) UndoStatusChange(w http.ResponseWriter, r *http.Request) *e.AppError {
+	name := mux.Vars(r)["name"]
+
+	version, err := ParseIfMatch(r)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	gh.logger.InfoContext(r.Context(), "Undoing guest arrival change.", logging.GuestName(name))
+
+	change, err := gh.service.UndoStatusChange(r.Context(), name, version)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, change.Version)
+
+	HandleJsonResponse(w, http.StatusOK, change)
+
+	return nil
+}
@@ -1,16 +1,21 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
 	"github.com/fpetrikovich/go-guestlist/pkg/service"
 	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,11 +32,11 @@ func Test_GuestHandler_GetGuestList(t *testing.T) {
 		mockService := service.NewMockIGuestService(gomock.NewController(t))
 		mockService.
 			EXPECT().
-			GetGuestList().
+			GetGuestList(gomock.Any(), "").
 			Return([]model.GuestData{{Table: tableID, Name: name, Accompanying_guests: entourage}}, nil).
 			Times(1)
 
-		mh := NewGuestHandler(mockService)
+		mh := NewGuestHandler(mockService, logging.NewNop())
 
 		err := mh.GetGuestList(rec, req)
 
@@ -55,11 +60,11 @@ func Test_GuestHandler_GetGuestList(t *testing.T) {
 		mockService := service.NewMockIGuestService(gomock.NewController(t))
 		mockService.
 			EXPECT().
-			GetGuestList().
+			GetGuestList(gomock.Any(), "").
 			Return([]model.GuestData{}, errors.New("Error occurred")).
 			Times(1)
 
-		mh := NewGuestHandler(mockService)
+		mh := NewGuestHandler(mockService, logging.NewNop())
 
 		err := mh.GetGuestList(rec, req)
 
@@ -68,6 +73,27 @@ func Test_GuestHandler_GetGuestList(t *testing.T) {
 	})
 }
 
+func Test_GuestHandler_GetGuestList_By_Tag(t *testing.T) {
+	t.Run("Passes_Tag_Query_Parameter_To_Service", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/guest_list?tag=vip", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuestList(gomock.Any(), "vip").
+			Return([]model.GuestData{{Name: "Flor", Table: 1}}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.GetGuestList(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
 func Test_GuestHandler_GetArrivedGuests(t *testing.T) {
 	name := "Flor"
 
@@ -81,11 +107,11 @@ func Test_GuestHandler_GetArrivedGuests(t *testing.T) {
 		mockService := service.NewMockIGuestService(gomock.NewController(t))
 		mockService.
 			EXPECT().
-			GetArrivedGuests().
+			GetArrivedGuests(gomock.Any(), "").
 			Return([]model.GuestArrival{{Name: name, Accompanying_guests: entourage, Arrived_at: time}}, nil).
 			Times(1)
 
-		mh := NewGuestHandler(mockService)
+		mh := NewGuestHandler(mockService, logging.NewNop())
 
 		err := mh.GetArrivedGuests(rec, req)
 
@@ -112,11 +138,11 @@ func Test_GuestHandler_GetArrivedGuests(t *testing.T) {
 		mockService := service.NewMockIGuestService(gomock.NewController(t))
 		mockService.
 			EXPECT().
-			GetArrivedGuests().
+			GetArrivedGuests(gomock.Any(), "").
 			Return([]model.GuestArrival{{Name: name, Accompanying_guests: entourage, Arrived_at: time}}, errors.New("Unknown error.")).
 			Times(1)
 
-		mh := NewGuestHandler(mockService)
+		mh := NewGuestHandler(mockService, logging.NewNop())
 
 		err := mh.GetArrivedGuests(rec, req)
 
@@ -124,3 +150,317 @@ func Test_GuestHandler_GetArrivedGuests(t *testing.T) {
 		assert.Equal(t, "[ERROR] Server error.", err.Message)
 	})
 }
+
+func Test_GuestHandler_UpdateGuest(t *testing.T) {
+	name := "Flor"
+
+	t.Run("Returns_GatewayTimeout_When_Deadline_Exceeded", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guests/"+name, strings.NewReader(`{"accompanying_guests": 2}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: name, Accompanying_guests: 2}, 1).
+			Return(nil, context.DeadlineExceeded).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UpdateGuest(rec, req)
+
+		assert.Equal(t, http.StatusGatewayTimeout, err.Code)
+		assert.Equal(t, "[ERROR] Request timed out.", err.Message)
+	})
+
+	t.Run("Returns_PreconditionRequired_When_No_IfMatch", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guests/"+name, strings.NewReader(`{"accompanying_guests": 2}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UpdateGuest(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, err.Code)
+	})
+
+	t.Run("Returns_PreconditionFailed_When_Version_Changed", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guests/"+name, strings.NewReader(`{"accompanying_guests": 2}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: name, Accompanying_guests: 2}, 1).
+			Return(nil, ex.NewPreconditionFailedError("guest", 1)).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UpdateGuest(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, err.Code)
+	})
+
+	t.Run("Returns_New_ETag_When_Updated", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guests/"+name, strings.NewReader(`{"accompanying_guests": 2}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: name, Accompanying_guests: 2}, 1).
+			Return(&model.Guest{Name: name, Version: 2}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UpdateGuest(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+	})
+}
+
+func Test_GuestHandler_GetGuest(t *testing.T) {
+	name := "Flor"
+
+	t.Run("Returns_NotModified_When_IfNoneMatch_Matches", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/guest_list/"+name, http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-None-Match", `"3"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuest(gomock.Any(), name).
+			Return(&model.Guest{Name: name, Version: 3}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.GetGuest(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("Returns_OK_With_ETag_When_Version_Changed", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/guest_list/"+name, http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-None-Match", `"2"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuest(gomock.Any(), name).
+			Return(&model.Guest{Name: name, Version: 3}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.GetGuest(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+	})
+}
+
+func Test_GuestHandler_UpdateGuestProfile(t *testing.T) {
+	name := "Flor"
+
+	t.Run("Returns_PreconditionRequired_When_No_IfMatch", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guest_list/"+name+"/profile", strings.NewReader(`{"diet": "vegan"}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		rec := httptest.NewRecorder()
+
+		mh := NewGuestHandler(service.NewMockIGuestService(gomock.NewController(t)), logging.NewNop())
+
+		err := mh.UpdateGuestProfile(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, err.Code)
+	})
+
+	t.Run("Returns_BadRequest_When_Unknown_Field", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guest_list/"+name+"/profile", strings.NewReader(`{"shoe_size": 42}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+
+		mh := NewGuestHandler(service.NewMockIGuestService(gomock.NewController(t)), logging.NewNop())
+
+		err := mh.UpdateGuestProfile(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_OK_With_ETag_When_Updated", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/guest_list/"+name+"/profile", strings.NewReader(`{"diet": "vegan", "allergies": "peanuts"}`))
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+
+		profile := &model.GuestProfile{Diet: model.DietVegan, Allergies: "peanuts"}
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateGuestProfile(gomock.Any(), name, profile, 1).
+			Return(&model.Guest{Name: name, Version: 2, GuestProfile: *profile}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UpdateGuestProfile(rec, req)
+
+		var returned model.Guest
+		json.NewDecoder(rec.Body).Decode(&returned)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		assert.Equal(t, model.DietVegan, returned.Diet)
+	})
+}
+
+func Test_GuestHandler_ArriveGuests(t *testing.T) {
+	t.Run("Returns_OK_With_Result_Of_Each_Guest", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/guests/arrivals", strings.NewReader(`{"arrivals": [{"name": "Flor", "accompanying_guests": 2}, {"guest_id": 9, "accompanying_guests": 0}]}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			ArriveGuests(gomock.Any(), []model.BatchArrival{{Name: "Flor", Accompanying_guests: 2}, {GuestID: 9}}).
+			Return(&model.BatchArrivalReport{
+				Results: []model.BatchArrivalResult{
+					{GuestID: 1, Name: "Flor", Status: model.BatchArrived, Accompanying_guests: 2, Version: 2},
+					{GuestID: 9, Status: model.BatchNotFound},
+				},
+				Arrived:  1,
+				NotFound: 1,
+			}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.ArriveGuests(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{
+			"results": [
+				{"guest_id": 1, "name": "Flor", "status": "arrived", "accompanying_guests": 2, "version": 2},
+				{"guest_id": 9, "status": "not_found", "accompanying_guests": 0}
+			],
+			"arrived": 1, "rejected": 0, "not_found": 1
+		}`, rec.Body.String())
+	})
+
+	t.Run("Returns_BadRequest_When_Batch_Is_Invalid", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/guests/arrivals", strings.NewReader(`{"arrivals": []}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			ArriveGuests(gomock.Any(), []model.BatchArrival{}).
+			Return(nil, ex.NewBadInputError("no arrivals in the batch")).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.ArriveGuests(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+}
+
+func Test_GuestHandler_UndoStatusChange(t *testing.T) {
+	name := "Flor"
+
+	t.Run("Returns_PreconditionRequired_When_No_IfMatch", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/guests/"+name+"/undo", nil)
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		rec := httptest.NewRecorder()
+
+		mh := NewGuestHandler(service.NewMockIGuestService(gomock.NewController(t)), logging.NewNop())
+
+		err := mh.UndoStatusChange(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, err.Code)
+	})
+
+	t.Run("Returns_BadRequest_When_Nothing_To_Undo", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/guests/"+name+"/undo", nil)
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UndoStatusChange(gomock.Any(), name, 1).
+			Return(nil, ex.NewArrivalStatusError("Guest has no change to undo")).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UndoStatusChange(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_OK_With_ETag_And_Change_Undone", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/guests/"+name+"/undo", nil)
+		req = mux.SetURLVars(req, map[string]string{"name": name})
+		req.Header.Set("If-Match", `"2"`)
+		rec := httptest.NewRecorder()
+
+		arrivedAt := "2026-10-19 21:04:05"
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UndoStatusChange(gomock.Any(), name, 2).
+			Return(&model.StatusChange{
+				ChangeID:   5,
+				GuestID:    1,
+				Name:       name,
+				Before:     model.ArrivalState{ArrivalStatus: model.NotArrived, Accompanying_guests: 2},
+				After:      model.ArrivalState{ArrivalStatus: model.Arrived, Accompanying_guests: 3, ArrivedAt: &arrivedAt},
+				ChangedAt:  arrivedAt,
+				RevertedAt: "2026-10-19 21:06:00",
+				Version:    3,
+			}, nil).
+			Times(1)
+
+		mh := NewGuestHandler(mockService, logging.NewNop())
+
+		err := mh.UndoStatusChange(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		assert.JSONEq(t, `{
+			"change_id": 5, "guest_id": 1, "name": "Flor",
+			"before": {"arrival_status": "not_arrived", "accompanying_guests": 2, "arrived_at": null},
+			"after": {"arrival_status": "arrived", "accompanying_guests": 3, "arrived_at": "2026-10-19 21:04:05"},
+			"changed_at": "2026-10-19 21:04:05", "reverted_at": "2026-10-19 21:06:00", "version": 3
+		}`, rec.Body.String())
+	})
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type HealthHandler struct {
+	service service.IHealthService
+}
+
+func NewHealthHandler(ms service.IHealthService) *HealthHandler {
+	return &HealthHandler{service: ms}
+}
+
+/**
+ * Liveness probe, answers as long as the process is running.
+ * CURL CMD: curl -X GET localhost:3000/healthz
+ */
+func (hh *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	handleHealthReport(w, hh.service.Liveness(r.Context()))
+}
+
+/**
+ * Readiness probe, checks the database connection and schema.
+ * CURL CMD: curl -X GET localhost:3000/readyz
+ */
+func (hh *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	handleHealthReport(w, hh.service.Readiness(r.Context()))
+}
+
+func handleHealthReport(w http.ResponseWriter, report *model.HealthReport) {
+	code := http.StatusOK
+	if report.Status != model.HealthUp {
+		code = http.StatusServiceUnavailable
+	}
+	HandleJsonResponse(w, code, report)
+}
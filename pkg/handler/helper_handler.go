@@ -1,8 +1,16 @@
 package handler
 
 import (
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
 )
 
 func HandleJsonResponse(w http.ResponseWriter, code int, structData interface{}) {
@@ -11,8 +19,92 @@ func HandleJsonResponse(w http.ResponseWriter, code int, structData interface{})
 	json.NewEncoder(w).Encode(structData)
 }
 
+/*
+`HandleCSVResponse` writes the records as a CSV file named filename, which browsers download
+instead of showing.
+*/
+func HandleCSVResponse(w http.ResponseWriter, code int, filename string, records [][]string) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(code)
+	csv.NewWriter(w).WriteAll(records)
+}
+
 func CreateBodyDecoder(r *http.Request) *json.Decoder {
 	decoder := json.NewDecoder(r.Body)
 	decoder.DisallowUnknownFields()
 	return decoder
 }
+
+/*
+`HandleDecodeError` maps an error returned while decoding a body created with
+CreateBodyDecoder to the response sent to the client. Bodies over the limit set by
+the `MaxBodyBytes` middleware are answered with `413 Request Entity Too Large`.
+*/
+func HandleDecodeError(err error) *e.AppError {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return e.ErrorCaseHanding(e.NewPayloadTooLargeError(maxBytesErr.Limit))
+	}
+	return e.ErrorCaseHanding(e.NewBadInputError("Unknown field in body"))
+}
+
+/*
+`SetETag` sets the `ETag` response header to the version of the resource being returned.
+*/
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", fmt.Sprintf("\"%d\"", version))
+}
+
+/*
+`ParseIfMatch` reads the version the client expects the resource to be at from the `If-Match`
+header. The header is required, and `*` matches any version.
+*/
+func ParseIfMatch(r *http.Request) (int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" {
+		return 0, e.NewPreconditionRequiredError("If-Match")
+	}
+	if value == "*" {
+		return service.AnyVersion, nil
+	}
+
+	version, ok := parseETag(value)
+	if !ok {
+		return 0, e.NewBadInputError("If-Match " + value)
+	}
+	return version, nil
+}
+
+/*
+`MatchesIfNoneMatch` tells whether the client already has the given version of the resource,
+according to the `If-None-Match` header, in which case a `304 Not Modified` can be sent.
+*/
+func MatchesIfNoneMatch(r *http.Request, version int) bool {
+	value := r.Header.Get("If-None-Match")
+	if value == "" {
+		return false
+	}
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if v, ok := parseETag(tag); ok && v == version {
+			return true
+		}
+	}
+	return false
+}
+
+func parseETag(tag string) (int, bool) {
+	tag = strings.TrimPrefix(tag, "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, "\"") || !strings.HasSuffix(tag, "\"") {
+		return 0, false
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type NotificationHandler struct {
+	service service.INotificationService
+	logger  *slog.Logger
+}
+
+func NewNotificationHandler(ns service.INotificationService, logger *slog.Logger) *NotificationHandler {
+	return &NotificationHandler{service: ns, logger: logger}
+}
+
+/**
+ * Render the notifications of a campaign for a segment of guests, without sending them.
+ * CURL CMD: curl -X POST localhost:3000/notifications/preview -H 'Content-Type: application/json' -d '{"kind": "reminder", "segment": {"rsvp_status": "invited"}}'
+ */
+func (nh *NotificationHandler) Preview(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams model.Campaign
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	nh.logger.InfoContext(r.Context(), "Previewing notification campaign.", "kind", bodyParams.Kind)
+
+	result, err := nh.service.Preview(r.Context(), &bodyParams)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, result)
+
+	return nil // success
+}
+
+/**
+ * Queue the notifications of a campaign for a segment of guests. They are delivered
+ * in the background, so the answer is 202 Accepted.
+ * CURL CMD: curl -X POST localhost:3000/notifications/campaigns -H 'Content-Type: application/json' -d '{"kind": "invitation", "segment": {"arrival_status": "not_arrived"}}'
+ */
+func (nh *NotificationHandler) StartCampaign(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams model.Campaign
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	result, err := nh.service.StartCampaign(r.Context(), &bodyParams)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusAccepted, result)
+
+	return nil // success
+}
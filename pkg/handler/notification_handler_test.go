@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NotificationHandler_StartCampaign(t *testing.T) {
+
+	t.Run("Returns_Accepted_When_Campaign_Queued", func(t *testing.T) {
+		body := `{"kind": "invitation", "segment": {"arrival_status": "not_arrived"}}`
+		req, _ := http.NewRequest(http.MethodPost, "/notifications/campaigns", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		campaign := &model.Campaign{Kind: model.InvitationNotification, Segment: model.Segment{ArrivalStatus: model.NotArrived}}
+
+		mockService := service.NewMockINotificationService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			StartCampaign(gomock.Any(), campaign).
+			Return(&model.CampaignResult{Kind: model.InvitationNotification, Queued: 4, Skipped: 1}, nil).
+			Times(1)
+
+		nh := NewNotificationHandler(mockService, logging.NewNop())
+
+		err := nh.StartCampaign(rec, req)
+
+		var result model.CampaignResult
+		json.NewDecoder(rec.Body).Decode(&result)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Equal(t, 4, result.Queued)
+		assert.Equal(t, 1, result.Skipped)
+	})
+
+	t.Run("Returns_BadRequest_When_Kind_Is_Unknown", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/notifications/campaigns", strings.NewReader(`{"kind": "farewell"}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockINotificationService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			StartCampaign(gomock.Any(), gomock.Any()).
+			Return(nil, ex.NewBadInputError("farewell")).
+			Times(1)
+
+		nh := NewNotificationHandler(mockService, logging.NewNop())
+
+		err := nh.StartCampaign(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_BadRequest_When_Unknown_Field", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/notifications/campaigns", strings.NewReader(`{"kind": "invitation", "audience": "all"}`))
+		rec := httptest.NewRecorder()
+
+		nh := NewNotificationHandler(service.NewMockINotificationService(gomock.NewController(t)), logging.NewNop())
+
+		err := nh.StartCampaign(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/pass"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type PassHandler struct {
+	service service.IPassService
+	logger  *slog.Logger
+}
+
+func NewPassHandler(ps service.IPassService, logger *slog.Logger) *PassHandler {
+	return &PassHandler{service: ps, logger: logger}
+}
+
+/**
+ * Retrieve the QR-code check-in pass of the guest with the invitation link {token}, as a PNG
+ * image or, with `?format=svg`, as an SVG image.
+ * CURL CMD: curl -X GET "localhost:3000/rsvp/{token}/pass?format=svg"
+ */
+func (ph *PassHandler) GetPass(w http.ResponseWriter, r *http.Request) *e.AppError {
+	token := mux.Vars(r)["token"]
+
+	format, appErr := passFormat(r)
+	if appErr != nil {
+		return appErr
+	}
+
+	ph.logger.InfoContext(r.Context(), "Fetching guest pass.", "format", format)
+
+	guestPass, err := ph.service.GetPass(r.Context(), token)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	return writePass(w, guestPass, format)
+}
+
+/**
+ * Retrieve the QR-code check-in pass of the guest with the id {id} for the staff, as a PNG
+ * image or, with `?format=svg`, as an SVG image.
+ * CURL CMD: curl -X GET "localhost:3000/guests/{id}/pass?format=svg" -H 'X-API-Key: {KEY}'
+ */
+func (ph *PassHandler) GetGuestPass(w http.ResponseWriter, r *http.Request) *e.AppError {
+	guestID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		return e.ErrorCaseHanding(e.NewBadInputError(mux.Vars(r)["id"]))
+	}
+
+	format, appErr := passFormat(r)
+	if appErr != nil {
+		return appErr
+	}
+
+	ph.logger.InfoContext(r.Context(), "Fetching guest pass.", "guest_id", guestID, "format", format)
+
+	guestPass, err := ph.service.GetGuestPass(r.Context(), guestID)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	return writePass(w, guestPass, format)
+}
+
+// Reads the image format of the pass from `?format=`, PNG when missing.
+func passFormat(r *http.Request) (string, *e.AppError) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = pass.FormatPNG
+	}
+	if format != pass.FormatPNG && format != pass.FormatSVG {
+		return "", e.ErrorCaseHanding(e.NewBadInputError(format))
+	}
+	return format, nil
+}
+
+func writePass(w http.ResponseWriter, guestPass *model.GuestPass, format string) *e.AppError {
+	image, contentType, err := pass.Encode(guestPass.Token, format)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(image)
+
+	return nil // success
+}
+
+/**
+ * Check in the guest of a scanned pass, with the entourage they arrive with.
+ * The expected entourage is used when `accompanying_guests` is missing.
+ * CURL CMD: curl -X POST localhost:3000/checkin/scan -H 'Content-Type: application/json' -d '{"token": string, "accompanying_guests": int}'
+ */
+func (ph *PassHandler) Scan(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams model.PassScan
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	guest, err := ph.service.Scan(r.Context(), &bodyParams)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, guest.Version)
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Name          string            `json:"name"`
+		ArrivalStatus model.GuestStatus `json:"arrival_status"`
+	}{Name: guest.Name, ArrivalStatus: guest.ArrivalStatus})
+
+	return nil // success
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PassHandler_GetPass(t *testing.T) {
+
+	t.Run("Returns_SVG_When_Format_Is_SVG", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/rsvp/abc/pass?format=svg", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"token": "abc"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIPassService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetPass(gomock.Any(), "abc").
+			Return(&model.GuestPass{GuestID: 7, Name: "Flor", Token: "7.0.signature"}, nil).
+			Times(1)
+
+		ph := NewPassHandler(mockService, logging.NewNop())
+
+		err := ph.GetPass(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "image/svg+xml", rec.Header().Get("Content-Type"))
+		assert.True(t, bytes.HasPrefix(rec.Body.Bytes(), []byte("<svg")))
+	})
+
+	t.Run("Returns_BadRequest_When_Format_Is_Unknown", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/rsvp/abc/pass?format=gif", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"token": "abc"})
+		rec := httptest.NewRecorder()
+
+		ph := NewPassHandler(service.NewMockIPassService(gomock.NewController(t)), logging.NewNop())
+
+		err := ph.GetPass(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+}
+
+func Test_PassHandler_GetGuestPass(t *testing.T) {
+
+	t.Run("Returns_PNG_By_Default", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/guests/7/pass", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "7"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIPassService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuestPass(gomock.Any(), 7).
+			Return(&model.GuestPass{GuestID: 7, Name: "Flor", Token: "7.0.signature"}, nil).
+			Times(1)
+
+		ph := NewPassHandler(mockService, logging.NewNop())
+
+		err := ph.GetGuestPass(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	})
+
+	t.Run("Returns_NotFound_When_Guest_Does_Not_Exist", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/guests/8/pass", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "8"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIPassService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuestPass(gomock.Any(), 8).
+			Return(nil, ex.NewNotFoundError("8", "id", "guest")).
+			Times(1)
+
+		ph := NewPassHandler(mockService, logging.NewNop())
+
+		err := ph.GetGuestPass(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, err.Code)
+	})
+}
+
+func Test_PassHandler_Scan(t *testing.T) {
+
+	t.Run("Returns_Forbidden_When_Pass_Is_Forged", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/checkin/scan", strings.NewReader(`{"token": "7.forged"}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIPassService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			Scan(gomock.Any(), &model.PassScan{Token: "7.forged"}).
+			Return(nil, ex.NewInvalidPassError()).
+			Times(1)
+
+		ph := NewPassHandler(mockService, logging.NewNop())
+
+		err := ph.Scan(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, err.Code)
+	})
+
+	t.Run("Returns_Conflict_When_Pass_Is_Replayed", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/checkin/scan", strings.NewReader(`{"token": "7.signature"}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIPassService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			Scan(gomock.Any(), gomock.Any()).
+			Return(nil, ex.NewPassAlreadyUsedError("arrived")).
+			Times(1)
+
+		ph := NewPassHandler(mockService, logging.NewNop())
+
+		err := ph.Scan(rec, req)
+
+		assert.Equal(t, http.StatusConflict, err.Code)
+	})
+
+	t.Run("Returns_OK_With_ETag_When_Guest_Checked_In", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/checkin/scan", strings.NewReader(`{"token": "7.signature", "accompanying_guests": 2}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIPassService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			Scan(gomock.Any(), gomock.Any()).
+			Return(&model.Guest{Name: "Flor", ArrivalStatus: model.Arrived, Version: 5}, nil).
+			Times(1)
+
+		ph := NewPassHandler(mockService, logging.NewNop())
+
+		err := ph.Scan(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"5"`, rec.Header().Get("ETag"))
+		assert.Contains(t, rec.Body.String(), `"arrival_status":"arrived"`)
+	})
+}
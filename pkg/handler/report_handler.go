@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+// Formats every report can be exported in.
+const (
+	reportFormatJSON = "json"
+	reportFormatCSV  = "csv"
+)
+
+// Length of the buckets of the arrival timeline when the request doesn't give one.
+const defaultArrivalInterval = 15 * time.Minute
+
+type ReportHandler struct {
+	service service.IReportService
+	logger  *slog.Logger
+}
+
+func NewReportHandler(rs service.IReportService, logger *slog.Logger) *ReportHandler {
+	return &ReportHandler{service: rs, logger: logger}
+}
+
+/**
+ * Retrieve the meal counts per table and diet, for catering.
+ * With `?format=csv`, one row per table and diet.
+ * CURL CMD: curl -X GET "localhost:3000/reports/catering?format=csv"
+ */
+func (rh *ReportHandler) GetCateringReport(w http.ResponseWriter, r *http.Request) *e.AppError {
+	format, appErr := parseReportFormat(r)
+	if appErr != nil {
+		return appErr
+	}
+
+	rh.logger.InfoContext(r.Context(), "Building catering report.", "format", format)
+
+	report, err := rh.service.GetCateringReport(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	if format == reportFormatCSV {
+		records := [][]string{{"table", "diet", "meals"}}
+		for _, table := range report.Tables {
+			for _, diet := range model.DietTypes {
+				records = append(records, []string{strconv.Itoa(table.Table), string(diet), strconv.Itoa(table.Meals[diet])})
+			}
+		}
+		HandleCSVResponse(w, http.StatusOK, "catering.csv", records)
+		return nil // success
+	}
+
+	HandleJsonResponse(w, http.StatusOK, report)
+
+	return nil // success
+}
+
+/**
+ * Retrieve the guests that turned up, bucketed by arrival time. The length of the
+ * buckets is given with `?interval=`, 15 minutes by default.
+ * With `?format=csv`, one row per bucket.
+ * CURL CMD: curl -X GET "localhost:3000/reports/arrivals?interval=30m"
+ */
+func (rh *ReportHandler) GetArrivalTimeline(w http.ResponseWriter, r *http.Request) *e.AppError {
+	format, appErr := parseReportFormat(r)
+	if appErr != nil {
+		return appErr
+	}
+
+	interval := defaultArrivalInterval
+	if value := r.URL.Query().Get("interval"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return e.ErrorCaseHanding(e.NewBadInputError(value))
+		}
+		interval = parsed
+	}
+
+	rh.logger.InfoContext(r.Context(), "Building arrival timeline.", "interval", interval, "format", format)
+
+	timeline, err := rh.service.GetArrivalTimeline(r.Context(), interval)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	if format == reportFormatCSV {
+		records := [][]string{{"start", "end", "guests", "people", "rejected"}}
+		for _, bucket := range timeline.Buckets {
+			records = append(records, []string{bucket.Start, bucket.End, strconv.Itoa(bucket.Guests), strconv.Itoa(bucket.People), strconv.Itoa(bucket.Rejected)})
+		}
+		HandleCSVResponse(w, http.StatusOK, "arrivals.csv", records)
+		return nil // success
+	}
+
+	HandleJsonResponse(w, http.StatusOK, timeline)
+
+	return nil // success
+}
+
+/**
+ * Retrieve the capacity, reserved and occupied seats of each table.
+ * With `?format=csv`, one row per table.
+ * CURL CMD: curl -X GET localhost:3000/reports/tables
+ */
+func (rh *ReportHandler) GetTableUtilization(w http.ResponseWriter, r *http.Request) *e.AppError {
+	format, appErr := parseReportFormat(r)
+	if appErr != nil {
+		return appErr
+	}
+
+	rh.logger.InfoContext(r.Context(), "Building table utilization report.", "format", format)
+
+	report, err := rh.service.GetTableUtilization(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	if format == reportFormatCSV {
+		records := [][]string{{"table", "capacity", "reserved_seats", "occupied_seats", "utilization"}}
+		for _, table := range report.Tables {
+			records = append(records, []string{
+				strconv.Itoa(table.Table),
+				strconv.Itoa(table.Capacity),
+				strconv.Itoa(table.ReservedSeats),
+				strconv.Itoa(table.OccupiedSeats),
+				formatRate(table.Utilization),
+			})
+		}
+		HandleCSVResponse(w, http.StatusOK, "tables.csv", records)
+		return nil // success
+	}
+
+	HandleJsonResponse(w, http.StatusOK, report)
+
+	return nil // success
+}
+
+/**
+ * Retrieve the attendance of the event: how many guests arrived, were rejected or
+ * didn't show up, and how their entourage deviated from what was expected.
+ * With `?format=csv`, one row per figure.
+ * CURL CMD: curl -X GET localhost:3000/reports/attendance
+ */
+func (rh *ReportHandler) GetAttendanceReport(w http.ResponseWriter, r *http.Request) *e.AppError {
+	format, appErr := parseReportFormat(r)
+	if appErr != nil {
+		return appErr
+	}
+
+	rh.logger.InfoContext(r.Context(), "Building attendance report.", "format", format)
+
+	report, err := rh.service.GetAttendanceReport(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	if format == reportFormatCSV {
+		HandleCSVResponse(w, http.StatusOK, "attendance.csv", [][]string{
+			{"metric", "value"},
+			{"guests", strconv.Itoa(report.Guests)},
+			{"not_arrived", strconv.Itoa(report.NotArrived)},
+			{"arrived", strconv.Itoa(report.Arrived)},
+			{"left", strconv.Itoa(report.Left)},
+			{"rejected", strconv.Itoa(report.Rejected)},
+			{"no_shows", strconv.Itoa(report.NoShows)},
+			{"turned_up", strconv.Itoa(report.TurnedUp)},
+			{"rejection_rate", formatRate(report.RejectionRate)},
+			{"no_show_rate", formatRate(report.NoShowRate)},
+			{"average_expected_entourage", formatRate(report.AverageExpectedEntourage)},
+			{"average_entourage", formatRate(report.AverageEntourage)},
+			{"average_entourage_deviation", formatRate(report.AverageEntourageDeviation)},
+		})
+		return nil // success
+	}
+
+	HandleJsonResponse(w, http.StatusOK, report)
+
+	return nil // success
+}
+
+// `parseReportFormat` reads the `format` query parameter, JSON by default.
+func parseReportFormat(r *http.Request) (string, *e.AppError) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		return reportFormatJSON, nil
+	}
+	if format != reportFormatJSON && format != reportFormatCSV {
+		return "", e.ErrorCaseHanding(e.NewBadInputError(format))
+	}
+	return format, nil
+}
+
+// `formatRate` writes a rate or an average, which the service already rounds to 4 decimals.
+func formatRate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReportHandler_GetArrivalTimeline(t *testing.T) {
+	t.Run("Returns_BadRequest_When_Format_Is_Unknown", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/reports/arrivals?format=xml", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		rh := NewReportHandler(service.NewMockIReportService(gomock.NewController(t)), logging.NewNop())
+
+		err := rh.GetArrivalTimeline(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_BadRequest_When_Interval_Is_Not_A_Duration", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/reports/arrivals?interval=quarter", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		rh := NewReportHandler(service.NewMockIReportService(gomock.NewController(t)), logging.NewNop())
+
+		err := rh.GetArrivalTimeline(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_CSV_When_Requested", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/reports/arrivals?interval=30m&format=csv", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIReportService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetArrivalTimeline(gomock.Any(), 30*time.Minute).
+			Return(&model.ArrivalTimeline{
+				Interval: "30m0s",
+				Buckets:  []model.ArrivalBucket{{Start: "2024-12-20 21:00:00", End: "2024-12-20 21:30:00", Guests: 2, People: 5, Rejected: 1}},
+			}, nil).
+			Times(1)
+
+		rh := NewReportHandler(mockService, logging.NewNop())
+
+		err := rh.GetArrivalTimeline(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="arrivals.csv"`, rec.Header().Get("Content-Disposition"))
+		assert.Equal(t, "start,end,guests,people,rejected\n2024-12-20 21:00:00,2024-12-20 21:30:00,2,5,1\n", rec.Body.String())
+	})
+}
+
+func Test_ReportHandler_GetAttendanceReport(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/reports/attendance", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	mockService := service.NewMockIReportService(gomock.NewController(t))
+	mockService.
+		EXPECT().
+		GetAttendanceReport(gomock.Any()).
+		Return(&model.AttendanceReport{Guests: 4, NoShows: 1, NoShowRate: 0.25}, nil).
+		Times(1)
+
+	rh := NewReportHandler(mockService, logging.NewNop())
+
+	err := rh.GetAttendanceReport(rec, req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `"no_show_rate":0.25`)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type RSVPHandler struct {
+	service service.IRSVPService
+	logger  *slog.Logger
+}
+
+func NewRSVPHandler(rs service.IRSVPService, logger *slog.Logger) *RSVPHandler {
+	return &RSVPHandler{service: rs, logger: logger}
+}
+
+/**
+ * Retrieve the invitation with token {token}, as seen by the invited guest.
+ * CURL CMD: curl -X GET localhost:3000/rsvp/{token}
+ */
+func (rh *RSVPHandler) GetInvitation(w http.ResponseWriter, r *http.Request) *e.AppError {
+	token := mux.Vars(r)["token"]
+
+	rh.logger.InfoContext(r.Context(), "Fetching invitation.")
+
+	invitation, err := rh.service.GetInvitation(r.Context(), token)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, invitation)
+
+	return nil // success
+}
+
+/**
+ * Answer the invitation with token {token}, confirming the entourage the guest will bring.
+ * CURL CMD: curl -X POST localhost:3000/rsvp/{token} -H 'Content-Type: application/json' -d '{"status": "accepted", "accompanying_guests": int}'
+ */
+func (rh *RSVPHandler) Respond(w http.ResponseWriter, r *http.Request) *e.AppError {
+	token := mux.Vars(r)["token"]
+
+	var bodyParams model.RSVPResponse
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	rh.logger.InfoContext(r.Context(), "Answering invitation.", "rsvp_status", bodyParams.Status)
+
+	invitation, err := rh.service.Respond(r.Context(), token, &bodyParams)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, invitation)
+
+	return nil // success
+}
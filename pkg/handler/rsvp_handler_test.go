@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RSVPHandler_Respond(t *testing.T) {
+	token := "0123456789abcdef0123456789abcdef"
+
+	t.Run("Returns_OK_When_Invitation_Answered", func(t *testing.T) {
+		body := `{"status": "accepted", "accompanying_guests": 2}`
+		req, _ := http.NewRequest(http.MethodPost, "/rsvp/"+token, strings.NewReader(body))
+		req = mux.SetURLVars(req, map[string]string{"token": token})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIRSVPService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			Respond(gomock.Any(), token, &model.RSVPResponse{Status: model.Accepted, Accompanying_guests: 2}).
+			Return(&model.Invitation{Name: "Flor", Table: 1, Accompanying_guests: 2, RSVPStatus: model.Accepted}, nil).
+			Times(1)
+
+		rh := NewRSVPHandler(mockService, logging.NewNop())
+
+		err := rh.Respond(rec, req)
+
+		var returned model.Invitation
+		json.NewDecoder(rec.Body).Decode(&returned)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, model.Accepted, returned.RSVPStatus)
+		assert.Equal(t, 2, returned.Accompanying_guests)
+	})
+
+	t.Run("Returns_BadRequest_When_Table_Is_Full", func(t *testing.T) {
+		body := `{"status": "accepted", "accompanying_guests": 5}`
+		req, _ := http.NewRequest(http.MethodPost, "/rsvp/"+token, strings.NewReader(body))
+		req = mux.SetURLVars(req, map[string]string{"token": token})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIRSVPService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			Respond(gomock.Any(), token, gomock.Any()).
+			Return(nil, ex.NewExceedsCapacityError(1, 5)).
+			Times(1)
+
+		rh := NewRSVPHandler(mockService, logging.NewNop())
+
+		err := rh.Respond(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_NotFound_When_Token_Is_Unknown", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/rsvp/"+token, http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"token": token})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIRSVPService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(nil, ex.NewNotFoundError(token, "token", "invitation")).
+			Times(1)
+
+		rh := NewRSVPHandler(mockService, logging.NewNop())
+
+		err := rh.GetInvitation(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, err.Code)
+	})
+}
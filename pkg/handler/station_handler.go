@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type StationHandler struct {
+	service service.IStationService
+	logger  *slog.Logger
+}
+
+func NewStationHandler(ss service.IStationService, logger *slog.Logger) *StationHandler {
+	return &StationHandler{service: ss, logger: logger}
+}
+
+/**
+ * Retrieve the stations with the guests that went through each of them.
+ * CURL CMD: curl -X GET localhost:3000/stations
+ */
+func (sh *StationHandler) GetStations(w http.ResponseWriter, r *http.Request) *e.AppError {
+
+	sh.logger.InfoContext(r.Context(), "Fetching stations.")
+
+	stations, err := sh.service.GetStations(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Stations []model.StationThroughput `json:"stations"`
+	}{
+		Stations: stations,
+	})
+
+	return nil // success
+}
+
+/**
+ * Register a station at an entrance of the event.
+ * CURL CMD: curl -X POST localhost:3000/stations -H 'Content-Type: application/json' -d '{"id": "north-door", "name": "North entrance"}'
+ */
+func (sh *StationHandler) CreateStation(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams struct {
+		StationID string `json:"id"`
+		Name      string `json:"name"`
+	}
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	sh.logger.InfoContext(r.Context(), "Creating station.", "station", bodyParams.StationID)
+
+	station, err := sh.service.CreateStation(r.Context(), &model.Station{StationID: bodyParams.StationID, Name: bodyParams.Name})
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, station)
+
+	return nil // success
+}
+
+/**
+ * Retrieve the latest guests let in, turned away or out at the station with id {id}, newest first.
+ * `?limit=` gives how many, 50 by default, and `?before=` the change id to continue from.
+ * CURL CMD: curl -X GET "localhost:3000/stations/{id}/activity?limit=20"
+ */
+func (sh *StationHandler) GetActivity(w http.ResponseWriter, r *http.Request) *e.AppError {
+	id := mux.Vars(r)["id"]
+
+	limit := service.DefaultActivityLimit
+	before := 0
+	for param, value := range map[string]*int{"limit": &limit, "before": &before} {
+		raw := r.URL.Query().Get(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return e.ErrorCaseHanding(e.NewBadInputError(raw))
+		}
+		*value = parsed
+	}
+
+	sh.logger.InfoContext(r.Context(), "Fetching station activity.", "station", id, "limit", limit, "before", before)
+
+	activity, err := sh.service.GetActivity(r.Context(), id, before, limit)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Station  string                  `json:"station"`
+		Activity []model.StationActivity `json:"activity"`
+	}{
+		Station:  id,
+		Activity: activity,
+	})
+
+	return nil // success
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StationHandler_GetActivity(t *testing.T) {
+	t.Run("Returns_OK_With_Activity", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/stations/north-door/activity?limit=20&before=12", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "north-door"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIStationService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetActivity(gomock.Any(), "north-door", 12, 20).
+			Return([]model.StationActivity{{ChangeID: 9, Name: "Flor", ArrivalStatus: model.Arrived, Staff: "Juli"}}, nil).
+			Times(1)
+
+		sh := NewStationHandler(mockService, logging.NewNop())
+
+		err := sh.GetActivity(rec, req)
+
+		var returned struct {
+			Station  string                  `json:"station"`
+			Activity []model.StationActivity `json:"activity"`
+		}
+		json.NewDecoder(rec.Body).Decode(&returned)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "north-door", returned.Station)
+		assert.Equal(t, "Juli", returned.Activity[0].Staff)
+	})
+
+	t.Run("Uses_Default_Limit", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/stations/north-door/activity", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "north-door"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIStationService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetActivity(gomock.Any(), "north-door", 0, service.DefaultActivityLimit).
+			Return([]model.StationActivity{}, nil).
+			Times(1)
+
+		sh := NewStationHandler(mockService, logging.NewNop())
+
+		err := sh.GetActivity(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Returns_BadRequest_When_Limit_Not_A_Number", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/stations/north-door/activity?limit=all", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "north-door"})
+		rec := httptest.NewRecorder()
+
+		sh := NewStationHandler(service.NewMockIStationService(gomock.NewController(t)), logging.NewNop())
+
+		err := sh.GetActivity(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type SyncHandler struct {
+	service service.ISyncService
+	logger  *slog.Logger
+}
+
+func NewSyncHandler(ss service.ISyncService, logger *slog.Logger) *SyncHandler {
+	return &SyncHandler{service: ss, logger: logger}
+}
+
+/**
+ * Apply the check-ins a door station recorded while offline, answering with the outcome of each one:
+ * applied, duplicate or conflict. Operations sent again keep the outcome they had.
+ * CURL CMD: curl -X POST localhost:3000/sync/operations -H 'Content-Type: application/json' -d '{"station": "north-door", "operations": [{"operation_id": "1b4e28ba-2fa1-11d2-883f-0016d3cca427", "name": "Maria", "kind": "arrive", "accompanying_guests": 2, "recorded_at": "2024-12-20 21:05:00"}]}'
+ */
+func (sh *SyncHandler) PushOperations(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams struct {
+		Station    string                `json:"station"`
+		Operations []model.DoorOperation `json:"operations"`
+	}
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	sh.logger.InfoContext(r.Context(), "Syncing door operations.", "station", bodyParams.Station, "operations", len(bodyParams.Operations))
+
+	report, err := sh.service.ApplyOperations(r.Context(), bodyParams.Station, bodyParams.Operations)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, report)
+
+	return nil // success
+}
+
+/**
+ * Retrieve the operations of the door stations that conflicted with the central instance, for review,
+ * or only those of the door station in the `station` query parameter.
+ * CURL CMD: curl -X GET localhost:3000/sync/conflicts?station=north-door
+ */
+func (sh *SyncHandler) GetConflicts(w http.ResponseWriter, r *http.Request) *e.AppError {
+	station := r.URL.Query().Get("station")
+
+	sh.logger.InfoContext(r.Context(), "Fetching sync conflicts.", "station", station)
+
+	conflicts, err := sh.service.GetConflicts(r.Context(), station)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Conflicts []model.SyncConflict `json:"conflicts"`
+	}{
+		Conflicts: conflicts,
+	})
+
+	return nil // success
+}
+
+/**
+ * Retrieve the tables and guests the door stations copy to keep working while offline.
+ * CURL CMD: curl -X GET localhost:3000/sync/snapshot
+ */
+func (sh *SyncHandler) GetSnapshot(w http.ResponseWriter, r *http.Request) *e.AppError {
+
+	sh.logger.InfoContext(r.Context(), "Fetching snapshot for door stations.")
+
+	snapshot, err := sh.service.GetSnapshot(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, snapshot)
+
+	return nil // success
+}
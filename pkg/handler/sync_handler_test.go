@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SyncHandler_PushOperations(t *testing.T) {
+	body := `{"station": "north-door", "operations": [{"operation_id": "1b4e28ba-2fa1-11d2-883f-0016d3cca427", "name": "Flor", "kind": "arrive", "accompanying_guests": 2, "recorded_at": "2024-12-20 21:05:00"}]}`
+	operations := []model.DoorOperation{{OperationID: "1b4e28ba-2fa1-11d2-883f-0016d3cca427", Name: "Flor", Kind: model.ArriveOperation, Accompanying_guests: 2, RecordedAt: "2024-12-20 21:05:00"}}
+
+	t.Run("Returns_OK_With_Report", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/sync/operations", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockISyncService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			ApplyOperations(gomock.Any(), "north-door", operations).
+			Return(&model.SyncReport{Results: []model.SyncResult{{OperationID: "1b4e28ba-2fa1-11d2-883f-0016d3cca427", Result: model.SyncApplied}}, Applied: 1}, nil).
+			Times(1)
+
+		sh := NewSyncHandler(mockService, logging.NewNop())
+
+		err := sh.PushOperations(rec, req)
+
+		var returned model.SyncReport
+		json.NewDecoder(rec.Body).Decode(&returned)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 1, returned.Applied)
+		assert.Equal(t, model.SyncApplied, returned.Results[0].Result)
+	})
+
+	t.Run("Returns_BadRequest_When_Operations_Are_Invalid", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/sync/operations", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockISyncService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			ApplyOperations(gomock.Any(), "north-door", operations).
+			Return(nil, ex.NewBadInputError("operations[0] has an invalid recorded_at")).
+			Times(1)
+
+		sh := NewSyncHandler(mockService, logging.NewNop())
+
+		err := sh.PushOperations(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+}
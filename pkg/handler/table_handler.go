@@ -1,7 +1,7 @@
 package handler
 
 import (
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 
@@ -14,10 +14,11 @@ import (
 
 type EventTableHandler struct {
 	service service.IEventTableService
+	logger  *slog.Logger
 }
 
-func NewEventTableHandler(ms service.IEventTableService) *EventTableHandler {
-	return &EventTableHandler{service: ms}
+func NewEventTableHandler(ms service.IEventTableService, logger *slog.Logger) *EventTableHandler {
+	return &EventTableHandler{service: ms, logger: logger}
 }
 
 /**
@@ -33,10 +34,10 @@ func (th *EventTableHandler) CreateTable(w http.ResponseWriter, r *http.Request)
 	err := decoder.Decode(&eTable)
 
 	if err != nil {
-		return e.ErrorCaseHanding(e.NewBadInputError("Unknown field in body"))
+		return HandleDecodeError(err)
 	}
 
-	pTable, err := th.service.CreateTable(&eTable)
+	pTable, err := th.service.CreateTable(r.Context(), &eTable)
 
 	if err != nil {
 		return e.ErrorCaseHanding(err)
@@ -54,7 +55,8 @@ func (th *EventTableHandler) CreateTable(w http.ResponseWriter, r *http.Request)
 }
 
 /**
- * Fetch a table with the table id.
+ * Fetch a table with the table id, with its version in the ETag header.
+ * Answers 304 Not Modified if the If-None-Match header matches the version.
  * CURL CMD: curl -X GET localhost:3000/tables/{id}'
  */
 func (th *EventTableHandler) GetTable(w http.ResponseWriter, r *http.Request) *e.AppError {
@@ -68,30 +70,112 @@ func (th *EventTableHandler) GetTable(w http.ResponseWriter, r *http.Request) *e
 		return &e.AppError{Error: err, Message: "[ERROR] Table ID is not a number.", Code: http.StatusBadRequest}
 	}
 
-	log.Print("[INFO] Fetching table with ID: ", id)
+	th.logger.InfoContext(r.Context(), "Fetching table.", "table_id", id)
 
-	eTable, err := th.service.GetTable(id)
+	eTable, err := th.service.GetTable(r.Context(), id)
 
 	if err != nil {
+		if appErr := e.CheckTimeoutError(err); appErr != nil {
+			return appErr
+		}
 		return &e.AppError{Error: err, Message: "[ERROR] Fetching table data.", Code: http.StatusBadRequest}
 	}
 
+	SetETag(w, eTable.Version)
+	if MatchesIfNoneMatch(r, eTable.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
 	HandleJsonResponse(w, http.StatusOK, eTable)
 
 	return nil // success
 }
 
+/**
+ * Change the capacity of a table. Requires the If-Match header with the version of the
+ * table the client read, and returns the new version in the ETag header.
+ * CURL CMD: curl -X PUT localhost:3000/tables/{id} -H 'If-Match: "1"' -H 'Content-Type: application/json' -d '{ "capacity": 12 }'
+ */
+func (th *EventTableHandler) UpdateTable(w http.ResponseWriter, r *http.Request) *e.AppError {
+
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+
+	if err != nil {
+		return &e.AppError{Error: err, Message: "[ERROR] Table ID is not a number.", Code: http.StatusBadRequest}
+	}
+
+	version, err := ParseIfMatch(r)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	var bodyParams struct {
+		Capacity int `json:"capacity"`
+	}
+
+	decoder := CreateBodyDecoder(r)
+	err = decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	th.logger.InfoContext(r.Context(), "Updating table.", "table_id", id, "capacity", bodyParams.Capacity)
+
+	eTable, err := th.service.UpdateTable(r.Context(), id, bodyParams.Capacity, version)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, eTable.Version)
+	HandleJsonResponse(w, http.StatusOK, eTable)
+
+	return nil // success
+}
+
+/**
+ * Delete a table of the event. Refused while guests sat at it still hold their seats.
+ * CURL CMD: curl -X DELETE localhost:3000/tables/{id}
+ */
+func (th *EventTableHandler) DeleteTable(w http.ResponseWriter, r *http.Request) *e.AppError {
+
+	params := mux.Vars(r)
+	id, err := strconv.Atoi(params["id"])
+
+	if err != nil {
+		return &e.AppError{Error: err, Message: "[ERROR] Table ID is not a number.", Code: http.StatusBadRequest}
+	}
+
+	th.logger.InfoContext(r.Context(), "Deleting table.", "table_id", id)
+
+	err = th.service.DeleteTable(r.Context(), id)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil // success
+}
+
 /**
  * Fetch all the tables of the event.
  * CURL CMD: curl -X GET localhost:3000/tables'
  */
 func (th *EventTableHandler) GetTables(w http.ResponseWriter, r *http.Request) *e.AppError {
 
-	log.Print("[INFO] Fetching tables...")
+	th.logger.InfoContext(r.Context(), "Fetching tables.")
 
-	retTables, err := th.service.GetTables()
+	retTables, err := th.service.GetTables(r.Context())
 
 	if err != nil {
+		if appErr := e.CheckTimeoutError(err); appErr != nil {
+			return appErr
+		}
 		return &e.AppError{Error: err, Message: "[ERROR] Fetching tables unsuccessful.", Code: http.StatusInternalServerError}
 	}
 
@@ -110,11 +194,14 @@ func (th *EventTableHandler) GetTables(w http.ResponseWriter, r *http.Request) *
  */
 func (th *EventTableHandler) GetEmptySeats(w http.ResponseWriter, r *http.Request) *e.AppError {
 
-	log.Print("[INFO] Empty seats count...")
+	th.logger.InfoContext(r.Context(), "Counting empty seats.")
 
-	freeSeats, err := th.service.GetEmptySeats()
+	freeSeats, err := th.service.GetEmptySeats(r.Context())
 
 	if err != nil {
+		if appErr := e.CheckTimeoutError(err); appErr != nil {
+			return appErr
+		}
 		return &e.AppError{Error: err, Message: "[ERROR] Fetching empty seat count.", Code: http.StatusInternalServerError}
 	}
 
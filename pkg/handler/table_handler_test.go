@@ -1,15 +1,21 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
 	"github.com/fpetrikovich/go-guestlist/pkg/service"
 	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,11 +27,11 @@ func Test_TableHandler_GetTables(t *testing.T) {
 		mockService := service.NewMockIEventTableService(gomock.NewController(t))
 		mockService.
 			EXPECT().
-			GetTables().
+			GetTables(gomock.Any()).
 			Return([]model.EventTable{{TableID: 1, Capacity: 10}}, nil).
 			Times(1)
 
-		mh := NewEventTableHandler(mockService)
+		mh := NewEventTableHandler(mockService, logging.NewNop())
 
 		mh.GetTables(rec, req)
 
@@ -47,14 +53,90 @@ func Test_TableHandler_GetTables(t *testing.T) {
 		mockService := service.NewMockIEventTableService(gomock.NewController(t))
 		mockService.
 			EXPECT().
-			GetTables().
+			GetTables(gomock.Any()).
 			Return([]model.EventTable{{TableID: 1, Capacity: 10}}, errors.New("Error occurred")).
 			Times(1)
 
-		mh := NewEventTableHandler(mockService)
+		mh := NewEventTableHandler(mockService, logging.NewNop())
 
 		err := mh.GetTables(rec, req)
 
 		assert.Equal(t, http.StatusInternalServerError, err.Code)
 	})
+
+	t.Run("Returns_GatewayTimeout_When_Deadline_Exceeded", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/tables", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIEventTableService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetTables(gomock.Any()).
+			Return(nil, fmt.Errorf("fetching tables: %w", context.DeadlineExceeded)).
+			Times(1)
+
+		mh := NewEventTableHandler(mockService, logging.NewNop())
+
+		err := mh.GetTables(rec, req)
+
+		assert.Equal(t, http.StatusGatewayTimeout, err.Code)
+	})
+}
+
+func Test_TableHandler_CreateTable(t *testing.T) {
+	t.Run("Returns_PayloadTooLarge_When_Body_Exceeds_Limit", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/tables", strings.NewReader(`{"capacity": 10000000000000000000}`))
+		rec := httptest.NewRecorder()
+		// as limited by the MaxBodyBytes middleware
+		req.Body = http.MaxBytesReader(rec, req.Body, 16)
+
+		mockService := service.NewMockIEventTableService(gomock.NewController(t))
+
+		mh := NewEventTableHandler(mockService, logging.NewNop())
+
+		err := mh.CreateTable(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, err.Code)
+	})
+}
+
+func Test_TableHandler_DeleteTable(t *testing.T) {
+	t.Run("Returns_NoContent_When_No_Errors", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, "/tables/3", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "3"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIEventTableService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			DeleteTable(gomock.Any(), 3).
+			Return(nil).
+			Times(1)
+
+		mh := NewEventTableHandler(mockService, logging.NewNop())
+
+		err := mh.DeleteTable(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Returns_Conflict_When_Guests_Are_Seated", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, "/tables/3", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "3"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIEventTableService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			DeleteTable(gomock.Any(), 3).
+			Return(ex.NewTableNotEmptyError("3", 2)).
+			Times(1)
+
+		mh := NewEventTableHandler(mockService, logging.NewNop())
+
+		err := mh.DeleteTable(rec, req)
+
+		assert.Equal(t, http.StatusConflict, err.Code)
+	})
 }
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type TagHandler struct {
+	service service.ITagService
+	logger  *slog.Logger
+}
+
+func NewTagHandler(ts service.ITagService, logger *slog.Logger) *TagHandler {
+	return &TagHandler{service: ts, logger: logger}
+}
+
+/**
+ * Retrieve all the tags.
+ * CURL CMD: curl -X GET localhost:3000/tags
+ */
+func (th *TagHandler) GetTags(w http.ResponseWriter, r *http.Request) *e.AppError {
+
+	th.logger.InfoContext(r.Context(), "Fetching tags.")
+
+	tags, err := th.service.GetTags(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Tags []model.Tag `json:"tags"`
+	}{
+		Tags: tags,
+	})
+
+	return nil // success
+}
+
+/**
+ * Retrieve the tag with name {name}, with its version in the ETag header.
+ * Answers 304 Not Modified if the If-None-Match header matches the version.
+ * CURL CMD: curl -X GET localhost:3000/tags/{name}
+ */
+func (th *TagHandler) GetTag(w http.ResponseWriter, r *http.Request) *e.AppError {
+	name := mux.Vars(r)["name"]
+
+	tag, err := th.service.GetTag(r.Context(), name)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, tag.Version)
+	if MatchesIfNoneMatch(r, tag.Version) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	HandleJsonResponse(w, http.StatusOK, tag)
+
+	return nil // success
+}
+
+/**
+ * Create a tag to attach to guests.
+ * CURL CMD: curl -X POST localhost:3000/tags -H 'Content-Type: application/json' -d '{"name": "sponsor", "description": string}'
+ */
+func (th *TagHandler) CreateTag(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams model.TagData
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	th.logger.InfoContext(r.Context(), "Creating tag.", "tag", bodyParams.Name)
+
+	tag, err := th.service.CreateTag(r.Context(), &bodyParams)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, tag.Version)
+
+	HandleJsonResponse(w, http.StatusOK, tag)
+
+	return nil // success
+}
+
+/**
+ * Change the description of a tag. Requires the If-Match header with the version of
+ * the tag the client read, and returns the new version in the ETag header.
+ * CURL CMD: curl -X PUT localhost:3000/tags/{name} -H 'If-Match: "1"' -H 'Content-Type: application/json' -d '{"description": string}'
+ */
+func (th *TagHandler) UpdateTag(w http.ResponseWriter, r *http.Request) *e.AppError {
+	name := mux.Vars(r)["name"]
+
+	version, err := ParseIfMatch(r)
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	var bodyParams struct {
+		Description string `json:"description"`
+	}
+
+	decoder := CreateBodyDecoder(r)
+	err = decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	tag, err := th.service.UpdateTag(r.Context(), name, bodyParams.Description, version)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	SetETag(w, tag.Version)
+
+	HandleJsonResponse(w, http.StatusOK, tag)
+
+	return nil
+}
+
+/**
+ * Delete a tag, detaching it from every guest.
+ * CURL CMD: curl -X DELETE localhost:3000/tags/{name}
+ */
+func (th *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) *e.AppError {
+	name := mux.Vars(r)["name"]
+
+	err := th.service.DeleteTag(r.Context(), name)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+/**
+ * Retrieve the tags of the guest with name {name}.
+ * CURL CMD: curl -X GET localhost:3000/guest_list/{name}/tags
+ */
+func (th *TagHandler) GetGuestTags(w http.ResponseWriter, r *http.Request) *e.AppError {
+	name := mux.Vars(r)["name"]
+
+	th.logger.InfoContext(r.Context(), "Fetching guest tags.", logging.GuestName(name))
+
+	tags, err := th.service.GetGuestTags(r.Context(), name)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Tags []model.Tag `json:"tags"`
+	}{
+		Tags: tags,
+	})
+
+	return nil // success
+}
+
+/**
+ * Attach the tag {tag} to the guest with name {name}, answering with the tags of the guest.
+ * CURL CMD: curl -X PUT localhost:3000/guest_list/{name}/tags/{tag}
+ */
+func (th *TagHandler) AttachTag(w http.ResponseWriter, r *http.Request) *e.AppError {
+	params := mux.Vars(r)
+
+	tags, err := th.service.AttachTag(r.Context(), params["name"], params["tag"])
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Tags []model.Tag `json:"tags"`
+	}{
+		Tags: tags,
+	})
+
+	return nil
+}
+
+/**
+ * Detach the tag {tag} from the guest with name {name}.
+ * CURL CMD: curl -X DELETE localhost:3000/guest_list/{name}/tags/{tag}
+ */
+func (th *TagHandler) DetachTag(w http.ResponseWriter, r *http.Request) *e.AppError {
+	params := mux.Vars(r)
+
+	err := th.service.DetachTag(r.Context(), params["name"], params["tag"])
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TagHandler_CreateTag(t *testing.T) {
+	t.Run("Returns_OK_With_ETag_When_Created", func(t *testing.T) {
+		body := `{"name": "sponsor", "description": "Pays for the drinks"}`
+		req, _ := http.NewRequest(http.MethodPost, "/tags", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockITagService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			CreateTag(gomock.Any(), &model.TagData{Name: "sponsor", Description: "Pays for the drinks"}).
+			Return(&model.Tag{TagID: 5, Name: "sponsor", Description: "Pays for the drinks", Version: 1}, nil).
+			Times(1)
+
+		th := NewTagHandler(mockService, logging.NewNop())
+
+		err := th.CreateTag(rec, req)
+
+		var returned model.Tag
+		json.NewDecoder(rec.Body).Decode(&returned)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"1"`, rec.Header().Get("ETag"))
+		assert.Equal(t, 5, returned.TagID)
+	})
+
+	t.Run("Returns_Conflict_When_Tag_Exists", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/tags", strings.NewReader(`{"name": "vip"}`))
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockITagService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			CreateTag(gomock.Any(), gomock.Any()).
+			Return(nil, ex.NewAlreadyExistsError("vip", "name", "tag")).
+			Times(1)
+
+		th := NewTagHandler(mockService, logging.NewNop())
+
+		err := th.CreateTag(rec, req)
+
+		assert.Equal(t, http.StatusConflict, err.Code)
+	})
+}
+
+func Test_TagHandler_UpdateTag(t *testing.T) {
+	t.Run("Returns_PreconditionRequired_Without_If_Match", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/tags/vip", strings.NewReader(`{"description": "Very important"}`))
+		req = mux.SetURLVars(req, map[string]string{"name": "vip"})
+		rec := httptest.NewRecorder()
+
+		th := NewTagHandler(service.NewMockITagService(gomock.NewController(t)), logging.NewNop())
+
+		err := th.UpdateTag(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, err.Code)
+	})
+
+	t.Run("Returns_OK_With_New_ETag_When_Updated", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/tags/vip", strings.NewReader(`{"description": "Very important"}`))
+		req = mux.SetURLVars(req, map[string]string{"name": "vip"})
+		req.Header.Set("If-Match", `"2"`)
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockITagService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateTag(gomock.Any(), "vip", "Very important", 2).
+			Return(&model.Tag{TagID: 1, Name: "vip", Description: "Very important", Version: 3}, nil).
+			Times(1)
+
+		th := NewTagHandler(mockService, logging.NewNop())
+
+		err := th.UpdateTag(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+	})
+}
+
+func Test_TagHandler_DetachTag(t *testing.T) {
+	t.Run("Returns_NoContent_When_Detached", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, "/guest_list/Flor/tags/vip", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"name": "Flor", "tag": "vip"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockITagService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			DetachTag(gomock.Any(), "Flor", "vip").
+			Return(nil).
+			Times(1)
+
+		th := NewTagHandler(mockService, logging.NewNop())
+
+		err := th.DetachTag(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Returns_NotFound_When_Guest_Doesnt_Have_Tag", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, "/guest_list/Flor/tags/press", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"name": "Flor", "tag": "press"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockITagService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			DetachTag(gomock.Any(), "Flor", "press").
+			Return(ex.NewNotFoundError("press", "name", "tag of guest Flor")).
+			Times(1)
+
+		th := NewTagHandler(mockService, logging.NewNop())
+
+		err := th.DetachTag(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, err.Code)
+	})
+}
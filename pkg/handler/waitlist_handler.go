@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+type WaitlistHandler struct {
+	service service.IWaitlistService
+	logger  *slog.Logger
+}
+
+func NewWaitlistHandler(ws service.IWaitlistService, logger *slog.Logger) *WaitlistHandler {
+	return &WaitlistHandler{service: ws, logger: logger}
+}
+
+/**
+ * Retrieve the parties waiting for seats or holding an offer, in the order seats are offered to them.
+ * CURL CMD: curl -X GET localhost:3000/waitlist
+ */
+func (wh *WaitlistHandler) GetWaitlist(w http.ResponseWriter, r *http.Request) *e.AppError {
+
+	wh.logger.InfoContext(r.Context(), "Fetching waitlist.")
+
+	entries, err := wh.service.GetWaitlist(r.Context())
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, struct {
+		Waitlist []model.WaitlistEntry `json:"waitlist"`
+	}{
+		Waitlist: entries,
+	})
+
+	return nil // success
+}
+
+/**
+ * Add a party to the waitlist.
+ * CURL CMD: curl -X POST localhost:3000/waitlist -H 'Content-Type: application/json' -d '{"name": string, "party_size": int, "email": string, "priority": int}'
+ */
+func (wh *WaitlistHandler) Enqueue(w http.ResponseWriter, r *http.Request) *e.AppError {
+	var bodyParams model.WaitlistRequest
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	wh.logger.InfoContext(r.Context(), "Adding party to waitlist.", logging.GuestName(bodyParams.Name))
+
+	entry, err := wh.service.Enqueue(r.Context(), &bodyParams)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, entry)
+
+	return nil // success
+}
+
+/**
+ * Remove the party with id {id} from the waitlist.
+ * CURL CMD: curl -X DELETE localhost:3000/waitlist/{id}
+ */
+func (wh *WaitlistHandler) Cancel(w http.ResponseWriter, r *http.Request) *e.AppError {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	if err != nil {
+		return &e.AppError{Error: err, Message: "[ERROR] Waitlist entry ID is not a number.", Code: http.StatusBadRequest}
+	}
+
+	err = wh.service.Cancel(r.Context(), id)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+/**
+ * Retrieve the seats offered with token {token}, as seen by the waitlisted party.
+ * CURL CMD: curl -X GET localhost:3000/waitlist/offers/{token}
+ */
+func (wh *WaitlistHandler) GetOffer(w http.ResponseWriter, r *http.Request) *e.AppError {
+	token := mux.Vars(r)["token"]
+
+	wh.logger.InfoContext(r.Context(), "Fetching waitlist offer.")
+
+	entry, err := wh.service.GetOffer(r.Context(), token)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, entry)
+
+	return nil // success
+}
+
+/**
+ * Accept or decline the seats offered with token {token}. Accepting adds the party to the guest list.
+ * CURL CMD: curl -X POST localhost:3000/waitlist/offers/{token} -H 'Content-Type: application/json' -d '{"accept": true}'
+ */
+func (wh *WaitlistHandler) AnswerOffer(w http.ResponseWriter, r *http.Request) *e.AppError {
+	token := mux.Vars(r)["token"]
+
+	var bodyParams model.OfferResponse
+
+	decoder := CreateBodyDecoder(r)
+	err := decoder.Decode(&bodyParams)
+
+	if err != nil {
+		return HandleDecodeError(err)
+	}
+
+	if bodyParams.Accept == nil {
+		return e.ErrorCaseHanding(e.NewBadInputError("accept is required"))
+	}
+
+	wh.logger.InfoContext(r.Context(), "Answering waitlist offer.", "accept", *bodyParams.Accept)
+
+	entry, err := wh.service.AnswerOffer(r.Context(), token, *bodyParams.Accept)
+
+	if err != nil {
+		return e.ErrorCaseHanding(err)
+	}
+
+	HandleJsonResponse(w, http.StatusOK, entry)
+
+	return nil // success
+}
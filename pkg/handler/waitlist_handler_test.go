@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WaitlistHandler_AnswerOffer(t *testing.T) {
+	token := "0123456789abcdef0123456789abcdef"
+
+	t.Run("Returns_BadRequest_When_Answer_Is_Missing", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/waitlist/offers/"+token, strings.NewReader(`{}`))
+		req = mux.SetURLVars(req, map[string]string{"token": token})
+		rec := httptest.NewRecorder()
+
+		wh := NewWaitlistHandler(service.NewMockIWaitlistService(gomock.NewController(t)), logging.NewNop())
+
+		err := wh.AnswerOffer(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, err.Code)
+	})
+
+	t.Run("Returns_OK_When_Accepted", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/waitlist/offers/"+token, strings.NewReader(`{"accept": true}`))
+		req = mux.SetURLVars(req, map[string]string{"token": token})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIWaitlistService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			AnswerOffer(gomock.Any(), token, true).
+			Return(&model.WaitlistEntry{EntryID: 1, Name: "Flor", PartySize: 3, Status: model.WaitlistAccepted, Table: 2}, nil).
+			Times(1)
+
+		wh := NewWaitlistHandler(mockService, logging.NewNop())
+
+		err := wh.AnswerOffer(rec, req)
+
+		var returned model.WaitlistEntry
+		json.NewDecoder(rec.Body).Decode(&returned)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, model.WaitlistAccepted, returned.Status)
+		assert.Equal(t, 2, returned.Table)
+	})
+
+	t.Run("Returns_Conflict_When_Offer_Was_Answered", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/waitlist/offers/"+token, strings.NewReader(`{"accept": false}`))
+		req = mux.SetURLVars(req, map[string]string{"token": token})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIWaitlistService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			AnswerOffer(gomock.Any(), token, false).
+			Return(nil, ex.NewWaitlistStatusError("accepted")).
+			Times(1)
+
+		wh := NewWaitlistHandler(mockService, logging.NewNop())
+
+		err := wh.AnswerOffer(rec, req)
+
+		assert.Equal(t, http.StatusConflict, err.Code)
+	})
+}
+
+func Test_WaitlistHandler_Cancel(t *testing.T) {
+	t.Run("Returns_NoContent_When_Cancelled", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, "/waitlist/4", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "4"})
+		rec := httptest.NewRecorder()
+
+		mockService := service.NewMockIWaitlistService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			Cancel(gomock.Any(), 4).
+			Return(nil).
+			Times(1)
+
+		wh := NewWaitlistHandler(mockService, logging.NewNop())
+
+		err := wh.Cancel(rec, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
@@ -0,0 +1,134 @@
+package logging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+)
+
+// Formats supported by New.
+const (
+	FormatJSON   = "json"
+	FormatLogfmt = "logfmt"
+)
+
+// Keys used across the application for the attributes of a log line.
+const (
+	RequestIDKey = "request_id"
+	GuestNameKey = "guest_name"
+)
+
+type contextKey struct{}
+
+/*
+`WithRequestID` returns a copy of ctx carrying the id of the request being served.
+Loggers created by this package add it to every line logged with that context.
+*/
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+/*
+`RequestID` returns the id of the request stored in ctx, or an empty string if there is none.
+*/
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
+
+/*
+`GuestName` builds the attribute used to log the name of a guest. Names are personal
+data, so when redaction is enabled the value is replaced by a short hash: lines about
+the same guest can still be correlated without the name showing up in the logs.
+*/
+func GuestName(name string) slog.Attr {
+	return slog.String(GuestNameKey, name)
+}
+
+/**
+ * Creates the application logger from the configuration. The output format is JSON
+ * or logfmt, lines below the configured level are dropped, the request id found in
+ * the context is attached to every line and guest names are redacted if enabled.
+ *
+ * @param  w    writer the lines are written to
+ * @param  cfg  pointer to the application Config
+ * @return      pointer to an instance of slog.Logger
+ */
+func New(w io.Writer, cfg *config.Config) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level: ParseLevel(cfg.LogLevel),
+	}
+	if cfg.LogRedactPII {
+		opts.ReplaceAttr = redactGuestNames
+	}
+
+	var handler slog.Handler
+	if cfg.LogFormat == FormatLogfmt {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+/*
+`NewNop` returns a logger that discards every line, for tests and for
+components that are built without a logger.
+*/
+func NewNop() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError + 1}))
+}
+
+/*
+`ParseLevel` maps the level names `debug`, `info`, `warn` and `error` to a slog.Level.
+Unknown names default to info.
+*/
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func redactGuestNames(groups []string, a slog.Attr) slog.Attr {
+	if a.Key != GuestNameKey {
+		return a
+	}
+	sum := sha256.Sum256([]byte(a.Value.String()))
+	return slog.String(GuestNameKey, "redacted:"+hex.EncodeToString(sum[:4]))
+}
+
+/*
+`contextHandler` decorates a slog.Handler adding the request id carried by the
+context of the record, so handlers, services and repositories don't have to.
+*/
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if requestID := RequestID(ctx); requestID != "" {
+		r.AddAttrs(slog.String(RequestIDKey, requestID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
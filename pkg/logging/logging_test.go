@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Logger(t *testing.T) {
+
+	t.Run("Adds_RequestID_From_Context", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, &config.Config{LogLevel: "info", LogFormat: FormatJSON})
+
+		logger.InfoContext(WithRequestID(context.Background(), "abc123"), "Hello.")
+
+		var line map[string]interface{}
+		json.Unmarshal(buf.Bytes(), &line)
+		assert.Equal(t, "abc123", line[RequestIDKey])
+	})
+
+	t.Run("Redacts_Guest_Names_When_Enabled", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, &config.Config{LogLevel: "info", LogFormat: FormatJSON, LogRedactPII: true})
+
+		logger.Info("Fetching guest.", GuestName("Flor"))
+
+		var line map[string]interface{}
+		json.Unmarshal(buf.Bytes(), &line)
+		assert.NotContains(t, buf.String(), "Flor")
+		assert.Contains(t, line[GuestNameKey], "redacted:")
+	})
+
+	t.Run("Keeps_Guest_Names_When_Disabled", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, &config.Config{LogLevel: "info", LogFormat: FormatLogfmt})
+
+		logger.Info("Fetching guest.", GuestName("Flor"))
+
+		assert.Contains(t, buf.String(), "guest_name=Flor")
+	})
+
+	t.Run("Drops_Lines_Below_Level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, &config.Config{LogLevel: "warn", LogFormat: FormatJSON})
+
+		logger.Info("Not logged.")
+
+		assert.Empty(t, buf.String())
+	})
+}
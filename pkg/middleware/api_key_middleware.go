@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+/*
+`RequireAPIKey` only lets through requests sending one of `apiKeys` in the `X-API-Key` header,
+answering the rest with `401 Unauthorized`. It guards the routes meant for the staff rather than
+the guests, so when no keys are given those routes can't be reached at all.
+*/
+func RequireAPIKey(apiKeys []string) func(http.Handler) http.Handler {
+	known := knownKeys(apiKeys)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if apiKey := r.Header.Get(APIKeyHeader); apiKey == "" || !known[apiKey] {
+				w.Header().Set("WWW-Authenticate", APIKeyHeader)
+				http.Error(w, "[ERROR] Missing or unknown API key.", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RequireAPIKey(t *testing.T) {
+	handler := RequireAPIKey([]string{"door-key"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	t.Run("Lets_Known_Key_Through", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/v1/guests/7/pass", http.NoBody)
+		req.Header.Set(APIKeyHeader, "door-key")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Rejects_Unknown_Key", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/v1/guests/7/pass", http.NoBody)
+		req.Header.Set(APIKeyHeader, "made-up")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Rejects_Missing_Key", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/v1/guests/7/pass", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Rejects_Everyone_Without_Keys", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/v1/guests/7/pass", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		RequireAPIKey(nil)(http.NotFoundHandler()).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
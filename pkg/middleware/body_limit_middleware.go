@@ -0,0 +1,16 @@
+package middleware
+
+import "net/http"
+
+/*
+`MaxBodyBytes` caps the request body of the wrapped handler at `limit` bytes. Reading past it
+fails with an `*http.MaxBytesError`, which the handlers answer with `413 Request Entity Too Large`.
+*/
+func MaxBodyBytes(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
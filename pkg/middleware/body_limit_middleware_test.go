@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MaxBodyBytes(t *testing.T) {
+	var readErr error
+	handler := MaxBodyBytes(16)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, readErr = io.ReadAll(r.Body)
+	}))
+
+	t.Run("Reads_Body_Within_Limit", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/v1/tables", strings.NewReader(`{"capacity": 10}`))
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Nil(t, readErr)
+	})
+
+	t.Run("Fails_Reading_Body_Over_Limit", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/v1/tables", strings.NewReader(`{"capacity": 100}`))
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		var maxBytesErr *http.MaxBytesError
+		assert.True(t, errors.As(readErr, &maxBytesErr))
+	})
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+// Headers used to receive the station a request is made at and the staff member making it.
+const (
+	StationHeader = "X-Station-ID"
+	StaffHeader   = "X-Staff"
+)
+
+// Longest station id and staff member accepted, matching the `guest_status_change` table.
+const (
+	maxStationLength = 64
+	maxStaffLength   = 100
+)
+
+/*
+`Checkpoint` stores in the request context the station the request is made at, from the
+`X-Station-ID` header or `defaultStation` when missing, and the staff member making it, from
+the `X-Staff` header. The changes of the arrival of the guests made while serving the request
+are recorded with them. Values longer than the database keeps are answered with `400 Bad Request`.
+*/
+func Checkpoint(defaultStation string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			checkpoint := model.Checkpoint{
+				Station: r.Header.Get(StationHeader),
+				Staff:   r.Header.Get(StaffHeader),
+			}
+			if checkpoint.Station == "" {
+				checkpoint.Station = defaultStation
+			}
+
+			if len(checkpoint.Station) > maxStationLength || len(checkpoint.Staff) > maxStaffLength {
+				appErr := e.ErrorCaseHanding(e.NewBadInputError(fmt.Sprintf("%s or %s header too long", StationHeader, StaffHeader)))
+				http.Error(w, appErr.Message, appErr.Code)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(model.WithCheckpoint(r.Context(), checkpoint)))
+		})
+	}
+}
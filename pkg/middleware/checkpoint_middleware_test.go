@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Checkpoint(t *testing.T) {
+	var checkpoint model.Checkpoint
+	handler := Checkpoint("main-door")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkpoint = model.CheckpointFrom(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	t.Run("Stores_Station_And_Staff", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/v1/guests/Flor", http.NoBody)
+		req.Header.Set(StationHeader, "north-door")
+		req.Header.Set(StaffHeader, "Juli")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, model.Checkpoint{Station: "north-door", Staff: "Juli"}, checkpoint)
+	})
+
+	t.Run("Defaults_Station", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, "/v1/guests/Flor", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, model.Checkpoint{Station: "main-door"}, checkpoint)
+	})
+
+	t.Run("Rejects_Long_Staff", func(t *testing.T) {
+		checkpoint = model.Checkpoint{}
+		req, _ := http.NewRequest(http.MethodPut, "/v1/guests/Flor", http.NoBody)
+		req.Header.Set(StaffHeader, strings.Repeat("a", 101))
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, model.Checkpoint{}, checkpoint)
+	})
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+/*
+`Deprecated` marks the responses of routes that are kept working while clients move to a
+newer version of the API. The routes are served as usual, with the following headers added:
+- `Deprecation`: the time the routes were deprecated at, as `@<unix seconds>`, or `true` when it isn't set.
+- `Sunset`: the time the routes stop being served at, left out when it isn't set.
+- `Link`: the same route in the version replacing them, the path prefixed with successor.
+*/
+func Deprecated(deprecatedAt time.Time, sunset time.Time, successor string) func(http.Handler) http.Handler {
+	deprecation := "true"
+	if !deprecatedAt.IsZero() {
+		deprecation = fmt.Sprintf("@%d", deprecatedAt.Unix())
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			if !sunset.IsZero() {
+				w.Header().Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+			}
+			w.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, successor, r.URL.EscapedPath()))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Deprecated(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	t.Run("Adds_Deprecation_Sunset_And_Successor", func(t *testing.T) {
+		deprecatedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		sunset := time.Date(2027, 3, 31, 23, 0, 0, 0, time.FixedZone("ART", -3*60*60))
+		handler := Deprecated(deprecatedAt, sunset, "/v1")(okHandler)
+
+		req, _ := http.NewRequest(http.MethodPut, "/guests/Mar%C3%ADa", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "@1790812800", rec.Header().Get("Deprecation"))
+		assert.Equal(t, "Thu, 01 Apr 2027 02:00:00 GMT", rec.Header().Get("Sunset"))
+		assert.Equal(t, `</v1/guests/Mar%C3%ADa>; rel="successor-version"`, rec.Header().Get("Link"))
+	})
+
+	t.Run("Leaves_Out_Dates_Not_Set", func(t *testing.T) {
+		handler := Deprecated(time.Time{}, time.Time{}, "/v1")(okHandler)
+
+		req, _ := http.NewRequest(http.MethodGet, "/tables", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+		assert.Empty(t, rec.Header().Values("Sunset"))
+		assert.Equal(t, `</v1/tables>; rel="successor-version"`, rec.Header().Get("Link"))
+	})
+}
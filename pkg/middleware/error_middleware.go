@@ -1,10 +1,14 @@
 package middleware
 
 import (
-	"log"
+	"log/slog"
 	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
 
 	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
 )
 
 /*
@@ -13,15 +17,42 @@ an `*http.Request` as input and returns a pointer to an e.AppError struct.
 */
 type AppHandler func(http.ResponseWriter, *http.Request) *e.AppError
 
+// Path variables holding guest names or the tokens of links, left out of the errors logged.
+var redactedPathVars = []string{"name", "token"}
+
 /*
-`ServeHTTP` takes an `http.ResponseWriter` and an `*http.Request` as input.
-If a non-nil error is returned by calling `fn` with the `http.ResponseWriter` and `*http.Request` as arguments,
-the error's details are logged using the log package and an HTTP error response is sent
-using the `http.Error` function with the error message and code specified in the `AppError` struct.
+`Errors` turns an AppHandler into an http.Handler. If a non-nil error is returned by the handler,
+its code and error are logged with logger and an HTTP error response is sent using the `http.Error`
+function with the error message and code specified in the `AppError` struct.
+Errors often quote the guest name or token of the path, so those are replaced by the name of their
+path variable in the line logged, and the guest name is logged as an attribute redacted with the rest.
 */
-func (fn AppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if e := fn(w, r); e != nil { // e is *AppError, not os.Error.
-		log.Print("[ERROR] ", e.Error)
-		http.Error(w, e.Message, e.Code)
+func Errors(logger *slog.Logger) func(AppHandler) http.Handler {
+	return func(fn AppHandler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			appErr := fn(w, r)
+			if appErr == nil {
+				return
+			}
+
+			vars := mux.Vars(r)
+			detail := appErr.Message
+			if appErr.Error != nil {
+				detail = appErr.Error.Error()
+			}
+			for _, key := range redactedPathVars {
+				if value := vars[key]; value != "" {
+					detail = strings.ReplaceAll(detail, value, "{"+key+"}")
+				}
+			}
+
+			attrs := []any{"status", appErr.Code, "error", detail}
+			if name, ok := vars["name"]; ok {
+				attrs = append(attrs, logging.GuestName(name))
+			}
+			logger.ErrorContext(r.Context(), "Request failed.", attrs...)
+
+			http.Error(w, appErr.Message, appErr.Code)
+		})
 	}
 }
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+)
+
+func Test_Errors(t *testing.T) {
+	t.Run("Logs_Error_Without_Guest_Name", func(t *testing.T) {
+		var out bytes.Buffer
+		logger := logging.New(&out, &config.Config{LogLevel: "info", LogFormat: logging.FormatJSON, LogRedactPII: true})
+		router := mux.NewRouter()
+		router.Handle("/guests/{name}", Errors(logger)(func(w http.ResponseWriter, r *http.Request) *e.AppError {
+			return e.ErrorCaseHanding(e.NewNotFoundError(mux.Vars(r)["name"], "name", "guest"))
+		}))
+
+		req, _ := http.NewRequest(http.MethodGet, "/guests/Florencia", http.NoBody)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Florencia")
+		assert.Contains(t, out.String(), `"status":404`)
+		assert.Contains(t, out.String(), "{name}")
+		assert.NotContains(t, out.String(), "Florencia")
+	})
+
+	t.Run("Logs_Nothing_When_Handler_Succeeds", func(t *testing.T) {
+		var out bytes.Buffer
+		logger := logging.New(&out, &config.Config{LogLevel: "info", LogFormat: logging.FormatJSON})
+		handler := Errors(logger)(func(w http.ResponseWriter, r *http.Request) *e.AppError {
+			return nil
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/tables", http.NoBody)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Empty(t, out.String())
+	})
+}
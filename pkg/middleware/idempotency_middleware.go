@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Header clients send to make a mutating request safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// Header added to responses replayed from the idempotency store.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// Longest idempotency key accepted.
+const maxIdempotencyKeyLength = 255
+
+/*
+The `IdempotentResponse` struct holds the response sent for the first request with a given key,
+so it can be replayed to the client on retries.
+*/
+type IdempotentResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+/*
+The `IdempotencyRecord` struct is what the store keeps for each key.
+
+It includes the following fields:
+- `Fingerprint`: a hash of the route, path variables and body of the first request, used to detect a key reused for a different request.
+- `Response`: the response to replay. It is nil while the first request is still being processed.
+- `ExpiresAt`: when the record is forgotten and the key can be used again.
+*/
+type IdempotencyRecord struct {
+	Fingerprint string
+	Response    *IdempotentResponse
+	ExpiresAt   time.Time
+}
+
+/*
+The `IIdempotencyStore` interface defines where the responses to idempotent requests are kept.
+*/
+type IIdempotencyStore interface {
+	// Records the key as in flight and returns true, or returns the existing record and false.
+	Begin(key string, fingerprint string, ttl time.Duration) (*IdempotencyRecord, bool)
+	// Stores the response of the request that began with the key.
+	Complete(key string, response *IdempotentResponse)
+	// Forgets the key, so the request can be retried from scratch.
+	Release(key string)
+}
+
+/*
+`MemoryIdempotencyStore` is an in-memory implementation of `IIdempotencyStore`.
+Expired records are dropped lazily when their key is used again or the store is swept.
+*/
+type MemoryIdempotencyStore struct {
+	mu        sync.Mutex
+	records   map[string]*IdempotencyRecord
+	lastSweep time.Time
+}
+
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		records:   make(map[string]*IdempotencyRecord),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *MemoryIdempotencyStore) Begin(key string, fingerprint string, ttl time.Duration) (*IdempotencyRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > time.Minute {
+		for k, record := range s.records {
+			if now.After(record.ExpiresAt) {
+				delete(s.records, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	if record, ok := s.records[key]; ok && now.Before(record.ExpiresAt) {
+		return record, false
+	}
+
+	record := &IdempotencyRecord{Fingerprint: fingerprint, ExpiresAt: now.Add(ttl)}
+	s.records[key] = record
+	return record, true
+}
+
+func (s *MemoryIdempotencyStore) Complete(key string, response *IdempotentResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		record.Response = response
+	}
+}
+
+func (s *MemoryIdempotencyStore) Release(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+}
+
+/*
+`Idempotency` makes mutating requests that carry an `Idempotency-Key` header safe to retry.
+The first response sent for a key is stored for `window` and replayed on retries, so a door
+tablet retrying a check-in doesn't run the arrival logic twice. Reusing a key with a different
+route, path variables or body is answered with `422 Unprocessable Entity`, and retrying while the
+first request is still being processed with `409 Conflict`. Each wrapped handler is its own route,
+so a retry is matched whichever version of the path it is sent to. Server errors and panics are
+not stored, so the request can be retried with the same key. Requests without the header are not affected.
+Keys are scoped by client: by the `X-API-Key` header when it is one of `apiKeys`, and by IP address otherwise,
+so a client can't replay the responses of another by sending their key header.
+*/
+func Idempotency(store IIdempotencyStore, window time.Duration, maxBodyBytes int64, apiKeys []string) func(http.Handler) http.Handler {
+	known := knownKeys(apiKeys)
+	var routes atomic.Int64
+	return func(next http.Handler) http.Handler {
+		route := routes.Add(1)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			idempotencyKey := r.Header.Get(IdempotencyKeyHeader)
+			if idempotencyKey == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(idempotencyKey) > maxIdempotencyKeyLength {
+				http.Error(w, "[ERROR] Idempotency-Key is too long.", http.StatusBadRequest)
+				return
+			}
+
+			// Read the body to fingerprint it, then hand a fresh copy to the handler
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					http.Error(w, "[ERROR] Request body is too large.", http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, "[ERROR] Could not read request body.", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			key := clientKey(r, known) + "|" + idempotencyKey
+			fingerprint := requestFingerprint(route, r, body)
+
+			record, created := store.Begin(key, fingerprint, window)
+			if !created {
+				switch {
+				case record.Fingerprint != fingerprint:
+					http.Error(w, "[ERROR] Idempotency-Key was already used for a different request.", http.StatusUnprocessableEntity)
+				case record.Response == nil:
+					http.Error(w, "[ERROR] A request with this Idempotency-Key is still being processed.", http.StatusConflict)
+				default:
+					replayResponse(w, record.Response)
+				}
+				return
+			}
+
+			// A panicking handler sent no response to store, so the key is freed for a retry
+			defer func() {
+				if p := recover(); p != nil {
+					store.Release(key)
+					panic(p)
+				}
+			}()
+
+			recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+
+			if recorder.status >= http.StatusInternalServerError {
+				store.Release(key)
+				return
+			}
+			store.Complete(key, &IdempotentResponse{
+				Status: recorder.status,
+				Header: recorder.Header().Clone(),
+				Body:   recorder.body.Bytes(),
+			})
+		})
+	}
+}
+
+func requestFingerprint(route int64, r *http.Request, body []byte) string {
+	vars := mux.Vars(r)
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%d %s\n", route, r.Method)
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s=%q\n", name, vars[name])
+	}
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replayResponse(w http.ResponseWriter, response *IdempotentResponse) {
+	for name, values := range response.Header {
+		if name == RequestIDHeader {
+			continue // keep the id of the retry
+		}
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.Status)
+	w.Write(response.Body)
+}
+
+// `responseRecorder` copies the response written by the wrapped handler so it can be stored.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(code int) {
+	rr.status = code
+	rr.ResponseWriter.WriteHeader(code)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Idempotency(t *testing.T) {
+	newHandler := func(calls *int) http.Handler {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"call": %d}`, *calls)
+		})
+		return Idempotency(NewMemoryIdempotencyStore(), time.Hour, 1024, nil)(next)
+	}
+
+	send := func(handler http.Handler, key string, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodPut, "/guests/Flor", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Replays_First_Response_When_Retried", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(&calls)
+
+		first := send(handler, "abc", `{"accompanying_guests": 2}`)
+		retry := send(handler, "abc", `{"accompanying_guests": 2}`)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusOK, retry.Code)
+		assert.Equal(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("Returns_UnprocessableEntity_When_Body_Differs", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(&calls)
+
+		send(handler, "abc", `{"accompanying_guests": 2}`)
+		retry := send(handler, "abc", `{"accompanying_guests": 5}`)
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusUnprocessableEntity, retry.Code)
+	})
+
+	t.Run("Scopes_Keys_By_Known_APIKey_Or_IP", func(t *testing.T) {
+		calls := 0
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			fmt.Fprintf(w, `{"call": %d}`, calls)
+		})
+		handler := Idempotency(NewMemoryIdempotencyStore(), time.Hour, 1024, []string{"kiosk-1"})(next)
+
+		sendAs := func(remoteAddr string, apiKey string) string {
+			req, _ := http.NewRequest(http.MethodPut, "/guests/Flor", strings.NewReader(`{}`))
+			req.RemoteAddr = remoteAddr
+			req.Header.Set(IdempotencyKeyHeader, "abc")
+			req.Header.Set(APIKeyHeader, apiKey)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec.Body.String()
+		}
+
+		assert.Equal(t, `{"call": 1}`, sendAs("10.0.0.1:1", "kiosk-1"))
+		// The known key is the client wherever it connects from
+		assert.Equal(t, `{"call": 1}`, sendAs("10.0.0.2:1", "kiosk-1"))
+		// Unknown keys are scoped by IP, so they don't share the namespace of anyone sending the same header
+		assert.Equal(t, `{"call": 2}`, sendAs("10.0.0.3:1", "guessed"))
+		assert.Equal(t, `{"call": 3}`, sendAs("10.0.0.4:1", "guessed"))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Runs_Handler_When_No_Key", func(t *testing.T) {
+		calls := 0
+		handler := newHandler(&calls)
+
+		send(handler, "", `{"accompanying_guests": 2}`)
+		send(handler, "", `{"accompanying_guests": 2}`)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Runs_Handler_Again_When_First_Response_Was_Server_Error", func(t *testing.T) {
+		calls := 0
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusGatewayTimeout)
+		})
+		handler := Idempotency(NewMemoryIdempotencyStore(), time.Hour, 1024, nil)(next)
+
+		send(handler, "abc", `{}`)
+		send(handler, "abc", `{}`)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Runs_Handler_Again_When_First_Request_Panicked", func(t *testing.T) {
+		calls := 0
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				panic("lost the database")
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := Idempotency(NewMemoryIdempotencyStore(), time.Hour, 1024, nil)(next)
+
+		assert.Panics(t, func() { send(handler, "abc", `{}`) })
+		retry := send(handler, "abc", `{}`)
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, http.StatusOK, retry.Code)
+	})
+
+	t.Run("Matches_Retry_On_Any_Version_Of_The_Route", func(t *testing.T) {
+		calls := 0
+		idempotency := Idempotency(NewMemoryIdempotencyStore(), time.Hour, 1024, nil)
+		arrive := idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusOK)
+		}))
+		router := mux.NewRouter()
+		router.Handle("/guests/{name}", arrive).Methods(http.MethodPut)
+		router.Handle("/v1/guests/{name}", arrive).Methods(http.MethodPut)
+		router.Handle("/v2/arrivals/{name}", arrive).Methods(http.MethodPut)
+		router.Handle("/v2/guests/{name}/profile", idempotency(arrive)).Methods(http.MethodPut)
+
+		sendTo := func(path string) int {
+			req, _ := http.NewRequest(http.MethodPut, path, strings.NewReader(`{}`))
+			req.RemoteAddr = "10.0.0.1:1234"
+			req.Header.Set(IdempotencyKeyHeader, "abc")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		assert.Equal(t, http.StatusOK, sendTo("/guests/Flor"))
+		assert.Equal(t, http.StatusOK, sendTo("/v1/guests/Flor"))
+		assert.Equal(t, http.StatusOK, sendTo("/v2/arrivals/Flor"))
+		assert.Equal(t, 1, calls)
+		// Another guest or another route is a different request
+		assert.Equal(t, http.StatusUnprocessableEntity, sendTo("/v2/arrivals/Nico"))
+		assert.Equal(t, http.StatusUnprocessableEntity, sendTo("/v2/guests/Flor/profile"))
+	})
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Header clients can use to identify themselves, e.g. each kiosk with its own key.
+const APIKeyHeader = "X-API-Key"
+
+// Clients not seen for this long are forgotten by the rate limiter.
+const visitorTTL = 10 * time.Minute
+
+// Most clients tracked at once. Past it, new clients share a single bucket until idle ones are forgotten.
+const maxVisitors = 10000
+
+/*
+`RateLimiter` is a token-bucket rate limiter keyed by client. Each client gets its own
+bucket of `burst` tokens refilled at `rps` tokens per second. Clients sending one of
+`apiKeys` in the `X-API-Key` header are keyed by it, the rest by their IP address, so
+made up keys cannot be used to get a fresh bucket.
+*/
+type RateLimiter struct {
+	rps         rate.Limit
+	burst       int
+	apiKeys     map[string]bool
+	mu          sync.Mutex
+	visitors    map[string]*visitor
+	overflow    *rate.Limiter
+	lastCleanup time.Time
+}
+
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func NewRateLimiter(rps float64, burst int, apiKeys []string) *RateLimiter {
+	return &RateLimiter{
+		rps:         rate.Limit(rps),
+		burst:       burst,
+		apiKeys:     knownKeys(apiKeys),
+		visitors:    make(map[string]*visitor),
+		overflow:    rate.NewLimiter(rate.Limit(rps), burst),
+		lastCleanup: time.Now(),
+	}
+}
+
+/*
+`Limit` wraps a handler so requests over the client's limit are answered with
+`429 Too Many Requests` and a `Retry-After` header telling when to try again,
+without reaching the handler or the database.
+*/
+func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reservation := rl.limiter(rl.bucketKey(r)).Reserve()
+
+		if delay := reservation.Delay(); delay > 0 {
+			reservation.Cancel()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, "[ERROR] Too many requests.", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (rl *RateLimiter) limiter(key string) *rate.Limiter {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+
+	// Forget idle clients so the map doesn't grow forever
+	if now.Sub(rl.lastCleanup) > visitorTTL {
+		rl.forgetIdle(now)
+	}
+
+	v, ok := rl.visitors[key]
+	if !ok {
+		// Sweeping is throttled, as clients keep arriving while the map is full
+		if len(rl.visitors) >= maxVisitors && now.Sub(rl.lastCleanup) > time.Minute {
+			rl.forgetIdle(now)
+		}
+		if len(rl.visitors) >= maxVisitors {
+			return rl.overflow
+		}
+		v = &visitor{limiter: rate.NewLimiter(rl.rps, rl.burst)}
+		rl.visitors[key] = v
+	}
+	v.lastSeen = now
+
+	return v.limiter
+}
+
+func (rl *RateLimiter) forgetIdle(now time.Time) {
+	for k, v := range rl.visitors {
+		if now.Sub(v.lastSeen) > visitorTTL {
+			delete(rl.visitors, k)
+		}
+	}
+	rl.lastCleanup = now
+}
+
+// Clients sending a known key get the bucket of the key, the rest the bucket of their IP address.
+func (rl *RateLimiter) bucketKey(r *http.Request) string {
+	return clientKey(r, rl.apiKeys)
+}
+
+func knownKeys(apiKeys []string) map[string]bool {
+	known := make(map[string]bool, len(apiKeys))
+	for _, key := range apiKeys {
+		known[key] = true
+	}
+	return known
+}
+
+// Identifies the client by its key when it is one of apiKeys, and by its IP address otherwise,
+// so sending a made up or someone else's unknown key doesn't make a client pass for another.
+func clientKey(r *http.Request, apiKeys map[string]bool) string {
+	if apiKey := r.Header.Get(APIKeyHeader); apiKeys[apiKey] {
+		return "key:" + apiKey
+	}
+	return "ip:" + clientIP(r)
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return host
+}
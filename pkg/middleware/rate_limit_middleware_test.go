@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RateLimiter_Limit(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("Returns_TooManyRequests_When_Burst_Exhausted", func(t *testing.T) {
+		handler := NewRateLimiter(1, 2, nil).Limit(okHandler)
+
+		codes := make([]int, 3)
+		var retryAfter string
+		for i := range codes {
+			req, _ := http.NewRequest(http.MethodPut, "/guests/Flor", http.NoBody)
+			req.RemoteAddr = "10.0.0.1:1234"
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			codes[i] = rec.Code
+			retryAfter = rec.Header().Get("Retry-After")
+		}
+
+		assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+		assert.Equal(t, "1", retryAfter)
+	})
+
+	t.Run("Keys_Clients_By_APIKey_Then_IP", func(t *testing.T) {
+		handler := NewRateLimiter(1, 1, []string{"kiosk-1", "kiosk-2"}).Limit(okHandler)
+
+		send := func(remoteAddr string, apiKey string) int {
+			req, _ := http.NewRequest(http.MethodGet, "/guest_list", http.NoBody)
+			req.RemoteAddr = remoteAddr
+			if apiKey != "" {
+				req.Header.Set(APIKeyHeader, apiKey)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		assert.Equal(t, http.StatusOK, send("10.0.0.1:1", ""))
+		assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.1:2", ""))
+		// Same IP, but identified kiosks have their own bucket
+		assert.Equal(t, http.StatusOK, send("10.0.0.1:3", "kiosk-1"))
+		assert.Equal(t, http.StatusOK, send("10.0.0.1:4", "kiosk-2"))
+		assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.1:5", "kiosk-1"))
+		// Made up keys don't get a bucket of their own
+		assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.1:6", "random-1"))
+		assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.1:7", "random-2"))
+	})
+
+	t.Run("Shares_A_Bucket_When_Too_Many_Clients", func(t *testing.T) {
+		limiter := NewRateLimiter(1, 1, nil)
+		handler := limiter.Limit(okHandler)
+
+		send := func(remoteAddr string) int {
+			req, _ := http.NewRequest(http.MethodGet, "/guest_list", http.NoBody)
+			req.RemoteAddr = remoteAddr
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		for i := 0; i < maxVisitors; i++ {
+			send(fmt.Sprintf("10.%d.%d.1:1", i/256, i%256))
+		}
+
+		assert.Len(t, limiter.visitors, maxVisitors)
+		assert.Equal(t, http.StatusOK, send("192.168.0.1:1"))
+		assert.Equal(t, http.StatusTooManyRequests, send("192.168.0.2:1"))
+		assert.Len(t, limiter.visitors, maxVisitors)
+	})
+}
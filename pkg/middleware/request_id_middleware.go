@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+)
+
+// Header used to receive and return the id of a request.
+const RequestIDHeader = "X-Request-ID"
+
+// Ids sent by clients are only reused when short and made of characters safe to log and echo back.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+/*
+`RequestID` makes sure every request has an id. The id sent by the client in the
+`X-Request-ID` header is reused when it is at most 128 letters, digits, dots, underscores
+or dashes, otherwise a random one is generated. It is returned in
+the response header and stored in the request context, so every line logged while
+serving the request carries it. Once the request is served an access line is logged,
+with the template of the route matched rather than the path, since paths carry guest
+names and the tokens of the invitation and offer links.
+*/
+func RequestID(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if !validRequestID.MatchString(requestID) {
+				requestID = newRequestID()
+			}
+
+			w.Header().Set(RequestIDHeader, requestID)
+			ctx := logging.WithRequestID(r.Context(), requestID)
+
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r.WithContext(ctx))
+
+			logger.InfoContext(ctx, "Request served.",
+				"method", r.Method,
+				"route", routeTemplate(r),
+				"status", sw.status,
+				"duration_ms", time.Since(start).Milliseconds(),
+			)
+		})
+	}
+}
+
+// The path template of the route matched by the router, used in the logs in place of the path.
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return "unmatched"
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// `statusWriter` records the status code written by the wrapped handler.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (sw *statusWriter) WriteHeader(code int) {
+	sw.status = code
+	sw.ResponseWriter.WriteHeader(code)
+}
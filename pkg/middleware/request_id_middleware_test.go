@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RequestID(t *testing.T) {
+	var requestID string
+	handler := RequestID(logging.NewNop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID = logging.RequestID(r.Context())
+	}))
+
+	t.Run("Reuses_Client_ID", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/v1/tables", http.NoBody)
+		req.Header.Set(RequestIDHeader, "door-7.req_42")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, "door-7.req_42", requestID)
+		assert.Equal(t, "door-7.req_42", rec.Header().Get(RequestIDHeader))
+	})
+
+	for name, sent := range map[string]string{
+		"Missing":            "",
+		"Too_Long":           strings.Repeat("a", 129),
+		"Invalid_Characters": "id\" level=ERROR msg=forged",
+	} {
+		t.Run("Replaces_"+name+"_ID", func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/v1/tables", http.NoBody)
+			req.Header.Set(RequestIDHeader, sent)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.NotEqual(t, sent, requestID)
+			assert.Len(t, requestID, 32)
+			assert.Equal(t, requestID, rec.Header().Get(RequestIDHeader))
+		})
+	}
+}
+
+func Test_RequestID_Logs_Route_Template(t *testing.T) {
+	var out bytes.Buffer
+	router := mux.NewRouter()
+	router.Use(RequestID(logging.New(&out, &config.Config{LogLevel: "info", LogFormat: logging.FormatJSON})))
+	router.HandleFunc("/rsvp/{token}", func(w http.ResponseWriter, r *http.Request) {})
+
+	req, _ := http.NewRequest(http.MethodGet, "/rsvp/secret-token", http.NoBody)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Contains(t, out.String(), `"route":"/rsvp/{token}"`)
+	assert.NotContains(t, out.String(), "secret-token")
+}
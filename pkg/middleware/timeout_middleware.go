@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+/*
+`Timeout` wraps a handler so the request context carries a deadline of `timeout`.
+The context is passed down to the service and repository layers, so queries still
+running when the deadline is hit, or when the client disconnects, are cancelled by
+the MySQL driver instead of holding the handler forever.
+*/
+func Timeout(timeout time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
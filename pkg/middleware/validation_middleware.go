@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+/*
+`ValidationIssue` is a single way in which a request doesn't match the API specification.
+
+It includes the following fields:
+- `In`: where the issue is, one of `path`, `query`, `header` or `body`.
+- `Name`: the name of the parameter, or the JSON pointer of the field for issues in the body.
+- `Reason`: what is wrong with the value.
+*/
+type ValidationIssue struct {
+	In     string `json:"in"`
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Body of the `400 Bad Request` answered to requests that don't match the API specification.
+type ValidationErrorResponse struct {
+	Error  string            `json:"error"`
+	Issues []ValidationIssue `json:"issues"`
+}
+
+/*
+`RequestValidator` checks requests against the OpenAPI specification of the API, so the
+path parameters, query parameters, headers and JSON bodies the handlers receive are the
+ones documented. Requests are never modified, defaults of the specification are left to
+the handlers.
+*/
+type RequestValidator struct {
+	router       routers.Router
+	maxBodyBytes int64
+	logger       *slog.Logger
+}
+
+/*
+`NewRequestValidator` loads the OpenAPI specification in spec, failing if it isn't valid.
+The servers of the specification are ignored, so requests match whatever host the API is
+reached at. Bodies above `maxBodyBytes` are answered with `413 Request Entity Too Large`.
+*/
+func NewRequestValidator(spec []byte, maxBodyBytes int64, logger *slog.Logger) (*RequestValidator, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, err
+	}
+	doc.Servers = nil
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestValidator{router: router, maxBodyBytes: maxBodyBytes, logger: logger}, nil
+}
+
+/*
+`Validate` wraps a handler so requests that don't match the operation of the specification
+are answered with `400 Bad Request` and a `ValidationErrorResponse` listing every issue,
+without reaching the handler. Requests to operations missing from the specification are
+let through. Bodies sent without a `Content-Type` are validated as JSON.
+*/
+func (v *RequestValidator) Validate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Read the body up front, so it can be given back to the handler once validated
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, v.maxBodyBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "[ERROR] Request body is too large.", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "[ERROR] Could not read request body.", http.StatusBadRequest)
+			return
+		}
+
+		validated := r.Clone(r.Context())
+		validated.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) > 0 && validated.Header.Get("Content-Type") == "" {
+			validated.Header.Set("Content-Type", "application/json")
+		}
+
+		err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+			Request:    validated,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			},
+		})
+		if err != nil {
+			issues := validationIssues(err)
+			v.logger.InfoContext(r.Context(), "Request doesn't match the API specification.", "issues", len(issues), "error", err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ValidationErrorResponse{
+				Error:  "[ERROR] Request doesn't match the API specification.",
+				Issues: issues,
+			})
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Flattens the errors returned by the validation into one issue per value that is wrong.
+func validationIssues(err error) []ValidationIssue {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		var issues []ValidationIssue
+		for _, e := range err {
+			issues = append(issues, validationIssues(e)...)
+		}
+		return issues
+	case *openapi3filter.RequestError:
+		if err.Parameter != nil {
+			return []ValidationIssue{{In: err.Parameter.In, Name: err.Parameter.Name, Reason: issueReason(err)}}
+		}
+		// Issues in the body are reported for each field of the body that is wrong
+		if schemaErrs, ok := err.Err.(openapi3.MultiError); ok {
+			var issues []ValidationIssue
+			for _, e := range schemaErrs {
+				issues = append(issues, bodyIssue(e, err))
+			}
+			return issues
+		}
+		return []ValidationIssue{bodyIssue(err.Err, err)}
+	default:
+		return []ValidationIssue{{In: "request", Reason: err.Error()}}
+	}
+}
+
+func bodyIssue(err error, reqErr *openapi3filter.RequestError) ValidationIssue {
+	if schemaErr, ok := err.(*openapi3.SchemaError); ok {
+		return ValidationIssue{In: "body", Name: "/" + strings.Join(schemaErr.JSONPointer(), "/"), Reason: schemaErr.Reason}
+	}
+	return ValidationIssue{In: "body", Reason: issueReason(reqErr)}
+}
+
+func issueReason(reqErr *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if reqErr.Err != nil && reqErr.Reason == "" {
+		return reqErr.Err.Error()
+	}
+	if reqErr.Err != nil {
+		return reqErr.Reason + ": " + reqErr.Err.Error()
+	}
+	return reqErr.Reason
+}
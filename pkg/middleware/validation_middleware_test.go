@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	guestlist "github.com/fpetrikovich/go-guestlist"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+)
+
+func newTestValidator(t *testing.T) *RequestValidator {
+	validator, err := NewRequestValidator(guestlist.APISpec, 1<<10, logging.NewNop())
+	assert.NoError(t, err)
+	return validator
+}
+
+func Test_RequestValidator_Validate(t *testing.T) {
+	validator := newTestValidator(t)
+
+	// Body received by the handler of the last request let through
+	var received string
+	handler := validator.Validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("Lets_Through_Documented_Requests", func(t *testing.T) {
+		requests := []struct {
+			method  string
+			target  string
+			body    string
+			headers map[string]string
+		}{
+			{method: http.MethodGet, target: "/ping"},
+			{method: http.MethodGet, target: "/readyz"},
+			{method: http.MethodGet, target: "/tables"},
+			{method: http.MethodGet, target: "/tables/1", headers: map[string]string{"If-None-Match": `"1"`}},
+			{method: http.MethodPost, target: "/tables", body: `{"capacity": 10}`},
+			{method: http.MethodPut, target: "/tables/1", body: `{"capacity": 12}`, headers: map[string]string{"If-Match": `"1"`}},
+			{method: http.MethodGet, target: "/seats_empty"},
+			{method: http.MethodGet, target: "/guest_list?tag=vip"},
+			{method: http.MethodGet, target: "/guest_list/Ana"},
+			{method: http.MethodPost, target: "/guest_list/Ana", body: `{"table": 1, "accompanying_guests": 2, "email": "ana@example.com"}`, headers: map[string]string{"Idempotency-Key": "create-ana"}},
+			{method: http.MethodPut, target: "/guest_list/Ana/profile", body: `{"email": "ana@example.com", "diet": "vegan", "allergies": "nuts"}`, headers: map[string]string{"If-Match": "*"}},
+			{method: http.MethodPut, target: "/guests/Ana", body: `{"accompanying_guests": 2}`, headers: map[string]string{"If-Match": `"1"`}},
+			{method: http.MethodGet, target: "/guests?tag=vip"},
+			{method: http.MethodDelete, target: "/guests/Ana"},
+			{method: http.MethodPost, target: "/guests/arrivals", body: `{"arrivals": [{"name": "Ana", "accompanying_guests": 2}, {"guest_id": 4, "accompanying_guests": 0}]}`},
+			{method: http.MethodPost, target: "/v2/arrivals", body: `{"arrivals": [{"name": "Ana", "accompanying_guests": 2}]}`},
+			{method: http.MethodPost, target: "/guests/Ana/undo", headers: map[string]string{"If-Match": `"2"`}},
+			{method: http.MethodPost, target: "/v2/arrivals/Ana/undo", headers: map[string]string{"If-Match": `"2"`}},
+			{method: http.MethodGet, target: "/tags"},
+			{method: http.MethodPost, target: "/tags", body: `{"name": "sponsor", "description": "Sponsors of the event"}`},
+			{method: http.MethodPut, target: "/tags/vip", body: `{"description": "Very important"}`, headers: map[string]string{"If-Match": `"1"`}},
+			{method: http.MethodPut, target: "/guest_list/Ana/tags/vip"},
+			{method: http.MethodGet, target: "/waitlist"},
+			{method: http.MethodPost, target: "/waitlist", body: `{"name": "Bob", "party_size": 3, "email": "bob@example.com", "priority": 1}`},
+			{method: http.MethodDelete, target: "/waitlist/4"},
+			{method: http.MethodPost, target: "/waitlist/offers/abc", body: `{"accept": true}`},
+			{method: http.MethodGet, target: "/rsvp/abc/pass?format=svg"},
+			{method: http.MethodPost, target: "/checkin/scan", body: `{"token": "abc", "accompanying_guests": 1}`},
+			{method: http.MethodGet, target: "/reports/catering?format=csv"},
+			{method: http.MethodGet, target: "/reports/arrivals?interval=30m"},
+			{method: http.MethodGet, target: "/reports/attendance?format=json"},
+			{method: http.MethodPost, target: "/notifications/preview", body: `{"kind": "reminder", "segment": {"rsvp_status": "invited"}}`},
+			{method: http.MethodPost, target: "/notifications/campaigns", body: `{"kind": "invitation", "segment": {"arrival_status": "not_arrived"}}`},
+			{method: http.MethodGet, target: "/rsvp/abc"},
+			{method: http.MethodPost, target: "/rsvp/abc", body: `{"status": "accepted", "accompanying_guests": 1}`},
+			{method: http.MethodPost, target: "/graphql", body: `{"query": "{ seatsEmpty }"}`},
+		}
+
+		for _, request := range requests {
+			var body io.Reader = http.NoBody
+			if request.body != "" {
+				body = strings.NewReader(request.body)
+			}
+			req, _ := http.NewRequest(request.method, request.target, body)
+			if request.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			for name, value := range request.headers {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+			received = ""
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code, "%s %s: %s", request.method, request.target, rec.Body.String())
+			assert.Equal(t, request.body, received, "%s %s", request.method, request.target)
+		}
+	})
+
+	t.Run("Validates_Body_Without_Content_Type_As_JSON", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/tables", strings.NewReader(`{"capacity": 10}`))
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, req.Header.Get("Content-Type"))
+	})
+
+	t.Run("Returns_BadRequest_With_Every_Issue", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/waitlist", strings.NewReader(`{"name": 3, "party_size": "three"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var response ValidationErrorResponse
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+		assert.Len(t, response.Issues, 2)
+		for _, issue := range response.Issues {
+			assert.Equal(t, "body", issue.In)
+			assert.Contains(t, []string{"/name", "/party_size"}, issue.Name)
+		}
+	})
+
+	t.Run("Returns_BadRequest_When_Path_Param_Is_Invalid", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/tables/first", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var response ValidationErrorResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		assert.Equal(t, []ValidationIssue{{In: "path", Name: "id", Reason: response.Issues[0].Reason}}, response.Issues)
+	})
+
+	t.Run("Returns_BadRequest_When_Query_Param_Is_Invalid", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/reports/tables?format=xml", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"format"`)
+	})
+
+	t.Run("Returns_PayloadTooLarge_When_Body_Exceeds_Limit", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/tables", strings.NewReader(`{"capacity": 1`+strings.Repeat("0", 2<<10)+`}`))
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("Lets_Through_Undocumented_Routes", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/metrics", http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
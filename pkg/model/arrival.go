@@ -0,0 +1,55 @@
+package model
+
+/*
+The `BatchArrival` struct represents a guest arriving as part of a batch, like a bus of guests let in together.
+
+It contains the following fields:
+- `GuestID`: the unique identifier of the guest. Either the id or the name is required.
+- `Name`: the name of the guest, used when the id is missing.
+- `Accompanying_guests`: the number of guests the guest actually arrives with.
+*/
+type BatchArrival struct {
+	GuestID             int    `json:"guest_id,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Accompanying_guests int    `json:"accompanying_guests"`
+}
+
+type BatchArrivalStatus string
+
+// A constant string type that defines the possible outcomes of a guest of a batch of arrivals.
+const (
+	BatchArrived  BatchArrivalStatus = "arrived"
+	BatchRejected BatchArrivalStatus = "rejected"
+	BatchNotFound BatchArrivalStatus = "not_found"
+)
+
+/*
+The `BatchArrivalResult` struct represents the outcome of a guest of a batch of arrivals.
+
+It includes the following fields:
+- `GuestID`: the unique identifier of the guest, as given when the guest wasn't found.
+- `Name`: the name of the guest, as given when the guest wasn't found.
+- `Status`: whether the guest arrived, was rejected for lack of room or wasn't found.
+- `Accompanying_guests`: the number of guests the guest arrived with.
+- `Version`: the version of the guest after the arrival, zero when the guest wasn't found.
+*/
+type BatchArrivalResult struct {
+	GuestID             int                `json:"guest_id,omitempty"`
+	Name                string             `json:"name,omitempty"`
+	Status              BatchArrivalStatus `json:"status"`
+	Accompanying_guests int                `json:"accompanying_guests"`
+	Version             int                `json:"version,omitempty"`
+}
+
+/*
+The `BatchArrivalReport` struct represents the outcome of a whole batch of arrivals.
+
+It contains the result of each guest, in the order of the batch, along with how many
+guests arrived, were rejected and weren't found.
+*/
+type BatchArrivalReport struct {
+	Results  []BatchArrivalResult `json:"results"`
+	Arrived  int                  `json:"arrived"`
+	Rejected int                  `json:"rejected"`
+	NotFound int                  `json:"not_found"`
+}
@@ -6,6 +6,7 @@ The `EventTable` struct represents a model for a table in an event.
 The struct has the following fields:
 - `TableID`: an integer representing the ID of the table
 - `Capacity`: an integer representing the maximum number of people that can sit at the table
+- `Version`: an integer incremented on every change of the table, used to detect concurrent updates
 - `CreatedAt`: a string representing the date and time when the table was created
 - `UpdatedAt`: a string representing the date and time when the table was last updated
 
@@ -15,6 +16,7 @@ so that when the data is encoded to JSON the keys in the JSON object will match
 type EventTable struct {
 	TableID   int    `json:"id"`
 	Capacity  int    `json:"capacity"`
+	Version   int    `json:"version"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"update_at"`
 }
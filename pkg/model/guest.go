@@ -1,5 +1,7 @@
 package model
 
+import "strings"
+
 type GuestStatus string
 
 // A constant string type that defines the possible arrival statuses of a guest.
@@ -9,10 +11,11 @@ const (
 	Rejected               = "rejected"
 	Left                   = "left"
 	Allocate               = "allocate"
+	NoShow                 = "no_show"
 )
 
 /*
-The `Guest` struct is a model that represents a single guest at an event. 
+The `Guest` struct is a model that represents a single guest at an event.
 
 It includes the following fields:
 - `GuestID`: a unique identifier for the guest.
@@ -20,48 +23,84 @@ It includes the following fields:
 - `Entourage`: the number of guests accompanying the primary guest.
 - `ArrivalStatus`: the status of the guest's arrival, represented as an instance of the GuestStatus type.
 - `ArrivedAt`: the time when the guest arrived, stored as an interface type to accommodate different data types.
+- `RSVPStatus`: the answer of the guest to their invitation, represented as an instance of the RSVPStatus type.
+- `InvitationToken`: the unique token of the guest's invitation link.
+- `Version`: incremented on every change of the guest, used to detect concurrent updates.
+- `PassVersion`: incremented every time the guest arrives, so each check-in pass lets them in once. Not exposed.
 - `UpdateAt`: the time when the guest's information was last updated.
 - `CreatedAt`: the time when the guest's information was created.
+- `Tags`: the names of the tags attached to the guest.
+- `GuestProfile`: the contact details, diet and accessibility needs of the guest.
 
-The json tags on each field are used for marshaling/unmarshaling the data to/from JSON, 
+The json tags on each field are used for marshaling/unmarshaling the data to/from JSON,
 so that when the data is encoded to JSON the keys in the JSON object will match the field names with the tags.
 */
 type Guest struct {
-	GuestID       int         `json:"guest_id"`
-	Name          string      `json:"name"`
-	Entourage     int         `json:"accompanying_guest"`
-	ArrivalStatus GuestStatus `json:"arrival_status"`
-	ArrivedAt     interface{} `json:"arrived_at"`
-	UpdateAt      string      `json:"updated_at"`
-	CreatedAt     string      `json:"created_at"`
+	GuestID         int         `json:"guest_id"`
+	Name            string      `json:"name"`
+	Entourage       int         `json:"accompanying_guest"`
+	ArrivalStatus   GuestStatus `json:"arrival_status"`
+	ArrivedAt       interface{} `json:"arrived_at"`
+	RSVPStatus      RSVPStatus  `json:"rsvp_status"`
+	InvitationToken string      `json:"invitation_token"`
+	Version         int         `json:"version"`
+	PassVersion     int         `json:"-"`
+	UpdateAt        string      `json:"updated_at"`
+	CreatedAt       string      `json:"created_at"`
+	Tags            []string    `json:"tags"`
+	GuestProfile
+}
+
+// Tells whether the guest has the tag with the given name.
+func (g *Guest) HasTag(name string) bool {
+	for _, tag := range g.Tags {
+		if strings.EqualFold(tag, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the arrival time of the guest as `YYYY-MM-DD hh:mm:ss`, empty if they never arrived.
+func (g *Guest) ArrivalTime() string {
+	switch arrivedAt := g.ArrivedAt.(type) {
+	case []byte:
+		return string(arrivedAt)
+	case string:
+		return arrivedAt
+	default:
+		return ""
+	}
 }
 
 /*
-The `GuestData` struct is a model representing data of a guest. 
+The `GuestData` struct is a model representing data of a guest.
 
-It contains three fields:
+It contains four fields:
 - `Name`: A string representing the name of the guest.
 - `Table`: An integer representing the table assigned to the guest.
 - `Accompanying_guests`: An integer representing the number of guests accompanying the main guest.
+- `GuestProfile`: The optional contact details, diet and accessibility needs of the guest.
 
-The json tags on each field are used for marshaling/unmarshaling the data to/from JSON, 
+The json tags on each field are used for marshaling/unmarshaling the data to/from JSON,
 so that when the data is encoded to JSON the keys in the JSON object will match the field names with the tags.
 */
 type GuestData struct {
 	Name                string `json:"name"`
 	Table               int    `json:"table"`
 	Accompanying_guests int    `json:"accompanying_guests"`
+	GuestProfile
 }
 
 /*
-The `GuestArrival` struct represents a model for storing information about a guest's arrival at an event. 
+The `GuestArrival` struct represents a model for storing information about a guest's arrival at an event.
 
 It contains the following fields:
 - `Name`: A string representing the name of the guest.
 - `Accompanying_guests`: An integer representing the number of guests accompanying the main guest.
 - `ArrivedAt`: the time when the guest arrived, stored as an interface type to accommodate different data types.
 
-The json tags on each field are used for marshaling/unmarshaling the data to/from JSON, 
+The json tags on each field are used for marshaling/unmarshaling the data to/from JSON,
 so that when the data is encoded to JSON the keys in the JSON object will match the field names with the tags.
 */
 type GuestArrival struct {
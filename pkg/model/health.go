@@ -0,0 +1,33 @@
+package model
+
+type HealthStatus string
+
+// A constant string type that defines the possible outcomes of a health check.
+const (
+	HealthUp   HealthStatus = "up"
+	HealthDown HealthStatus = "down"
+)
+
+/*
+The `HealthCheck` struct represents the result of checking a single dependency of the application.
+
+It includes the following fields:
+- `Status`: whether the dependency is usable, represented as an instance of the HealthStatus type.
+- `Error`: the reason the check failed, empty when the dependency is up.
+*/
+type HealthCheck struct {
+	Status HealthStatus `json:"status"`
+	Error  string       `json:"error,omitempty"`
+}
+
+/*
+The `HealthReport` struct represents the overall health of the application, as returned by the probes.
+
+It includes the following fields:
+- `Status`: `up` only when every check is up.
+- `Checks`: the result of each individual check, keyed by the name of the check.
+*/
+type HealthReport struct {
+	Status HealthStatus           `json:"status"`
+	Checks map[string]HealthCheck `json:"checks,omitempty"`
+}
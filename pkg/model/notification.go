@@ -0,0 +1,103 @@
+package model
+
+type NotificationKind string
+
+// A constant string type that defines the notifications that can be sent to guests.
+const (
+	InvitationNotification     NotificationKind = "invitation"
+	ReminderNotification       NotificationKind = "reminder"
+	SeatAssignmentNotification NotificationKind = "seat_assignment"
+	WaitlistOfferNotification  NotificationKind = "waitlist_offer"
+)
+
+type NotificationStatus string
+
+// A constant string type that defines the delivery statuses of a notification in the outbox.
+const (
+	NotificationPending NotificationStatus = "pending"
+	NotificationSent    NotificationStatus = "sent"
+	NotificationFailed  NotificationStatus = "failed"
+)
+
+/*
+The `Notification` struct represents an email waiting in the outbox to be delivered.
+
+It includes the following fields:
+- `NotificationID`: a unique identifier for the notification.
+- `GuestID`: the id of the guest the notification is sent to, 0 for parties on the waitlist, which aren't guests yet.
+- `Recipient`: the email address the notification is sent to.
+- `Kind`: what the notification is about, represented as an instance of the NotificationKind type.
+- `Subject`, `TextBody`, `HTMLBody`: the rendered email.
+- `Status`: the delivery status, represented as an instance of the NotificationStatus type.
+- `Attempts`: how many times delivery was attempted.
+*/
+type Notification struct {
+	NotificationID int                `json:"notification_id"`
+	GuestID        int                `json:"guest_id"`
+	Recipient      string             `json:"recipient"`
+	Kind           NotificationKind   `json:"kind"`
+	Subject        string             `json:"subject"`
+	TextBody       string             `json:"text_body"`
+	HTMLBody       string             `json:"html_body"`
+	Status         NotificationStatus `json:"status"`
+	Attempts       int                `json:"attempts"`
+}
+
+/*
+The `Segment` struct selects the guests a campaign is sent to. Every field is optional
+and the guests must match all of the fields that are set.
+
+It contains the following fields:
+- `ArrivalStatus`: only guests with this arrival status, e.g. `not_arrived`.
+- `RSVPStatus`: only guests that gave this answer to their invitation, e.g. `invited`.
+- `Table`: only guests sat at the table with this id.
+*/
+type Segment struct {
+	ArrivalStatus GuestStatus `json:"arrival_status,omitempty"`
+	RSVPStatus    RSVPStatus  `json:"rsvp_status,omitempty"`
+	Table         int         `json:"table,omitempty"`
+}
+
+/*
+The `Campaign` struct represents a notification to send to every guest of a segment.
+*/
+type Campaign struct {
+	Kind    NotificationKind `json:"kind"`
+	Segment Segment          `json:"segment"`
+}
+
+/*
+The `Recipient` struct holds what is needed to render a notification for a guest.
+
+It includes the following fields:
+- `GuestID`, `Name`, `Email`: who the guest is and where to send the notification.
+- `Table`: the table the guest is assigned to.
+- `Accompanying_guests`: the number of guests expected to accompany the guest.
+- `RSVPStatus`: the answer of the guest to their invitation.
+- `InvitationToken`: the token of the guest's invitation link.
+*/
+type Recipient struct {
+	GuestID             int
+	Name                string
+	Email               string
+	Table               int
+	Accompanying_guests int
+	RSVPStatus          RSVPStatus
+	InvitationToken     string
+}
+
+/*
+The `CampaignResult` struct summarizes a campaign.
+
+It contains the following fields:
+- `Kind`: the notification sent.
+- `Queued`: how many notifications were added to the outbox, or would be when previewing.
+- `Skipped`: how many guests of the segment have no email address.
+- `Messages`: the rendered notifications, only filled when previewing.
+*/
+type CampaignResult struct {
+	Kind     NotificationKind `json:"kind"`
+	Queued   int              `json:"queued"`
+	Skipped  int              `json:"skipped"`
+	Messages []Notification   `json:"messages,omitempty"`
+}
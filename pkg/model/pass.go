@@ -0,0 +1,27 @@
+package model
+
+/*
+The `GuestPass` struct represents the check-in pass of a guest.
+
+It includes the following fields:
+- `GuestID`: the unique identifier of the guest the pass belongs to.
+- `Name`: the name of the guest.
+- `Token`: the signed token encoded in the QR code of the pass.
+*/
+type GuestPass struct {
+	GuestID int    `json:"guest_id"`
+	Name    string `json:"name"`
+	Token   string `json:"token"`
+}
+
+/*
+The `PassScan` struct represents a pass scanned at the door.
+
+It contains two fields:
+- `Token`: the token read from the QR code.
+- `Accompanying_guests`: the number of guests the guest arrives with. When missing, the expected entourage is used.
+*/
+type PassScan struct {
+	Token               string `json:"token"`
+	Accompanying_guests *int   `json:"accompanying_guests"`
+}
@@ -0,0 +1,44 @@
+package model
+
+type DietType string
+
+// A constant string type that defines the dietary restrictions catering plans meals for.
+const (
+	DietNone        DietType = "none"
+	DietVegetarian  DietType = "vegetarian"
+	DietVegan       DietType = "vegan"
+	DietPescatarian DietType = "pescatarian"
+	DietGlutenFree  DietType = "gluten_free"
+	DietHalal       DietType = "halal"
+	DietKosher      DietType = "kosher"
+	DietOther       DietType = "other"
+)
+
+// Every DietType, in the order they are reported.
+var DietTypes = []DietType{DietNone, DietVegetarian, DietVegan, DietPescatarian, DietGlutenFree, DietHalal, DietKosher, DietOther}
+
+/*
+The `GuestProfile` struct holds the contact details of a guest and what catering and
+the venue need to know about them. It is embedded in `Guest` and `GuestData`, so its
+fields show up next to theirs in JSON.
+
+It includes the following fields:
+- `Email`: the address invitations and notices are sent to.
+- `Phone`: a phone number to reach the guest.
+- `Diet`: the dietary restriction of the guest, represented as an instance of the DietType type.
+- `DietNotes`: free text describing the diet, required when `Diet` is `other`.
+- `Allergies`: free text listing the allergies of the guest.
+- `AccessibilityNeeds`: free text describing what the guest needs to access the venue.
+- `Notes`: any other notes about the guest.
+
+Every field is optional.
+*/
+type GuestProfile struct {
+	Email              string   `json:"email,omitempty"`
+	Phone              string   `json:"phone,omitempty"`
+	Diet               DietType `json:"diet,omitempty"`
+	DietNotes          string   `json:"diet_notes,omitempty"`
+	Allergies          string   `json:"allergies,omitempty"`
+	AccessibilityNeeds string   `json:"accessibility_needs,omitempty"`
+	Notes              string   `json:"notes,omitempty"`
+}
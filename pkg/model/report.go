@@ -0,0 +1,166 @@
+package model
+
+/*
+The `CateringEntry` struct represents a guest as seen by catering: where they sit,
+how many meals they need and what restrictions apply.
+*/
+type CateringEntry struct {
+	Table               int
+	Name                string
+	Accompanying_guests int
+	Diet                DietType
+	DietNotes           string
+	Allergies           string
+}
+
+/*
+The `GuestNote` struct pairs a guest with a free text note about them, e.g. their allergies.
+*/
+type GuestNote struct {
+	Name string `json:"name"`
+	Note string `json:"note"`
+}
+
+/*
+The `TableCatering` struct holds the meals to serve at a table.
+
+It includes the following fields:
+- `Table`: the id of the table.
+- `Meals`: the number of meals per diet. The entourage of a guest is counted with no dietary restriction.
+- `TotalMeals`: the number of meals served at the table.
+- `DietNotes`: the notes of the guests whose diet needs explaining.
+- `Allergies`: the allergies of the guests of the table.
+*/
+type TableCatering struct {
+	Table      int              `json:"table"`
+	Meals      map[DietType]int `json:"meals"`
+	TotalMeals int              `json:"total_meals"`
+	DietNotes  []GuestNote      `json:"diet_notes"`
+	Allergies  []GuestNote      `json:"allergies"`
+}
+
+/*
+The `CateringReport` struct represents the meals catering has to prepare for the event.
+
+It contains the following fields:
+- `Tables`: the meals of each table, ordered by table id.
+- `Meals`: the number of meals per diet across every table.
+- `TotalMeals`: the number of meals across every table.
+*/
+type CateringReport struct {
+	Tables     []TableCatering  `json:"tables"`
+	Meals      map[DietType]int `json:"meals"`
+	TotalMeals int              `json:"total_meals"`
+}
+
+/*
+The `ArrivalEntry` struct represents a guest that turned up at the door: when, with how
+many people and whether they were let in.
+*/
+type ArrivalEntry struct {
+	ArrivedAt           string
+	ArrivalStatus       GuestStatus
+	Accompanying_guests int
+}
+
+/*
+The `ArrivalBucket` struct counts the guests that turned up during an interval of time.
+
+It includes the following fields:
+- `Start`, `End`: the interval of time, including the start but not the end.
+- `Guests`: the number of guests let in.
+- `People`: the number of people let in, counting the entourage of each guest.
+- `Rejected`: the number of guests turned away because their entourage didn't fit.
+*/
+type ArrivalBucket struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Guests   int    `json:"guests"`
+	People   int    `json:"people"`
+	Rejected int    `json:"rejected"`
+}
+
+/*
+The `ArrivalTimeline` struct represents when guests turned up at the event.
+
+It contains the following fields:
+- `Interval`: the length of each bucket, e.g. `15m0s`.
+- `Buckets`: the arrivals of each interval, from the first to the last arrival, including intervals without arrivals.
+- `Guests`, `People`, `Rejected`: the totals across every bucket.
+*/
+type ArrivalTimeline struct {
+	Interval string          `json:"interval"`
+	Buckets  []ArrivalBucket `json:"buckets"`
+	Guests   int             `json:"guests"`
+	People   int             `json:"people"`
+	Rejected int             `json:"rejected"`
+}
+
+/*
+The `TableUtilization` struct compares the seats of a table with the people using them.
+
+It includes the following fields:
+- `Table`: the id of the table.
+- `Capacity`: the number of seats of the table.
+- `ReservedSeats`: the seats held by guests that are expected or arrived, as counted when seating new guests.
+- `OccupiedSeats`: the seats used by guests that arrived and haven't left.
+- `Utilization`: the share of the capacity occupied, between 0 and 1.
+*/
+type TableUtilization struct {
+	Table         int     `json:"table"`
+	Capacity      int     `json:"capacity"`
+	ReservedSeats int     `json:"reserved_seats"`
+	OccupiedSeats int     `json:"occupied_seats"`
+	Utilization   float64 `json:"utilization"`
+}
+
+/*
+The `TableUtilizationReport` struct holds the utilization of every table, ordered by table id,
+along with the totals of the event.
+*/
+type TableUtilizationReport struct {
+	Tables        []TableUtilization `json:"tables"`
+	Capacity      int                `json:"capacity"`
+	ReservedSeats int                `json:"reserved_seats"`
+	OccupiedSeats int                `json:"occupied_seats"`
+	Utilization   float64            `json:"utilization"`
+}
+
+/*
+The `AttendanceEntry` struct aggregates the guests with an arrival status: how many there
+are, the entourage they brought and the entourage they were expected with.
+*/
+type AttendanceEntry struct {
+	ArrivalStatus     GuestStatus
+	Guests            int
+	Entourage         int
+	ExpectedEntourage int
+}
+
+/*
+The `AttendanceReport` struct represents how many of the guests expected turned up.
+
+It contains the following fields:
+- `Guests`: the number of guests expected, i.e. that didn't decline their invitation.
+- `NotArrived`, `Arrived`, `Left`, `Rejected`, `NoShows`: the number of guests with each arrival status.
+- `TurnedUp`: the number of guests that came to the door, whether they were let in or not.
+- `RejectionRate`: the share of the guests that turned up that were rejected.
+- `NoShowRate`: the share of the guests that turned up or were marked as no-shows that were no-shows.
+- `AverageExpectedEntourage`: the entourage the guests that turned up were expected with, on average.
+- `AverageEntourage`: the entourage the guests that turned up came with, on average.
+- `AverageEntourageDeviation`: the difference between the two, positive when guests brought more people than expected.
+*/
+type AttendanceReport struct {
+	Guests                    int     `json:"guests"`
+	NotArrived                int     `json:"not_arrived"`
+	Arrived                   int     `json:"arrived"`
+	Left                      int     `json:"left"`
+	Rejected                  int     `json:"rejected"`
+	NoShows                   int     `json:"no_shows"`
+	TurnedUp                  int     `json:"turned_up"`
+	RejectionRate             float64 `json:"rejection_rate"`
+	NoShowRate                float64 `json:"no_show_rate"`
+	AverageExpectedEntourage  float64 `json:"average_expected_entourage"`
+	AverageEntourage          float64 `json:"average_entourage"`
+	AverageEntourageDeviation float64 `json:"average_entourage_deviation"`
+}
@@ -0,0 +1,50 @@
+package model
+
+type RSVPStatus string
+
+// A constant string type that defines the possible answers of a guest to their invitation.
+const (
+	Invited   RSVPStatus = "invited"
+	Accepted  RSVPStatus = "accepted"
+	Declined  RSVPStatus = "declined"
+	Tentative RSVPStatus = "tentative"
+)
+
+/*
+The `Invitation` struct represents what a guest sees when opening their invitation link.
+
+It includes the following fields:
+- `GuestID`: the unique identifier of the invited guest.
+- `Name`: the name of the invited guest.
+- `Table`: the table the guest is assigned to.
+- `Accompanying_guests`: the number of guests expected to accompany the invited guest.
+- `ArrivalStatus`: the arrival status of the guest, RSVPs are only possible before arriving.
+- `RSVPStatus`: the answer of the guest to the invitation, represented as an instance of the RSVPStatus type.
+- `VIP`: whether the guest is tagged as VIP, so they can take the seats held back for VIPs.
+- `Version`: the version of the guest, used to detect concurrent updates.
+
+The json tags on each field are used for marshaling/unmarshaling the data to/from JSON,
+so that when the data is encoded to JSON the keys in the JSON object will match the field names with the tags.
+*/
+type Invitation struct {
+	GuestID             int         `json:"-"`
+	Name                string      `json:"name"`
+	Table               int         `json:"table"`
+	Accompanying_guests int         `json:"accompanying_guests"`
+	ArrivalStatus       GuestStatus `json:"-"`
+	RSVPStatus          RSVPStatus  `json:"rsvp_status"`
+	VIP                 bool        `json:"-"`
+	Version             int         `json:"-"`
+}
+
+/*
+The `RSVPResponse` struct represents the answer sent by a guest to their invitation.
+
+It contains two fields:
+- `Status`: the answer, one of accepted, declined or tentative.
+- `Accompanying_guests`: the number of guests that will actually accompany the invited guest.
+*/
+type RSVPResponse struct {
+	Status              RSVPStatus `json:"status"`
+	Accompanying_guests int        `json:"accompanying_guests"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+/*
+The `EventSchedule` struct is a model that represents the timetable of the event.
+
+It includes the following fields:
+- `DoorsOpen`: the time guests start being let in, zero when unknown.
+- `NoShowCutoff`: the time after which guests that haven't arrived are marked as no-shows, zero to never mark them.
+*/
+type EventSchedule struct {
+	DoorsOpen    time.Time
+	NoShowCutoff time.Time
+}
+
+// Tells whether guests that haven't arrived by `now` are no-shows.
+func (s EventSchedule) CutoffPassed(now time.Time) bool {
+	return !s.NoShowCutoff.IsZero() && !now.Before(s.NoShowCutoff)
+}
@@ -0,0 +1,94 @@
+package model
+
+import "context"
+
+/*
+The `Station` struct represents an entrance of the event where guests are let in and out.
+
+It includes the following fields:
+- `StationID`: the unique identifier of the station, such as `north-door`, sent by the devices at the entrance.
+- `Name`: a name for the station shown to people, such as `North entrance`.
+- `CreatedAt`: the time when the station was registered.
+*/
+type Station struct {
+	StationID string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+}
+
+/*
+The `StationThroughput` struct represents how many guests went through a station.
+Changes that were undone aren't counted.
+
+It includes the following fields:
+- `Station`: the station.
+- `Arrivals`: the number of guests let in.
+- `People`: the number of people let in, counting the entourage of each guest.
+- `Departures`: the number of guests that left.
+- `Rejections`: the number of guests turned away because their entourage didn't fit.
+- `ArrivalsLastHour`: the number of guests let in during the last hour.
+- `ArrivalsPerHour`: the guests let in per hour, between the first and the last arrival, over at least an hour.
+- `FirstArrivalAt`, `LastArrivalAt`: the time of the first and last arrival, nil if there were none.
+*/
+type StationThroughput struct {
+	Station
+	Arrivals         int     `json:"arrivals"`
+	People           int     `json:"people"`
+	Departures       int     `json:"departures"`
+	Rejections       int     `json:"rejections"`
+	ArrivalsLastHour int     `json:"arrivals_last_hour"`
+	ArrivalsPerHour  float64 `json:"arrivals_per_hour"`
+	FirstArrivalAt   *string `json:"first_arrival_at"`
+	LastArrivalAt    *string `json:"last_arrival_at"`
+}
+
+/*
+The `StationActivity` struct represents a guest let in, turned away or out at a station.
+
+It includes the following fields:
+- `ChangeID`: the unique identifier of the change of the guest.
+- `GuestID`: the unique identifier of the guest.
+- `Name`: the name of the guest.
+- `ArrivalStatus`: the status the guest changed to: `arrived`, `left` or `rejected`.
+- `Accompanying_guests`: the number of guests accompanying the guest.
+- `Staff`: the staff member who made the change, empty if unknown.
+- `ChangedAt`: the time when the change happened.
+- `RevertedAt`: the time when the change was undone, empty while it stands.
+*/
+type StationActivity struct {
+	ChangeID            int         `json:"change_id"`
+	GuestID             int         `json:"guest_id"`
+	Name                string      `json:"name"`
+	ArrivalStatus       GuestStatus `json:"arrival_status"`
+	Accompanying_guests int         `json:"accompanying_guests"`
+	Staff               string      `json:"staff"`
+	ChangedAt           string      `json:"changed_at"`
+	RevertedAt          string      `json:"reverted_at,omitempty"`
+}
+
+/*
+The `Checkpoint` struct represents where and by whom a change of the arrival of a guest was made.
+Both fields are empty when unknown.
+*/
+type Checkpoint struct {
+	Station string
+	Staff   string
+}
+
+type checkpointKey struct{}
+
+/*
+`WithCheckpoint` returns a copy of ctx carrying the checkpoint of the request being served,
+which is recorded along with every change of the arrival of a guest made with that context.
+*/
+func WithCheckpoint(ctx context.Context, checkpoint Checkpoint) context.Context {
+	return context.WithValue(ctx, checkpointKey{}, checkpoint)
+}
+
+/*
+`CheckpointFrom` returns the checkpoint stored in ctx, or an empty one if there is none.
+*/
+func CheckpointFrom(ctx context.Context) Checkpoint {
+	checkpoint, _ := ctx.Value(checkpointKey{}).(Checkpoint)
+	return checkpoint
+}
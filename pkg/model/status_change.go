@@ -0,0 +1,39 @@
+package model
+
+/*
+The `ArrivalState` struct represents the arrival of a guest at some point in time.
+
+It contains the following fields:
+- `ArrivalStatus`: the status of the guest's arrival.
+- `Accompanying_guests`: the number of guests accompanying the guest.
+- `ArrivedAt`: the time when the guest arrived, nil if they never did.
+*/
+type ArrivalState struct {
+	ArrivalStatus       GuestStatus `json:"arrival_status"`
+	Accompanying_guests int         `json:"accompanying_guests"`
+	ArrivedAt           *string     `json:"arrived_at"`
+}
+
+/*
+The `StatusChange` struct represents a change of the arrival of a guest, recorded so it can be undone.
+
+It includes the following fields:
+- `ChangeID`: a unique identifier for the change.
+- `GuestID`: the unique identifier of the guest changed.
+- `Name`: the name of the guest changed.
+- `Before`: the arrival of the guest before the change.
+- `After`: the arrival of the guest after the change.
+- `ChangedAt`: the time when the change happened.
+- `RevertedAt`: the time when the change was undone, empty while it stands.
+- `Version`: the version of the guest after the change was undone.
+*/
+type StatusChange struct {
+	ChangeID   int          `json:"change_id"`
+	GuestID    int          `json:"guest_id"`
+	Name       string       `json:"name"`
+	Before     ArrivalState `json:"before"`
+	After      ArrivalState `json:"after"`
+	ChangedAt  string       `json:"changed_at"`
+	RevertedAt string       `json:"reverted_at,omitempty"`
+	Version    int          `json:"version,omitempty"`
+}
@@ -0,0 +1,144 @@
+package model
+
+type OperationKind string
+
+// A constant string type that defines the operations a door station records and syncs.
+const (
+	ArriveOperation OperationKind = "arrive"
+	LeaveOperation  OperationKind = "leave"
+)
+
+/*
+The `DoorOperation` struct represents a check-in recorded by a door station, synced to the central instance.
+
+It contains the following fields:
+- `OperationID`: the UUID the door station gave the operation when it recorded it.
+- `Name`: the name of the guest.
+- `Kind`: whether the guest arrived or left.
+- `Accompanying_guests`: the number of guests the guest arrived with, or left with.
+- `RecordedAt`: the time the door station recorded the operation, as `YYYY-MM-DD hh:mm:ss`.
+- `Staff`: the staff member who let the guest in or out, empty if unknown.
+*/
+type DoorOperation struct {
+	OperationID         string        `json:"operation_id"`
+	Name                string        `json:"name"`
+	Kind                OperationKind `json:"kind"`
+	Accompanying_guests int           `json:"accompanying_guests"`
+	RecordedAt          string        `json:"recorded_at"`
+	Staff               string        `json:"staff,omitempty"`
+}
+
+type SyncResultStatus string
+
+// A constant string type that defines the possible outcomes of an operation synced by a door station.
+const (
+	SyncApplied    SyncResultStatus = "applied"
+	SyncDuplicate  SyncResultStatus = "duplicate"
+	SyncConflicted SyncResultStatus = "conflict"
+)
+
+type ConflictKind string
+
+// A constant string type that defines the conflicts reported for review when syncing operations.
+const (
+	GuestNotFoundConflict    ConflictKind = "guest_not_found"
+	DuplicateArrivalConflict ConflictKind = "duplicate_arrival"
+	TableOverbookedConflict  ConflictKind = "table_overbooked"
+	NotArrivedConflict       ConflictKind = "not_arrived"
+)
+
+/*
+The `SyncResult` struct represents the outcome of an operation synced by a door station.
+
+It includes the following fields:
+- `OperationID`: the identifier of the operation at the door station.
+- `Result`: whether the operation was applied, had already been, or conflicted with the central instance.
+- `Conflict`: the kind of conflict, empty unless the result is a conflict.
+- `Detail`: what the conflict was about, for whoever reviews it.
+*/
+type SyncResult struct {
+	OperationID string           `json:"operation_id"`
+	Result      SyncResultStatus `json:"result"`
+	Conflict    ConflictKind     `json:"conflict,omitempty"`
+	Detail      string           `json:"detail,omitempty"`
+}
+
+/*
+The `SyncReport` struct represents the outcome of a batch of operations synced by a door station.
+
+It contains the result of each operation, in the order of the batch, along with how many
+operations were applied, were duplicates and conflicted.
+*/
+type SyncReport struct {
+	Results    []SyncResult `json:"results"`
+	Applied    int          `json:"applied"`
+	Duplicates int          `json:"duplicates"`
+	Conflicts  int          `json:"conflicts"`
+}
+
+/*
+The `SyncConflict` struct represents an operation of a door station that conflicted with the central instance, kept for review.
+
+It includes the following fields:
+- `Station`: the door station that recorded the operation.
+- `DoorOperation`: the operation, as the door station recorded it.
+- `Conflict`: the kind of conflict.
+- `Detail`: what the conflict was about.
+- `SyncedAt`: the time the operation reached the central instance.
+*/
+type SyncConflict struct {
+	Station string `json:"station"`
+	DoorOperation
+	Conflict ConflictKind `json:"conflict"`
+	Detail   string       `json:"detail"`
+	SyncedAt string       `json:"synced_at"`
+}
+
+/*
+The `SnapshotGuest` struct represents the state of a guest the door stations copy from the central instance.
+
+It includes the following fields:
+- `GuestID`: the unique identifier of the guest.
+- `Name`: the name of the guest.
+- `Table`: the table the guest is seated at, zero when they have none.
+- `Accompanying_guests`: the number of guests accompanying the guest.
+- `ExpectedEntourage`: the entourage the guest had before arriving, nil if they never arrived.
+- `ArrivalStatus`: the status of the guest's arrival.
+- `ArrivedAt`: the time when the guest arrived, nil if they never did.
+- `RSVPStatus`: the answer of the guest to their invitation.
+- `RSVPAt`: the time when the guest answered their invitation, nil if they never did.
+- `InvitationToken`: the unique token of the guest's invitation link.
+- `Version`: the version of the guest.
+- `PassVersion`: the version of the check-in pass of the guest, so passes used at the central instance are rejected at the door.
+- `CreatedAt`: the time when the guest was added at the central instance.
+- `Tags`: the names of the tags attached to the guest.
+- `GuestProfile`: the contact details, diet and accessibility needs of the guest.
+
+Every column of the guest is copied but `updated_at`, which the door station sets when it writes the guest.
+*/
+type SnapshotGuest struct {
+	GuestID             int         `json:"guest_id"`
+	Name                string      `json:"name"`
+	Table               int         `json:"table"`
+	Accompanying_guests int         `json:"accompanying_guests"`
+	ExpectedEntourage   *int        `json:"expected_entourage"`
+	ArrivalStatus       GuestStatus `json:"arrival_status"`
+	ArrivedAt           *string     `json:"arrived_at"`
+	RSVPStatus          RSVPStatus  `json:"rsvp_status"`
+	RSVPAt              *string     `json:"rsvp_at"`
+	InvitationToken     string      `json:"invitation_token"`
+	Version             int         `json:"version"`
+	PassVersion         int         `json:"pass_version"`
+	CreatedAt           string      `json:"created_at"`
+	Tags                []string    `json:"tags"`
+	GuestProfile
+}
+
+/*
+The `Snapshot` struct represents the tables and guests of the central instance at a point in time,
+which the door stations replace their own copy with.
+*/
+type Snapshot struct {
+	Tables []EventTable    `json:"tables"`
+	Guests []SnapshotGuest `json:"guests"`
+}
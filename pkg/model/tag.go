@@ -0,0 +1,39 @@
+package model
+
+// Name of the tag that lets a guest use the seats held back for VIPs.
+const VIPTag = "vip"
+
+/*
+The `Tag` struct represents a label that can be attached to guests, such as VIP, press or staff.
+
+The struct has the following fields:
+- `TagID`: a unique identifier for the tag.
+- `Name`: the unique name of the tag, in lower case.
+- `Description`: an optional description of what the tag is used for.
+- `Version`: incremented on every change of the tag, used to detect concurrent updates.
+- `CreatedAt`: the time when the tag was created.
+- `UpdatedAt`: the time when the tag was last updated.
+
+The json tags on each field are used for marshaling/unmarshaling the data to/from JSON,
+so that when the data is encoded to JSON the keys in the JSON object will match the field names with the tags.
+*/
+type Tag struct {
+	TagID       int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Version     int    `json:"version"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+/*
+The `TagData` struct is the body of the requests that create or update a tag.
+
+It contains the following fields:
+- `Name`: the name of the tag, only read when creating it.
+- `Description`: an optional description of what the tag is used for.
+*/
+type TagData struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
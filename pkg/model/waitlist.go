@@ -0,0 +1,72 @@
+package model
+
+type WaitlistStatus string
+
+// A constant string type that defines the possible statuses of a party on the waitlist.
+const (
+	WaitlistWaiting   WaitlistStatus = "waiting"
+	WaitlistOffered   WaitlistStatus = "offered"
+	WaitlistAccepted  WaitlistStatus = "accepted"
+	WaitlistDeclined  WaitlistStatus = "declined"
+	WaitlistExpired   WaitlistStatus = "expired"
+	WaitlistCancelled WaitlistStatus = "cancelled"
+)
+
+/*
+The `WaitlistEntry` struct represents a party waiting for seats to free up.
+
+It includes the following fields:
+- `EntryID`: a unique identifier for the entry.
+- `Name`: the name the guest will be added to the guest list with.
+- `Email`: the optional address the offer of seats is sent to.
+- `PartySize`: the number of seats requested, counting the guest and their entourage.
+- `Priority`: parties with a higher priority are offered seats first, then the oldest entries.
+- `Status`: where the party is in the waitlist, represented as an instance of the WaitlistStatus type.
+- `Table`: the table whose seats were offered to the party, 0 when no offer was made.
+- `OfferToken`: the unique token of the link to answer the offer, only known to the party.
+- `OfferExpiresAt`: the time until which the offer can be accepted.
+- `Version`: incremented on every change of the entry, used to detect concurrent updates.
+- `CreatedAt`: the time when the party joined the waitlist.
+- `UpdatedAt`: the time when the entry was last updated.
+
+The json tags on each field are used for marshaling/unmarshaling the data to/from JSON,
+so that when the data is encoded to JSON the keys in the JSON object will match the field names with the tags.
+*/
+type WaitlistEntry struct {
+	EntryID        int            `json:"id"`
+	Name           string         `json:"name"`
+	Email          string         `json:"email,omitempty"`
+	PartySize      int            `json:"party_size"`
+	Priority       int            `json:"priority"`
+	Status         WaitlistStatus `json:"status"`
+	Table          int            `json:"table,omitempty"`
+	OfferToken     string         `json:"-"`
+	OfferExpiresAt string         `json:"offer_expires_at,omitempty"`
+	Version        int            `json:"version"`
+	CreatedAt      string         `json:"created_at"`
+	UpdatedAt      string         `json:"updated_at"`
+}
+
+/*
+The `WaitlistRequest` struct is the body of the request that adds a party to the waitlist.
+
+It contains the following fields:
+- `Name`: the name of the guest.
+- `Email`: the optional address the offer of seats is sent to.
+- `PartySize`: the number of seats requested, counting the guest and their entourage.
+- `Priority`: optional, parties with a higher priority are offered seats first.
+*/
+type WaitlistRequest struct {
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	PartySize int    `json:"party_size"`
+	Priority  int    `json:"priority"`
+}
+
+/*
+The `OfferResponse` struct is the answer of a party to the seats offered to them.
+`Accept` is a pointer so a missing answer can be told apart from a declined offer.
+*/
+type OfferResponse struct {
+	Accept *bool `json:"accept"`
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/notification/sender.go
+
+// Package notification is a generated GoMock package.
+package notification
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISender is a mock of ISender interface.
+type MockISender struct {
+	ctrl     *gomock.Controller
+	recorder *MockISenderMockRecorder
+}
+
+// MockISenderMockRecorder is the mock recorder for MockISender.
+type MockISenderMockRecorder struct {
+	mock *MockISender
+}
+
+// NewMockISender creates a new mock instance.
+func NewMockISender(ctrl *gomock.Controller) *MockISender {
+	mock := &MockISender{ctrl: ctrl}
+	mock.recorder = &MockISenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISender) EXPECT() *MockISenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockISender) Send(ctx context.Context, email *Email) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockISenderMockRecorder) Send(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockISender)(nil).Send), ctx, email)
+}
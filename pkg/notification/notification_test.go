@@ -0,0 +1,117 @@
+package notification
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Renderer_Render(t *testing.T) {
+	renderer, err := NewRenderer()
+	assert.Nil(t, err)
+
+	data := &TemplateData{
+		EventName:           "End of Year Party",
+		Name:                "<Flor>",
+		Table:               3,
+		Accompanying_guests: 2,
+		RSVPStatus:          model.Invited,
+		RSVPLink:            "http://localhost:3000/rsvp/token",
+	}
+
+	t.Run("Renders_Every_Kind", func(t *testing.T) {
+		for _, kind := range Kinds {
+			message, err := renderer.Render(kind, data)
+
+			assert.Nil(t, err, kind)
+			assert.Contains(t, message.Subject, "End of Year Party", kind)
+			assert.Contains(t, message.TextBody, "<Flor>", kind)
+			assert.Contains(t, message.HTMLBody, "&lt;Flor&gt;", kind)
+		}
+	})
+
+	t.Run("Includes_RSVP_Link_In_Invitation", func(t *testing.T) {
+		message, _ := renderer.Render(model.InvitationNotification, data)
+
+		assert.Contains(t, message.TextBody, data.RSVPLink)
+		assert.Contains(t, message.HTMLBody, `href="http://localhost:3000/rsvp/token"`)
+	})
+
+	t.Run("Returns_Error_When_Kind_Is_Unknown", func(t *testing.T) {
+		_, err := renderer.Render("farewell", data)
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_SMTPSender_Send(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go serveOneSMTPSession(listener, received)
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	sender, err := NewSMTPSender(SMTPConfig{Host: host, Port: port, From: "Guest List <guestlist@example.com>"})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = sender.Send(ctx, &Email{
+		To:      "flor@example.com",
+		Message: Message{Subject: "You're invited", TextBody: "Hi Flor\n", HTMLBody: "<p>Hi Flor</p>\n"},
+	})
+	assert.Nil(t, err)
+
+	data := <-received
+	assert.Contains(t, data, "MAIL FROM:<guestlist@example.com>")
+	assert.Contains(t, data, "RCPT TO:<flor@example.com>")
+	assert.Contains(t, data, "Subject: You're invited")
+	assert.Contains(t, data, "Content-Type: multipart/alternative")
+	assert.Contains(t, data, "<p>Hi Flor</p>")
+}
+
+// `serveOneSMTPSession` plays the server side of a single SMTP conversation and reports what the client sent.
+func serveOneSMTPSession(listener net.Listener, received chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	var transcript strings.Builder
+
+	text.PrintfLine("220 localhost ESMTP test")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			break
+		}
+		transcript.WriteString(line + "\n")
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			text.PrintfLine("250 localhost")
+		case "DATA":
+			text.PrintfLine("354 end with <CRLF>.<CRLF>")
+			lines, _ := text.ReadDotLines()
+			transcript.WriteString(strings.Join(lines, "\n"))
+			text.PrintfLine("250 queued")
+		case "QUIT":
+			text.PrintfLine("221 bye")
+			received <- transcript.String()
+			return
+		default:
+			text.PrintfLine("250 ok")
+		}
+	}
+	received <- transcript.String()
+}
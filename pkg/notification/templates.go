@@ -0,0 +1,122 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+// Kinds of notification with a template, each one has a `<kind>.txt.tmpl` and a `<kind>.html.tmpl` file.
+var Kinds = []model.NotificationKind{
+	model.InvitationNotification,
+	model.ReminderNotification,
+	model.SeatAssignmentNotification,
+	model.WaitlistOfferNotification,
+}
+
+/*
+The `TemplateData` struct holds the values available to the templates.
+`OfferLink` and `OfferExpiresAt` are only set for waitlist offers.
+*/
+type TemplateData struct {
+	EventName           string
+	Name                string
+	Table               int
+	Accompanying_guests int
+	RSVPStatus          model.RSVPStatus
+	RSVPLink            string
+	OfferLink           string
+	OfferExpiresAt      string
+}
+
+/*
+The `Message` struct is a rendered notification.
+*/
+type Message struct {
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+/*
+The `Renderer` renders the notification templates embedded in the binary.
+
+The subject and plain text body of each kind come from a `text/template` file defining
+the `subject` and `text` templates, and the HTML body from an `html/template` file
+defining the `html` template, so guest names are escaped in the HTML version.
+*/
+type Renderer struct {
+	text map[model.NotificationKind]*texttemplate.Template
+	html map[model.NotificationKind]*htmltemplate.Template
+}
+
+/**
+ * Parses the templates of every kind of notification.
+ *
+ * @return  pointer to an instance of Renderer
+ */
+func NewRenderer() (*Renderer, error) {
+	r := &Renderer{
+		text: make(map[model.NotificationKind]*texttemplate.Template),
+		html: make(map[model.NotificationKind]*htmltemplate.Template),
+	}
+	for _, kind := range Kinds {
+		text, err := texttemplate.ParseFS(templateFiles, fmt.Sprintf("templates/%s.txt.tmpl", kind))
+		if err != nil {
+			return nil, err
+		}
+		html, err := htmltemplate.ParseFS(templateFiles, fmt.Sprintf("templates/%s.html.tmpl", kind))
+		if err != nil {
+			return nil, err
+		}
+		r.text[kind] = text
+		r.html[kind] = html
+	}
+	return r, nil
+}
+
+/*
+`Supports` tells whether there are templates for the kind of notification.
+*/
+func (r *Renderer) Supports(kind model.NotificationKind) bool {
+	_, ok := r.text[kind]
+	return ok
+}
+
+/**
+ * Renders the subject and bodies of a notification.
+ *
+ * @param  kind  kind of notification to render
+ * @param  data  pointer to the TemplateData of the recipient
+ * @return       pointer to the rendered Message
+ */
+func (r *Renderer) Render(kind model.NotificationKind, data *TemplateData) (*Message, error) {
+	if !r.Supports(kind) {
+		return nil, fmt.Errorf("no template for notification %q", kind)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := r.text[kind].ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := r.text[kind].ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, err
+	}
+	if err := r.html[kind].ExecuteTemplate(&html, "html", data); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: strings.TrimSpace(text.String()) + "\n",
+		HTMLBody: strings.TrimSpace(html.String()) + "\n",
+	}, nil
+}
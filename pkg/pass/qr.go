@@ -0,0 +1,63 @@
+package pass
+
+import (
+	"bytes"
+	"fmt"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// Formats supported by Encode.
+const (
+	FormatPNG = "png"
+	FormatSVG = "svg"
+)
+
+// Size in pixels of the PNG images, and of each module of the SVG images.
+const (
+	pngSize       = 256
+	svgModuleSize = 8
+)
+
+/**
+ * Renders the token as a QR code image in the given format.
+ *
+ * @param  token   content of the QR code
+ * @param  format  FormatPNG or FormatSVG
+ * @return         the image and its content type
+ */
+func Encode(token string, format string) ([]byte, string, error) {
+	switch format {
+	case FormatPNG:
+		png, err := qrcode.Encode(token, qrcode.Medium, pngSize)
+		return png, "image/png", err
+	case FormatSVG:
+		svg, err := encodeSVG(token)
+		return svg, "image/svg+xml", err
+	default:
+		return nil, "", fmt.Errorf("unknown pass format %q", format)
+	}
+}
+
+// `encodeSVG` draws a square for each dark module of the QR code, quiet zone included.
+func encodeSVG(token string) ([]byte, error) {
+	code, err := qrcode.New(token, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	bitmap := code.Bitmap()
+	size := len(bitmap) * svgModuleSize
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, size, size)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#fff"/>`, size, size)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d"/>`, x*svgModuleSize, y*svgModuleSize, svgModuleSize, svgModuleSize)
+			}
+		}
+	}
+	buf.WriteString(`</svg>`)
+	return buf.Bytes(), nil
+}
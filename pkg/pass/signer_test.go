@@ -0,0 +1,73 @@
+package pass
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Signer(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+
+	t.Run("Verify_Returns_GuestID_And_Version_When_Token_Is_Signed", func(t *testing.T) {
+		guestID, version, err := signer.Verify(signer.Sign(42, 3))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 42, guestID)
+		assert.Equal(t, 3, version)
+	})
+
+	t.Run("Verify_Returns_Error_When_GuestID_Is_Changed", func(t *testing.T) {
+		token := signer.Sign(42, 0)
+		forged := "43" + token[2:]
+
+		_, _, err := signer.Verify(forged)
+		assert.Equal(t, ErrInvalidToken, err)
+	})
+
+	t.Run("Verify_Returns_Error_When_Version_Is_Changed", func(t *testing.T) {
+		token := signer.Sign(42, 1)
+		forged := "42.0" + token[4:]
+
+		_, _, err := signer.Verify(forged)
+		assert.Equal(t, ErrInvalidToken, err)
+	})
+
+	t.Run("Verify_Returns_Error_When_Signed_With_Another_Secret", func(t *testing.T) {
+		token := NewSigner([]byte("other")).Sign(42, 0)
+
+		_, _, err := signer.Verify(token)
+		assert.Equal(t, ErrInvalidToken, err)
+	})
+
+	t.Run("Verify_Returns_Error_When_Token_Is_Malformed", func(t *testing.T) {
+		for _, token := range []string{"", "42", "42.", "abc.def", "42.!!", "42.0.!!"} {
+			_, _, err := signer.Verify(token)
+			assert.Equal(t, ErrInvalidToken, err, token)
+		}
+	})
+}
+
+func Test_Encode(t *testing.T) {
+	t.Run("Returns_PNG_Image", func(t *testing.T) {
+		image, contentType, err := Encode("42.token", FormatPNG)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "image/png", contentType)
+		assert.True(t, bytes.HasPrefix(image, []byte("\x89PNG")))
+	})
+
+	t.Run("Returns_SVG_Image", func(t *testing.T) {
+		image, contentType, err := Encode("42.token", FormatSVG)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "image/svg+xml", contentType)
+		assert.True(t, bytes.HasPrefix(image, []byte("<svg")))
+	})
+
+	t.Run("Returns_Error_When_Format_Is_Unknown", func(t *testing.T) {
+		_, _, err := Encode("42.token", "gif")
+		assert.NotNil(t, err)
+	})
+}
@@ -1,12 +1,16 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
+	"strings"
+	"time"
 
 	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
 )
 
 /*
@@ -22,31 +26,77 @@ Errors are handled by the upper layers.
 */
 type MySQLGuestRepository struct {
 	Connection *sql.DB
+	logger     *slog.Logger
 }
 
-func NewMySQLGuestRepository(connection *sql.DB) *MySQLGuestRepository {
+// Columns of the `guest` table read into a Guest, in the order expected by scanGuest.
+const guestColumns = `guest_id, name, entourage, arrival_status, arrived_at, rsvp_status, IFNULL(invitation_token, ''),
+		version, pass_version, created_at, updated_at,
+		IFNULL(email, ''), phone, diet, diet_notes, allergies, accessibility_needs, IFNULL(notes, ''),
+		(SELECT GROUP_CONCAT(t.name ORDER BY t.name) FROM guest_tag as gt JOIN tag as t ON gt.tag_id = t.tag_id WHERE gt.guest_id = guest.guest_id)`
+
+// Columns selected after guestColumns are scanned into extra.
+func scanGuest(row rowScanner, guest *model.Guest, extra ...interface{}) error {
+	var tags sql.NullString
+	dest := []interface{}{&guest.GuestID, &guest.Name, &guest.Entourage, &guest.ArrivalStatus, &guest.ArrivedAt, &guest.RSVPStatus, &guest.InvitationToken,
+		&guest.Version, &guest.PassVersion, &guest.CreatedAt, &guest.UpdateAt,
+		&guest.Email, &guest.Phone, &guest.Diet, &guest.DietNotes, &guest.Allergies, &guest.AccessibilityNeeds, &guest.Notes,
+		&tags}
+	err := row.Scan(append(dest, extra...)...)
+	guest.Tags = []string{}
+	if tags.Valid && tags.String != "" {
+		guest.Tags = strings.Split(tags.String, ",")
+	}
+	return err
+}
+
+// Filter on the tags of the `guest` table aliased as g, used by the lists when a tag is requested.
+const guestTagFilter = `g.guest_id IN (
+			SELECT gt.guest_id
+			FROM guest_tag as gt
+			JOIN tag as t ON gt.tag_id = t.tag_id
+			WHERE t.name = ?
+		)`
+
+func NewMySQLGuestRepository(connection *sql.DB, logger *slog.Logger) *MySQLGuestRepository {
 	return &MySQLGuestRepository{
 		Connection: connection,
+		logger:     logger,
 	}
 }
 
 /**
  * Retrieves from the `guest` table all records, joining with the `seating` table
  * to get the table id. Returns an array of GuestData which includes the name,
- * entourage size, and table id.
+ * entourage size, and table id. When a tag is given, only the guests with said
+ * tag are returned.
  * Errors while scanning a row are notified, but not handled. This will mean only
  * rows that failed will have incomplete data instead of stopping all the operation.
  *
- * @return  array of GuestData
+ * @param   tag  name of the tag to filter by, or empty for all guests
+ * @return       array of GuestData
  */
-func (db *MySQLGuestRepository) GetGuestList() ([]model.GuestData, error) {
+func (db *MySQLGuestRepository) GetGuestList(ctx context.Context, tag string) ([]model.GuestData, error) {
 
 	sqlStatement := `
 		SELECT g.name, g.entourage, s.table_id
 		FROM guest as g
-		JOIN seating as s ON g.guest_id = s.guest_id;
-	`
-	rows, err := db.Connection.Query(sqlStatement)
+		JOIN seating as s ON g.guest_id = s.guest_id`
+	var args []interface{}
+	if tag != "" {
+		sqlStatement += `
+		WHERE ` + guestTagFilter
+		args = append(args, tag)
+	}
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetGuestList", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
 
 	var guests []model.GuestData
 
@@ -57,30 +107,44 @@ func (db *MySQLGuestRepository) GetGuestList() ([]model.GuestData, error) {
 		err = rows.Scan(&guest.Name, &guest.Accompanying_guests, &guest.Table)
 
 		if err != nil {
-			log.Print("[ERROR] ", err.Error())
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
 		}
 
 		guests = append(guests, guest)
 	}
-	return guests, nil
+	return guests, tracing.RecordError(span, rows.Err())
 }
 
 /**
  * Retrieves from the `guest` table all guest that have arrived at the event. Returns an
  * array of GuestArrival which includes the name, entourage size, and the arrival time.
+ * When a tag is given, only the guests with said tag are returned.
  * Errors while scanning a row are notified, but not handled. This will mean only
  * rows that failed will have incomplete data instead of stopping all the operation.
  *
- * @return  array of GuestArrival
+ * @param   tag  name of the tag to filter by, or empty for all guests
+ * @return       array of GuestArrival
  */
-func (db *MySQLGuestRepository) GetArrivedGuests() ([]model.GuestArrival, error) {
+func (db *MySQLGuestRepository) GetArrivedGuests(ctx context.Context, tag string) ([]model.GuestArrival, error) {
 
 	sqlStatement := `
-		SELECT name, entourage, arrived_at 
-		FROM guest
-		WHERE FIELD(arrival_status, "arrived", "left", "rejected")
-	`
-	rows, err := db.Connection.Query(sqlStatement)
+		SELECT g.name, g.entourage, g.arrived_at
+		FROM guest as g
+		WHERE FIELD(g.arrival_status, "arrived", "left", "rejected")`
+	var args []interface{}
+	if tag != "" {
+		sqlStatement += ` AND ` + guestTagFilter
+		args = append(args, tag)
+	}
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetArrivedGuests", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
 
 	var guests []model.GuestArrival
 
@@ -91,12 +155,12 @@ func (db *MySQLGuestRepository) GetArrivedGuests() ([]model.GuestArrival, error)
 		err = rows.Scan(&guest.Name, &guest.Accompanying_guests, &guest.Arrived_at)
 
 		if err != nil {
-			log.Print("[ERROR] ", err.Error())
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
 		}
 
 		guests = append(guests, guest)
 	}
-	return guests, nil
+	return guests, tracing.RecordError(span, rows.Err())
 }
 
 /**
@@ -106,41 +170,202 @@ func (db *MySQLGuestRepository) GetArrivedGuests() ([]model.GuestArrival, error)
  * @param  name  name of the guest to fetch
  * @return       pointer to an instance of Guest
  */
-func (db *MySQLGuestRepository) GetGuest(name string) (*model.Guest, error) {
+func (db *MySQLGuestRepository) GetGuest(ctx context.Context, name string) (*model.Guest, error) {
 
 	var guest model.Guest
-	sqlStatement := `SELECT * FROM guest WHERE name = ?;`
+	sqlStatement := `
+		SELECT ` + guestColumns + `
+		FROM guest
+		WHERE name = ?;
+	`
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetGuest", sqlStatement)
+	defer span.End()
 
 	// Fetch record where the id matches
-	row := db.Connection.QueryRow(sqlStatement, name)
-	err := row.Scan(&guest.GuestID, &guest.Name, &guest.Entourage, &guest.ArrivalStatus, &guest.ArrivedAt, &guest.CreatedAt, &guest.UpdateAt)
+	row := db.Connection.QueryRowContext(ctx, sqlStatement, name)
+	err := scanGuest(row, &guest)
+
+	return &guest, tracing.RecordError(span, e.CheckDatabaseError(err, name, "name", "guest"))
+}
+
+/**
+ * Retrieves a guest from the `guest` table using its id.
+ * Returns said guest and handles the database error to return a custom exception.
+ *
+ * @param  id  id of the guest to fetch
+ * @return     pointer to an instance of Guest
+ */
+func (db *MySQLGuestRepository) GetGuestByID(ctx context.Context, id int) (*model.Guest, error) {
+
+	var guest model.Guest
+	sqlStatement := `
+		SELECT ` + guestColumns + `
+		FROM guest
+		WHERE guest_id = ?;
+	`
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetGuestByID", sqlStatement)
+	defer span.End()
+
+	row := db.Connection.QueryRowContext(ctx, sqlStatement, id)
+	err := scanGuest(row, &guest)
 
-	return &guest, e.CheckDatabaseError(err, name, "name", "guest")
+	return &guest, tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(id), "id", "guest"))
+}
+
+/**
+ * Retrieves from the `guest` table the guests with any of the given names, in a single
+ * query. Names without a guest are left out of the result instead of being an error.
+ *
+ * @param   names  names of the guests to fetch
+ * @return         array of Guest
+ */
+func (db *MySQLGuestRepository) GetGuestsByName(ctx context.Context, names []string) ([]model.Guest, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	in, args := inList(names)
+	sqlStatement := `
+		SELECT ` + guestColumns + `
+		FROM guest
+		WHERE name IN (` + in + `);
+	`
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetGuestsByName", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var guests []model.Guest
+	for rows.Next() {
+		var guest model.Guest
+		if err := scanGuest(rows, &guest); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		guests = append(guests, guest)
+	}
+	return guests, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Retrieves the guests seated at any of the given tables, joining the `guest` and
+ * `seating` tables in a single query. The guests are grouped by table id, and tables
+ * without guests are left out of the result.
+ *
+ * @param   tableIDs  ids of the event tables
+ * @return            map of table id to the array of Guest seated at it
+ */
+func (db *MySQLGuestRepository) GetGuestsAtTables(ctx context.Context, tableIDs []int) (map[int][]model.Guest, error) {
+	guests := make(map[int][]model.Guest)
+	if len(tableIDs) == 0 {
+		return guests, nil
+	}
+
+	in, args := inList(tableIDs)
+	sqlStatement := `
+		SELECT ` + guestColumns + `, s.table_id
+		FROM guest
+		JOIN seating as s ON guest.guest_id = s.guest_id
+		WHERE s.table_id IN (` + in + `)
+		ORDER BY guest.guest_id;
+	`
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetGuestsAtTables", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var guest model.Guest
+		var tableID int
+		if err := scanGuest(rows, &guest, &tableID); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		guests[tableID] = append(guests[tableID], guest)
+	}
+	return guests, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Retrieves from the `seating` table the tables of the given guests, in a single query.
+ *
+ * @param   guestIDs  ids of the guests
+ * @return            array of Seating, one per guest found
+ */
+func (db *MySQLGuestRepository) GetSeating(ctx context.Context, guestIDs []int) ([]model.Seating, error) {
+	if len(guestIDs) == 0 {
+		return nil, nil
+	}
+
+	in, args := inList(guestIDs)
+	sqlStatement := `
+		SELECT table_id, guest_id
+		FROM seating
+		WHERE guest_id IN (` + in + `);
+	`
+
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetSeating", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var seating []model.Seating
+	for rows.Next() {
+		var seat model.Seating
+		if err := rows.Scan(&seat.TableID, &seat.GuestID); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		seating = append(seating, seat)
+	}
+	return seating, tracing.RecordError(span, rows.Err())
 }
 
 /**
  * Inserts a new record in the `guest` table and uses the returned guest id to insert a record
  * in the `seating` table. Uses data from GuestData for the creation, which contains name, entourage
- * size, and table id. Returns nil if successful or a custom database exception upon an error.
+ * size, table id and profile, and stores the token of the guest's invitation link. Returns nil if
+ * successful or a custom database exception upon an error.
  * If the guest already exists, a AlreadyExists error will occur.
  *
- * @param  params  pointer to GuestData
+ * @param  params           pointer to GuestData
+ * @param  invitationToken  unique token of the guest's invitation
  */
-func (db *MySQLGuestRepository) CreateGuest(params *model.GuestData) error {
+func (db *MySQLGuestRepository) CreateGuest(ctx context.Context, params *model.GuestData, invitationToken string) error {
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.CreateGuest", "INSERT INTO guest; INSERT INTO seating")
+	defer span.End()
+
 	// insert the guest record into the mysql table
-	res, err := db.Connection.Exec(`INSERT INTO guest (name, entourage) VALUES(?, ?);`, params.Name, params.Accompanying_guests)
+	res, err := db.Connection.ExecContext(ctx, `
+		INSERT INTO guest (name, entourage, invitation_token, email, phone, diet, diet_notes, allergies, accessibility_needs, notes)
+		VALUES(?, ?, ?, NULLIF(?, ''), ?, ?, ?, ?, ?, ?);`,
+		params.Name, params.Accompanying_guests, invitationToken,
+		params.Email, params.Phone, params.Diet, params.DietNotes, params.Allergies, params.AccessibilityNeeds, params.Notes)
 	if err != nil {
-		return e.CheckDatabaseError(err, params.Name, "name", "guest")
+		return tracing.RecordError(span, e.CheckDatabaseError(err, params.Name, "name", "guest"))
 	}
 	guestId, err := res.LastInsertId()
 	if err != nil {
-		return e.CheckDatabaseError(err, "", "", "")
+		return tracing.RecordError(span, e.CheckDatabaseError(err, "", "", ""))
 	}
 
 	// create the seating for the guest
-	_, err = db.Connection.Exec(`INSERT INTO seating (guest_id, table_id) VALUES(?, ?);`, guestId, params.Table)
+	_, err = db.Connection.ExecContext(ctx, `INSERT INTO seating (guest_id, table_id) VALUES(?, ?);`, guestId, params.Table)
 	if err != nil {
-		return e.CheckDatabaseError(err, fmt.Sprint(guestId), "guestID", "guest")
+		return tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(guestId), "guestID", "guest"))
 	}
 
 	return nil
@@ -148,24 +373,299 @@ func (db *MySQLGuestRepository) CreateGuest(params *model.GuestData) error {
 
 /**
  * Updates a record in the `guest` table using the data from the instance of Guest.
- * If the guest id is not found, returns a NotFound error.
+ * The first update also keeps the entourage the guest was expected with in
+ * `expected_entourage` for the reports. MySQL assigns the columns in order, so it
+ * still sees the previous entourage.
+ * The arrival before the update is recorded in `guest_status_change` in the same
+ * transaction, so the update can be undone.
+ * The update only happens if the record is still at the version of the instance,
+ * otherwise someone else changed it in between and a PreconditionFailed error is
+ * returned. On success the version of the instance is incremented.
  *
  * @param  params  pointer to GuestData
  */
-func (db *MySQLGuestRepository) UpdateGuest(guest *model.Guest) error {
-	sqlStatement := `
+func (db *MySQLGuestRepository) UpdateGuest(ctx context.Context, guest *model.Guest) error {
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.UpdateGuest", updateArrivalStatement)
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	if err := updateArrival(ctx, tx, guest); err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	return tracing.RecordError(span, tx.Commit())
+}
+
+// Statement of updateArrival. The pass version is set first, as later assignments see the new arrival status.
+const updateArrivalStatement = `
 		UPDATE guest
 		SET
+			pass_version = pass_version + IF(arrival_status <> 'arrived' AND ? = 'arrived', 1, 0),
 			name = ?,
+			expected_entourage = IFNULL(expected_entourage, entourage),
 			entourage = ?,
 			arrival_status = ?,
-			arrived_at = ?
+			arrived_at = ?,
+			version = version + 1
 		WHERE
-			guest_id = ?
+			guest_id = ? AND version = ?
 	`
-	_, err := db.Connection.Exec(sqlStatement, guest.Name, guest.Entourage, guest.ArrivalStatus, guest.ArrivedAt, guest.GuestID)
 
-	return e.CheckDatabaseError(err, fmt.Sprint(guest.GuestID), "guestID", "guest")
+/*
+Beginning of the statement recording in `guest_status_change` the arrival of the guests
+selected, before it changes, along with the station and staff member that changed it.
+Each change gets a UUID as its operation id, which door stations sync it with, so the ids
+of changes recorded after the database of a door was recreated don't collide with earlier ones.
+Completed with the status, entourage and arrival time the guests change to, and the
+condition selecting them from the `guest` table. Its arguments are built by statusChangeArgs.
+*/
+const recordStatusChange = `
+		INSERT INTO guest_status_change (operation_id, station_id, staff, guest_id, from_status, from_entourage, from_expected_entourage, from_arrived_at, to_status, to_entourage, to_arrived_at)
+		SELECT UUID(), NULLIF(?, ''), NULLIF(?, ''), guest_id, arrival_status, entourage, expected_entourage, arrived_at, `
+
+// Arguments of recordStatusChange: the checkpoint of ctx, followed by args.
+func statusChangeArgs(ctx context.Context, args ...interface{}) []interface{} {
+	checkpoint := model.CheckpointFrom(ctx)
+	return append([]interface{}{checkpoint.Station, checkpoint.Staff}, args...)
+}
+
+// Updates the arrival of the guest if it is still at its version, recording the change and incrementing the version of the instance.
+// A guest arriving uses up their check-in pass, so its version is incremented as well.
+func updateArrival(ctx context.Context, tx *sql.Tx, guest *model.Guest) error {
+	_, err := tx.ExecContext(ctx, recordStatusChange+`?, ?, ? FROM guest WHERE guest_id = ? AND version = ?;`,
+		statusChangeArgs(ctx, guest.ArrivalStatus, guest.Entourage, guest.ArrivedAt, guest.GuestID, guest.Version)...)
+	if err != nil {
+		return checkStationError(ctx, err)
+	}
+
+	res, err := tx.ExecContext(ctx, updateArrivalStatement, guest.ArrivalStatus, guest.Name, guest.Entourage, guest.ArrivalStatus, guest.ArrivedAt, guest.GuestID, guest.Version)
+	if err != nil {
+		return e.CheckDatabaseError(err, fmt.Sprint(guest.GuestID), "guestID", "guest")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return e.NewPreconditionFailedError("guest", guest.Version)
+	}
+
+	guest.Version++
+
+	return nil
+}
+
+/*
+`AdmitFunc` decides whether a guest arriving with entourage gets in, given the free seats left at
+their table, by updating the entourage, arrival status and arrival time of the guest.
+*/
+type AdmitFunc func(guest *model.Guest, entourage int, freeSeats int)
+
+/**
+ * Lets in a batch of guests in a single transaction. The guests and their tables are locked,
+ * and the free seats of each table are read once and then kept up to date as each guest
+ * is admitted, so guests of the batch sat at the same table never take the same seats.
+ * Guests are looked up by id, or by name when the arrival has no id. Guests that can't be
+ * found are skipped, leaving a nil in their place of the result.
+ *
+ * @param   arrivals  guests arriving, in the order they are let in
+ * @param   admit     decides whether each guest gets in
+ * @return            array with a pointer to the updated Guest of each arrival, in order
+ */
+func (db *MySQLGuestRepository) ArriveGuests(ctx context.Context, arrivals []model.BatchArrival, admit AdmitFunc) ([]*model.Guest, error) {
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.ArriveGuests", "SELECT guest FOR UPDATE; SELECT event_table FOR UPDATE; UPDATE guest")
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	var names []string
+	var ids []int
+	for _, arrival := range arrivals {
+		if arrival.GuestID != 0 {
+			ids = append(ids, arrival.GuestID)
+		} else {
+			names = append(names, arrival.Name)
+		}
+	}
+
+	var conditions []string
+	var args []interface{}
+	if len(ids) > 0 {
+		in, idArgs := inList(ids)
+		conditions = append(conditions, "guest.guest_id IN ("+in+")")
+		args = append(args, idArgs...)
+	}
+	if len(names) > 0 {
+		in, nameArgs := inList(names)
+		conditions = append(conditions, "guest.name IN ("+in+")")
+		args = append(args, nameArgs...)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT `+guestColumns+`, s.table_id
+		FROM guest
+		JOIN seating as s ON guest.guest_id = s.guest_id
+		WHERE `+strings.Join(conditions, " OR ")+`
+		FOR UPDATE;
+	`, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	byID := make(map[int]*model.Guest)
+	byName := make(map[string]*model.Guest)
+	tableOf := make(map[int]int)
+	var tableIDs []int
+	for rows.Next() {
+		guest := &model.Guest{}
+		var tableID int
+		if err := scanGuest(rows, guest, &tableID); err != nil {
+			rows.Close()
+			return nil, tracing.RecordError(span, err)
+		}
+		byID[guest.GuestID] = guest
+		byName[guest.Name] = guest
+		if _, ok := tableOf[guest.GuestID]; !ok {
+			tableIDs = append(tableIDs, tableID)
+		}
+		tableOf[guest.GuestID] = tableID
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	freeSeats, err := lockFreeSeats(ctx, tx, tableIDs)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	result := make([]*model.Guest, len(arrivals))
+	for i, arrival := range arrivals {
+		guest := byName[arrival.Name]
+		if arrival.GuestID != 0 {
+			guest = byID[arrival.GuestID]
+		}
+		if guest == nil {
+			continue
+		}
+
+		tableID := tableOf[guest.GuestID]
+		seatsBefore := seatsTaken(guest)
+		admit(guest, arrival.Accompanying_guests, freeSeats[tableID])
+		freeSeats[tableID] -= seatsTaken(guest) - seatsBefore
+
+		if err := updateArrival(ctx, tx, guest); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+
+		// Each arrival gets its own copy, so a guest listed twice reports both of its arrivals
+		arrived := *guest
+		result[i] = &arrived
+	}
+
+	return result, tracing.RecordError(span, tx.Commit())
+}
+
+/*
+`lockFreeSeats` locks the tables, so batches of arrivals at the same tables are accounted one
+after the other, and returns the free seats of each table by id.
+*/
+func lockFreeSeats(ctx context.Context, tx *sql.Tx, tableIDs []int) (map[int]int, error) {
+	freeSeats := make(map[int]int)
+	if len(tableIDs) == 0 {
+		return freeSeats, nil
+	}
+
+	in, args := inList(tableIDs)
+	locked, err := tx.QueryContext(ctx, `SELECT table_id FROM event_table WHERE table_id IN (`+in+`) FOR UPDATE;`, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := locked.Close(); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT table_id, free_seats FROM seating_usage WHERE table_id IN (`+in+`);`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableID, free int
+		if err := rows.Scan(&tableID, &free); err != nil {
+			return nil, err
+		}
+		freeSeats[tableID] = free
+	}
+	return freeSeats, rows.Err()
+}
+
+// Seats the guest takes up at their table, counted like the `seating_usage` view does.
+// Guests that declined give up their seats, unless they turn up anyway.
+func seatsTaken(guest *model.Guest) int {
+	if guest.ArrivalStatus == model.Arrived || (guest.ArrivalStatus == model.NotArrived && guest.RSVPStatus != model.Declined) {
+		return guest.Entourage + 1
+	}
+	return 0
+}
+
+/**
+ * Updates the contact details, diet and accessibility needs of a record in the `guest`
+ * table using the profile of the instance of Guest. As with UpdateGuest, the record must
+ * still be at the version of the instance, otherwise a PreconditionFailed error is returned.
+ * On success the version of the instance is incremented.
+ *
+ * @param  guest  pointer to Guest
+ */
+func (db *MySQLGuestRepository) UpdateGuestProfile(ctx context.Context, guest *model.Guest) error {
+	sqlStatement := `
+		UPDATE guest
+		SET
+			email = NULLIF(?, ''),
+			phone = ?,
+			diet = ?,
+			diet_notes = ?,
+			allergies = ?,
+			accessibility_needs = ?,
+			notes = ?,
+			version = version + 1
+		WHERE
+			guest_id = ? AND version = ?
+	`
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.UpdateGuestProfile", sqlStatement)
+	defer span.End()
+
+	p := guest.GuestProfile
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, p.Email, p.Phone, p.Diet, p.DietNotes, p.Allergies, p.AccessibilityNeeds, p.Notes, guest.GuestID, guest.Version)
+	if err != nil {
+		return tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(guest.GuestID), "guestID", "guest"))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewPreconditionFailedError("guest", guest.Version))
+	}
+
+	guest.Version++
+
+	return nil
 }
 
 /**
@@ -176,7 +676,7 @@ func (db *MySQLGuestRepository) UpdateGuest(guest *model.Guest) error {
  * @param  name  name of the guest (string)
  * @return       free seats at the table where guest is
  */
-func (db *MySQLGuestRepository) GetGuestTableFreeSeats(name string) (int, error) {
+func (db *MySQLGuestRepository) GetGuestTableFreeSeats(ctx context.Context, name string) (int, error) {
 
 	var result int
 
@@ -190,38 +690,267 @@ func (db *MySQLGuestRepository) GetGuestTableFreeSeats(name string) (int, error)
 			WHERE g.name = ?
 		);
 	`
-	err := db.Connection.QueryRow(sqlStatement, name).Scan(&result)
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.GetGuestTableFreeSeats", sqlStatement)
+	defer span.End()
 
-	return result, e.CheckDatabaseError(err, name, "name", "guest")
+	err := db.Connection.QueryRowContext(ctx, sqlStatement, name).Scan(&result)
+
+	return result, tracing.RecordError(span, e.CheckDatabaseError(err, name, "name", "guest"))
 }
 
 /**
  * Given a guest name, deletes the guest (logically) by setting the arrival
- * status to 'left'. If guest is not found, returns NotFound. If no guest with
- * said name has arrived, returns ArrivalStatus error.
+ * status to 'left', recording the change in `guest_status_change` so it can be
+ * undone. If guest is not found, returns NotFound. If no guest with said name
+ * has arrived, returns ArrivalStatus error.
  *
  * @param  name  name of the guest (string)
  */
-func (db *MySQLGuestRepository) DeleteGuest(name string) error {
+func (db *MySQLGuestRepository) DeleteGuest(ctx context.Context, name string) error {
 	sqlStatement := `
 		UPDATE guest
-		SET arrival_status = 'left'
+		SET arrival_status = 'left', version = version + 1
 		WHERE name = ? AND FIELD(arrival_status, 'arrived');
 	`
-	res, err := db.Connection.Exec(sqlStatement, name)
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.DeleteGuest", sqlStatement)
+	defer span.End()
 
+	tx, err := db.Connection.BeginTx(ctx, nil)
 	if err != nil {
-		return e.CheckDatabaseError(err, name, "name", "guest")
+		return tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, recordStatusChange+`'left', entourage, arrived_at FROM guest WHERE name = ? AND FIELD(arrival_status, 'arrived');`, statusChangeArgs(ctx, name)...)
+	if err != nil {
+		return tracing.RecordError(span, checkStationError(ctx, e.CheckDatabaseError(err, name, "name", "guest")))
+	}
+
+	res, err := tx.ExecContext(ctx, sqlStatement, name)
+
+	if err != nil {
+		return tracing.RecordError(span, e.CheckDatabaseError(err, name, "name", "guest"))
 	}
 
 	n, err := res.RowsAffected()
 	if err != nil {
-		return e.CheckDatabaseError(err, name, "name", "guest")
+		return tracing.RecordError(span, e.CheckDatabaseError(err, name, "name", "guest"))
 	}
 
 	if n == 0 {
-		return e.NewArrivalStatusError("Guest can't leave before they arrive")
+		return tracing.RecordError(span, e.NewArrivalStatusError("Guest can't leave before they arrive"))
 	}
 
-	return err
+	return tracing.RecordError(span, tx.Commit())
+}
+
+/**
+ * Marks the seated guests that still haven't arrived as no-shows, releasing their seats,
+ * unless they were added or answered their invitation after `settledBefore`, so guests that
+ * just got their seats, like parties accepting a waitlist offer, have time to turn up.
+ * The guests are selected and updated in a single transaction, so a guest arriving in
+ * between is never marked, and the changes are recorded in `guest_status_change`.
+ *
+ * @param   settledBefore  time guests must have been added and have answered by to be marked
+ * @return                 array of GuestData of the guests marked, with their table and entourage
+ */
+func (db *MySQLGuestRepository) MarkNoShows(ctx context.Context, settledBefore time.Time) ([]model.GuestData, error) {
+	sqlStatement := `
+		SELECT g.guest_id, g.name, g.entourage, s.table_id
+		FROM guest as g
+		JOIN seating as s ON g.guest_id = s.guest_id
+		WHERE g.arrival_status = 'not_arrived' AND g.created_at < ? AND (g.rsvp_at IS NULL OR g.rsvp_at < ?)
+		FOR UPDATE;
+	`
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.MarkNoShows", sqlStatement)
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, sqlStatement, settledBefore, settledBefore)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	var guests []model.GuestData
+	var ids []interface{}
+	for rows.Next() {
+		var id int
+		var guest model.GuestData
+		if err := rows.Scan(&id, &guest.Name, &guest.Accompanying_guests, &guest.Table); err != nil {
+			rows.Close()
+			return nil, tracing.RecordError(span, err)
+		}
+		ids = append(ids, id)
+		guests = append(guests, guest)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	if len(ids) == 0 {
+		return guests, nil
+	}
+
+	in := "?" + strings.Repeat(", ?", len(ids)-1)
+	_, err = tx.ExecContext(ctx, recordStatusChange+`'no_show', entourage, arrived_at FROM guest WHERE guest_id IN (`+in+`);`, statusChangeArgs(ctx, ids...)...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE guest
+		SET arrival_status = 'no_show', version = version + 1
+		WHERE guest_id IN (`+in+`);
+	`, ids...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	return guests, tracing.RecordError(span, tx.Commit())
+}
+
+/**
+ * Undoes the last change of the arrival of the guest with name that wasn't undone yet, restoring
+ * the status, entourage and arrival time recorded before it in `guest_status_change`. The guest
+ * and the change are locked, and the change is marked as reverted, in a single transaction.
+ * When the guest takes back seats, their table is locked to check the seats are still free.
+ * Returns a NotFound error if name is not found, a PreconditionFailed error if the guest is no
+ * longer at version, an ArrivalStatus error if there is no change to undo, it happened more
+ * than window ago or a door station already synced it to the central instance, and an
+ * ExceedsCapacity error if the seats were given to someone else.
+ *
+ * @param   name     name of the guest
+ * @param   version  version the client read the guest at, or zero for any version
+ * @param   window   how long after a change it can still be undone
+ * @return           pointer to the StatusChange undone, with the new version of the guest
+ */
+func (db *MySQLGuestRepository) UndoStatusChange(ctx context.Context, name string, version int, window time.Duration) (*model.StatusChange, error) {
+	ctx, span := startSpan(ctx, "MySQLGuestRepository.UndoStatusChange", "SELECT guest FOR UPDATE; SELECT guest_status_change FOR UPDATE; UPDATE guest; UPDATE guest_status_change")
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	guest := &model.Guest{}
+	var tableID int
+	row := tx.QueryRowContext(ctx, `
+		SELECT `+guestColumns+`, s.table_id
+		FROM guest
+		JOIN seating as s ON guest.guest_id = s.guest_id
+		WHERE guest.name = ?
+		FOR UPDATE;
+	`, name)
+	if err := scanGuest(row, guest, &tableID); err != nil {
+		return nil, tracing.RecordError(span, e.CheckDatabaseError(err, name, "name", "guest"))
+	}
+
+	// someone else changed the guest since the client read it
+	if version != 0 && guest.Version != version {
+		return nil, tracing.RecordError(span, e.NewPreconditionFailedError("guest", version))
+	}
+
+	change := model.StatusChange{GuestID: guest.GuestID, Name: guest.Name}
+	var fromExpected sql.NullInt64
+	var fromArrivedAt, toArrivedAt sql.NullString
+	var expired, synced bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT change_id, from_status, from_entourage, from_expected_entourage, from_arrived_at,
+			to_status, to_entourage, to_arrived_at, changed_at, changed_at < NOW() - INTERVAL ? SECOND, synced_at IS NOT NULL
+		FROM guest_status_change
+		WHERE guest_id = ? AND reverted_at IS NULL
+		ORDER BY change_id DESC
+		LIMIT 1
+		FOR UPDATE;
+	`, int64(window.Seconds()), guest.GuestID).Scan(
+		&change.ChangeID, &change.Before.ArrivalStatus, &change.Before.Accompanying_guests, &fromExpected, &fromArrivedAt,
+		&change.After.ArrivalStatus, &change.After.Accompanying_guests, &toArrivedAt, &change.ChangedAt, &expired, &synced)
+	if err == sql.ErrNoRows {
+		return nil, tracing.RecordError(span, e.NewArrivalStatusError("Guest has no change to undo"))
+	}
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	change.Before.ArrivedAt = nullString(fromArrivedAt)
+	change.After.ArrivedAt = nullString(toArrivedAt)
+
+	if expired {
+		return nil, tracing.RecordError(span, e.NewArrivalStatusError(fmt.Sprintf("Last change of the guest is older than %s and can't be undone", window)))
+	}
+
+	// the central instance already applied the change, and the next snapshot would bring it back
+	if synced {
+		return nil, tracing.RecordError(span, e.NewArrivalStatusError("Last change of the guest was synced to the central instance and can only be undone there"))
+	}
+
+	// changes made before they were recorded can't be told apart from the last one recorded
+	if guest.ArrivalStatus != change.After.ArrivalStatus || guest.Entourage != change.After.Accompanying_guests {
+		return nil, tracing.RecordError(span, e.NewArrivalStatusError("Guest changed after their last recorded change"))
+	}
+
+	restored := *guest
+	restored.ArrivalStatus = change.Before.ArrivalStatus
+	restored.Entourage = change.Before.Accompanying_guests
+	if needed := seatsTaken(&restored) - seatsTaken(guest); needed > 0 {
+		freeSeats, err := lockFreeSeats(ctx, tx, []int{tableID})
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		if free := freeSeats[tableID]; free < needed {
+			return nil, tracing.RecordError(span, e.NewExceedsCapacityError(free, needed-free))
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE guest
+		SET
+			entourage = ?,
+			expected_entourage = ?,
+			arrival_status = ?,
+			arrived_at = ?,
+			version = version + 1
+		WHERE
+			guest_id = ?
+	`, change.Before.Accompanying_guests, fromExpected, change.Before.ArrivalStatus, fromArrivedAt, guest.GuestID)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE guest_status_change SET reverted_at = CURRENT_TIMESTAMP WHERE change_id = ?;`, change.ChangeID)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	err = tx.QueryRowContext(ctx, `SELECT reverted_at FROM guest_status_change WHERE change_id = ?;`, change.ChangeID).Scan(&change.RevertedAt)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	change.Version = guest.Version + 1
+
+	return &change, tracing.RecordError(span, tx.Commit())
+}
+
+// Returns a pointer to the string, nil when it is NULL.
+func nullString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// Returns a pointer to the integer, nil when it is NULL.
+func nullInt(i sql.NullInt64) *int {
+	if !i.Valid {
+		return nil
+	}
+	n := int(i.Int64)
+	return &n
 }
@@ -1,23 +1,44 @@
 package repository
 
-import "github.com/fpetrikovich/go-guestlist/pkg/model"
+import (
+	"context"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
 
 /*
 This is an interface `IGuestRepository` for database logic regarding
 */
 type IGuestRepository interface {
-	// This method retrieves a list of all guests along with their data.
-	GetGuestList() ([]model.GuestData, error)
-	// This method retrieves a list of guests who have arrived at the event.
-	GetArrivedGuests() ([]model.GuestArrival, error)
+	// This method retrieves a list of all guests along with their data, optionally only those with a tag.
+	GetGuestList(ctx context.Context, tag string) ([]model.GuestData, error)
+	// This method retrieves a list of guests who have arrived at the event, optionally only those with a tag.
+	GetArrivedGuests(ctx context.Context, tag string) ([]model.GuestArrival, error)
 	// This method retrieves data of a single guest by their name.
-	GetGuest(name string) (*model.Guest, error)
-	// This method creates a new guest with the provided parameters.
-	CreateGuest(params *model.GuestData) error
+	GetGuest(ctx context.Context, name string) (*model.Guest, error)
+	// This method retrieves data of a single guest by their id.
+	GetGuestByID(ctx context.Context, id int) (*model.Guest, error)
+	// This method retrieves the guests with any of the given names in a single query.
+	GetGuestsByName(ctx context.Context, names []string) ([]model.Guest, error)
+	// This method retrieves the guests seated at any of the given tables in a single query, grouped by table id.
+	GetGuestsAtTables(ctx context.Context, tableIDs []int) (map[int][]model.Guest, error)
+	// This method retrieves the tables of the given guests in a single query.
+	GetSeating(ctx context.Context, guestIDs []int) ([]model.Seating, error)
+	// This method creates a new guest with the provided parameters and invitation token.
+	CreateGuest(ctx context.Context, params *model.GuestData, invitationToken string) error
 	// This method updates the data of a given guest.
-	UpdateGuest(g *model.Guest) error
+	UpdateGuest(ctx context.Context, g *model.Guest) error
+	// This method updates the contact details, diet and accessibility needs of a given guest.
+	UpdateGuestProfile(ctx context.Context, g *model.Guest) error
+	// This method lets in a batch of guests in a single transaction, deciding with admit whether each one gets in.
+	ArriveGuests(ctx context.Context, arrivals []model.BatchArrival, admit AdmitFunc) ([]*model.Guest, error)
 	// This method retrieves the number of free seats at a table assigned to a given guest.
-	GetGuestTableFreeSeats(name string) (int, error)
+	GetGuestTableFreeSeats(ctx context.Context, name string) (int, error)
 	// This method deletes a guest by their name.
-	DeleteGuest(name string) error
+	DeleteGuest(ctx context.Context, name string) error
+	// This method marks the guests that haven't arrived as no-shows, but those added or answering after a given time.
+	MarkNoShows(ctx context.Context, settledBefore time.Time) ([]model.GuestData, error)
+	// This method undoes the last change of the arrival of a given guest, if it happened within the window.
+	UndoStatusChange(ctx context.Context, name string, version int, window time.Duration) (*model.StatusChange, error)
 }
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/VividCortex/mysqlerr"
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Version of the schema in `docker/mysql/dump.sql` the repositories rely on.
+// Bumped with every change to the schema, along with the row of its `schema_version` table.
+const SchemaVersion = 2
+
+/*
+MySQL implementation of the `IHealthRepository` interface.
+It checks the connection is alive and which version of the schema in `docker/mysql/dump.sql` has been applied.
+*/
+type MySQLHealthRepository struct {
+	Connection *sql.DB
+}
+
+func NewMySQLHealthRepository(connection *sql.DB) *MySQLHealthRepository {
+	return &MySQLHealthRepository{
+		Connection: connection,
+	}
+}
+
+/**
+ * Verifies the connection to the database is still alive, establishing
+ * a new one if necessary.
+ */
+func (db *MySQLHealthRepository) Ping(ctx context.Context) error {
+	ctx, span := startSpan(ctx, "MySQLHealthRepository.Ping", "PING")
+	defer span.End()
+
+	return tracing.RecordError(span, db.Connection.PingContext(ctx))
+}
+
+/**
+ * Retrieves the version of the schema recorded in the `schema_version` table.
+ * Returns 0 when the table doesn't exist or is empty, as in schemas older than the table.
+ *
+ * @return  version of the schema applied
+ */
+func (db *MySQLHealthRepository) GetSchemaVersion(ctx context.Context) (int, error) {
+	sqlStatement := `SELECT IFNULL(MAX(version), 0) FROM schema_version;`
+
+	ctx, span := startSpan(ctx, "MySQLHealthRepository.GetSchemaVersion", sqlStatement)
+	defer span.End()
+
+	var version int
+	err := db.Connection.QueryRowContext(ctx, sqlStatement).Scan(&version)
+	if driverErr, ok := err.(*mysql.MySQLError); ok && driverErr.Number == mysqlerr.ER_NO_SUCH_TABLE {
+		return 0, nil
+	}
+	return version, tracing.RecordError(span, err)
+}
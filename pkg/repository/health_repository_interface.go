@@ -0,0 +1,13 @@
+package repository
+
+import "context"
+
+/*
+The `IHealthRepository` interface defines the checks run against the database to decide if the application can serve traffic.
+*/
+type IHealthRepository interface {
+	// Checks the database can be reached.
+	Ping(ctx context.Context) error
+	// Retrieves the version of the schema applied to the database, 0 when it has none.
+	GetSchemaVersion(ctx context.Context) (int, error)
+}
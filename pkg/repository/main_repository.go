@@ -1,35 +1,71 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/fpetrikovich/go-guestlist/pkg/repository")
+
 /*
 Implementation of a MySQL repository.
 
 It creates a new instance of the `MySQLRepository` and sets up a connection to a MySQL database.
 
-The connection is specified by the values of the environment variables.
+The connection is specified by the values of the environment variables, and the database
+is reached at `address`, so a door station can run against its own local database.
 
 The code logs a message indicating if the MySQL connection was successful or not.
+An invalid connection configuration is returned as an error.
 */
 type MySQLRepository struct {
 	Connection *sql.DB
 }
 
-func NewMySQLRepository() *MySQLRepository {
-	connectionString := fmt.Sprintf("%v:%v@tcp(%v:%v)/%v", "user", "password", "guestlist-mysql", "3306", "database")
+func NewMySQLRepository(address string, logger *slog.Logger) (*MySQLRepository, error) {
+	connectionString := fmt.Sprintf("%v:%v@tcp(%v)/%v", "user", "password", address, "database")
 	connection, err := sql.Open("mysql", connectionString)
 
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	log.Print("[INFO] MySQL connection successful.")
+	logger.Info("MySQL connection successful.")
 
 	return &MySQLRepository{
 		Connection: connection,
+	}, nil
+}
+
+/*
+`startSpan` opens a client span for a database call, tagging it with the
+statement being run so the time spent in MySQL shows up in traces.
+*/
+func startSpan(ctx context.Context, name string, statement string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mysql"),
+			attribute.String("db.statement", statement),
+		),
+	)
+}
+
+/*
+`inList` returns the placeholders of an `IN` clause for the values, like `?, ?, ?`,
+along with the values as the arguments of the statement. The values must not be empty.
+*/
+func inList[T any](values []T) (string, []interface{}) {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
 	}
+	return "?" + strings.Repeat(", ?", len(values)-1), args
 }
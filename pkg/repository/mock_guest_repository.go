@@ -5,7 +5,9 @@
 package repository
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/fpetrikovich/go-guestlist/pkg/model"
 	gomock "github.com/golang/mock/gomock"
@@ -34,104 +36,223 @@ func (m *MockIGuestRepository) EXPECT() *MockIGuestRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ArriveGuests mocks base method.
+func (m *MockIGuestRepository) ArriveGuests(ctx context.Context, arrivals []model.BatchArrival, admit AdmitFunc) ([]*model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArriveGuests", ctx, arrivals, admit)
+	ret0, _ := ret[0].([]*model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArriveGuests indicates an expected call of ArriveGuests.
+func (mr *MockIGuestRepositoryMockRecorder) ArriveGuests(ctx, arrivals, admit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArriveGuests", reflect.TypeOf((*MockIGuestRepository)(nil).ArriveGuests), ctx, arrivals, admit)
+}
+
 // CreateGuest mocks base method.
-func (m *MockIGuestRepository) CreateGuest(params *model.GuestData) error {
+func (m *MockIGuestRepository) CreateGuest(ctx context.Context, params *model.GuestData, invitationToken string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGuest", params)
+	ret := m.ctrl.Call(m, "CreateGuest", ctx, params, invitationToken)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateGuest indicates an expected call of CreateGuest.
-func (mr *MockIGuestRepositoryMockRecorder) CreateGuest(params interface{}) *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) CreateGuest(ctx, params, invitationToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuest", reflect.TypeOf((*MockIGuestRepository)(nil).CreateGuest), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuest", reflect.TypeOf((*MockIGuestRepository)(nil).CreateGuest), ctx, params, invitationToken)
 }
 
 // DeleteGuest mocks base method.
-func (m *MockIGuestRepository) DeleteGuest(name string) error {
+func (m *MockIGuestRepository) DeleteGuest(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGuest", name)
+	ret := m.ctrl.Call(m, "DeleteGuest", ctx, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteGuest indicates an expected call of DeleteGuest.
-func (mr *MockIGuestRepositoryMockRecorder) DeleteGuest(name interface{}) *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) DeleteGuest(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuest", reflect.TypeOf((*MockIGuestRepository)(nil).DeleteGuest), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuest", reflect.TypeOf((*MockIGuestRepository)(nil).DeleteGuest), ctx, name)
 }
 
 // GetArrivedGuests mocks base method.
-func (m *MockIGuestRepository) GetArrivedGuests() ([]model.GuestArrival, error) {
+func (m *MockIGuestRepository) GetArrivedGuests(ctx context.Context, tag string) ([]model.GuestArrival, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArrivedGuests")
+	ret := m.ctrl.Call(m, "GetArrivedGuests", ctx, tag)
 	ret0, _ := ret[0].([]model.GuestArrival)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArrivedGuests indicates an expected call of GetArrivedGuests.
-func (mr *MockIGuestRepositoryMockRecorder) GetArrivedGuests() *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) GetArrivedGuests(ctx, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArrivedGuests", reflect.TypeOf((*MockIGuestRepository)(nil).GetArrivedGuests))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArrivedGuests", reflect.TypeOf((*MockIGuestRepository)(nil).GetArrivedGuests), ctx, tag)
 }
 
 // GetGuest mocks base method.
-func (m *MockIGuestRepository) GetGuest(name string) (*model.Guest, error) {
+func (m *MockIGuestRepository) GetGuest(ctx context.Context, name string) (*model.Guest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGuest", name)
+	ret := m.ctrl.Call(m, "GetGuest", ctx, name)
 	ret0, _ := ret[0].(*model.Guest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGuest indicates an expected call of GetGuest.
-func (mr *MockIGuestRepositoryMockRecorder) GetGuest(name interface{}) *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) GetGuest(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuest", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuest), ctx, name)
+}
+
+// GetGuestByID mocks base method.
+func (m *MockIGuestRepository) GetGuestByID(ctx context.Context, id int) (*model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestByID", ctx, id)
+	ret0, _ := ret[0].(*model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestByID indicates an expected call of GetGuestByID.
+func (mr *MockIGuestRepositoryMockRecorder) GetGuestByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuest", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuest), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestByID", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestByID), ctx, id)
 }
 
 // GetGuestList mocks base method.
-func (m *MockIGuestRepository) GetGuestList() ([]model.GuestData, error) {
+func (m *MockIGuestRepository) GetGuestList(ctx context.Context, tag string) ([]model.GuestData, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGuestList")
+	ret := m.ctrl.Call(m, "GetGuestList", ctx, tag)
 	ret0, _ := ret[0].([]model.GuestData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGuestList indicates an expected call of GetGuestList.
-func (mr *MockIGuestRepositoryMockRecorder) GetGuestList() *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) GetGuestList(ctx, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestList", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestList", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestList), ctx, tag)
 }
 
 // GetGuestTableFreeSeats mocks base method.
-func (m *MockIGuestRepository) GetGuestTableFreeSeats(name string) (int, error) {
+func (m *MockIGuestRepository) GetGuestTableFreeSeats(ctx context.Context, name string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGuestTableFreeSeats", name)
+	ret := m.ctrl.Call(m, "GetGuestTableFreeSeats", ctx, name)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGuestTableFreeSeats indicates an expected call of GetGuestTableFreeSeats.
-func (mr *MockIGuestRepositoryMockRecorder) GetGuestTableFreeSeats(name interface{}) *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) GetGuestTableFreeSeats(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestTableFreeSeats", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestTableFreeSeats), ctx, name)
+}
+
+// GetGuestsAtTables mocks base method.
+func (m *MockIGuestRepository) GetGuestsAtTables(ctx context.Context, tableIDs []int) (map[int][]model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestsAtTables", ctx, tableIDs)
+	ret0, _ := ret[0].(map[int][]model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestsAtTables indicates an expected call of GetGuestsAtTables.
+func (mr *MockIGuestRepositoryMockRecorder) GetGuestsAtTables(ctx, tableIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestsAtTables", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestsAtTables), ctx, tableIDs)
+}
+
+// GetGuestsByName mocks base method.
+func (m *MockIGuestRepository) GetGuestsByName(ctx context.Context, names []string) ([]model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestsByName", ctx, names)
+	ret0, _ := ret[0].([]model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestsByName indicates an expected call of GetGuestsByName.
+func (mr *MockIGuestRepositoryMockRecorder) GetGuestsByName(ctx, names interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestTableFreeSeats", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestTableFreeSeats), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestsByName", reflect.TypeOf((*MockIGuestRepository)(nil).GetGuestsByName), ctx, names)
+}
+
+// GetSeating mocks base method.
+func (m *MockIGuestRepository) GetSeating(ctx context.Context, guestIDs []int) ([]model.Seating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeating", ctx, guestIDs)
+	ret0, _ := ret[0].([]model.Seating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeating indicates an expected call of GetSeating.
+func (mr *MockIGuestRepositoryMockRecorder) GetSeating(ctx, guestIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeating", reflect.TypeOf((*MockIGuestRepository)(nil).GetSeating), ctx, guestIDs)
+}
+
+// MarkNoShows mocks base method.
+func (m *MockIGuestRepository) MarkNoShows(ctx context.Context, settledBefore time.Time) ([]model.GuestData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNoShows", ctx, settledBefore)
+	ret0, _ := ret[0].([]model.GuestData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNoShows indicates an expected call of MarkNoShows.
+func (mr *MockIGuestRepositoryMockRecorder) MarkNoShows(ctx, settledBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNoShows", reflect.TypeOf((*MockIGuestRepository)(nil).MarkNoShows), ctx, settledBefore)
+}
+
+// UndoStatusChange mocks base method.
+func (m *MockIGuestRepository) UndoStatusChange(ctx context.Context, name string, version int, window time.Duration) (*model.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoStatusChange", ctx, name, version, window)
+	ret0, _ := ret[0].(*model.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoStatusChange indicates an expected call of UndoStatusChange.
+func (mr *MockIGuestRepositoryMockRecorder) UndoStatusChange(ctx, name, version, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoStatusChange", reflect.TypeOf((*MockIGuestRepository)(nil).UndoStatusChange), ctx, name, version, window)
 }
 
 // UpdateGuest mocks base method.
-func (m *MockIGuestRepository) UpdateGuest(g *model.Guest) error {
+func (m *MockIGuestRepository) UpdateGuest(ctx context.Context, g *model.Guest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGuest", g)
+	ret := m.ctrl.Call(m, "UpdateGuest", ctx, g)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateGuest indicates an expected call of UpdateGuest.
-func (mr *MockIGuestRepositoryMockRecorder) UpdateGuest(g interface{}) *gomock.Call {
+func (mr *MockIGuestRepositoryMockRecorder) UpdateGuest(ctx, g interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuest", reflect.TypeOf((*MockIGuestRepository)(nil).UpdateGuest), ctx, g)
+}
+
+// UpdateGuestProfile mocks base method.
+func (m *MockIGuestRepository) UpdateGuestProfile(ctx context.Context, g *model.Guest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestProfile", ctx, g)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGuestProfile indicates an expected call of UpdateGuestProfile.
+func (mr *MockIGuestRepositoryMockRecorder) UpdateGuestProfile(ctx, g interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuest", reflect.TypeOf((*MockIGuestRepository)(nil).UpdateGuest), g)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestProfile", reflect.TypeOf((*MockIGuestRepository)(nil).UpdateGuestProfile), ctx, g)
 }
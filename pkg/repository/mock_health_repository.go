@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/health_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIHealthRepository is a mock of IHealthRepository interface.
+type MockIHealthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthRepositoryMockRecorder
+}
+
+// MockIHealthRepositoryMockRecorder is the mock recorder for MockIHealthRepository.
+type MockIHealthRepositoryMockRecorder struct {
+	mock *MockIHealthRepository
+}
+
+// NewMockIHealthRepository creates a new mock instance.
+func NewMockIHealthRepository(ctrl *gomock.Controller) *MockIHealthRepository {
+	mock := &MockIHealthRepository{ctrl: ctrl}
+	mock.recorder = &MockIHealthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthRepository) EXPECT() *MockIHealthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSchemaVersion mocks base method.
+func (m *MockIHealthRepository) GetSchemaVersion(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchemaVersion", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchemaVersion indicates an expected call of GetSchemaVersion.
+func (mr *MockIHealthRepositoryMockRecorder) GetSchemaVersion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchemaVersion", reflect.TypeOf((*MockIHealthRepository)(nil).GetSchemaVersion), ctx)
+}
+
+// Ping mocks base method.
+func (m *MockIHealthRepository) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockIHealthRepositoryMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockIHealthRepository)(nil).Ping), ctx)
+}
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/notification_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockINotificationRepository is a mock of INotificationRepository interface.
+type MockINotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationRepositoryMockRecorder
+}
+
+// MockINotificationRepositoryMockRecorder is the mock recorder for MockINotificationRepository.
+type MockINotificationRepositoryMockRecorder struct {
+	mock *MockINotificationRepository
+}
+
+// NewMockINotificationRepository creates a new mock instance.
+func NewMockINotificationRepository(ctrl *gomock.Controller) *MockINotificationRepository {
+	mock := &MockINotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockINotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationRepository) EXPECT() *MockINotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueNotifications mocks base method.
+func (m *MockINotificationRepository) ClaimDueNotifications(ctx context.Context, limit int, lease time.Duration) ([]model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueNotifications", ctx, limit, lease)
+	ret0, _ := ret[0].([]model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueNotifications indicates an expected call of ClaimDueNotifications.
+func (mr *MockINotificationRepositoryMockRecorder) ClaimDueNotifications(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueNotifications", reflect.TypeOf((*MockINotificationRepository)(nil).ClaimDueNotifications), ctx, limit, lease)
+}
+
+// EnqueueNotifications mocks base method.
+func (m *MockINotificationRepository) EnqueueNotifications(ctx context.Context, notifications []model.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueNotifications", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueNotifications indicates an expected call of EnqueueNotifications.
+func (mr *MockINotificationRepositoryMockRecorder) EnqueueNotifications(ctx, notifications interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueNotifications", reflect.TypeOf((*MockINotificationRepository)(nil).EnqueueNotifications), ctx, notifications)
+}
+
+// FailNotification mocks base method.
+func (m *MockINotificationRepository) FailNotification(ctx context.Context, id int, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailNotification", ctx, id, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailNotification indicates an expected call of FailNotification.
+func (mr *MockINotificationRepositoryMockRecorder) FailNotification(ctx, id, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailNotification", reflect.TypeOf((*MockINotificationRepository)(nil).FailNotification), ctx, id, lastError)
+}
+
+// GetRecipients mocks base method.
+func (m *MockINotificationRepository) GetRecipients(ctx context.Context, segment model.Segment) ([]model.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipients", ctx, segment)
+	ret0, _ := ret[0].([]model.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipients indicates an expected call of GetRecipients.
+func (mr *MockINotificationRepositoryMockRecorder) GetRecipients(ctx, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipients", reflect.TypeOf((*MockINotificationRepository)(nil).GetRecipients), ctx, segment)
+}
+
+// MarkNotificationSent mocks base method.
+func (m *MockINotificationRepository) MarkNotificationSent(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationSent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationSent indicates an expected call of MarkNotificationSent.
+func (mr *MockINotificationRepositoryMockRecorder) MarkNotificationSent(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationSent", reflect.TypeOf((*MockINotificationRepository)(nil).MarkNotificationSent), ctx, id)
+}
+
+// RetryNotification mocks base method.
+func (m *MockINotificationRepository) RetryNotification(ctx context.Context, id int, lastError string, retryIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryNotification", ctx, id, lastError, retryIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryNotification indicates an expected call of RetryNotification.
+func (mr *MockINotificationRepositoryMockRecorder) RetryNotification(ctx, id, lastError, retryIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryNotification", reflect.TypeOf((*MockINotificationRepository)(nil).RetryNotification), ctx, id, lastError, retryIn)
+}
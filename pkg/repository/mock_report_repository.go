@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/report_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReportRepository is a mock of IReportRepository interface.
+type MockIReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReportRepositoryMockRecorder
+}
+
+// MockIReportRepositoryMockRecorder is the mock recorder for MockIReportRepository.
+type MockIReportRepositoryMockRecorder struct {
+	mock *MockIReportRepository
+}
+
+// NewMockIReportRepository creates a new mock instance.
+func NewMockIReportRepository(ctrl *gomock.Controller) *MockIReportRepository {
+	mock := &MockIReportRepository{ctrl: ctrl}
+	mock.recorder = &MockIReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReportRepository) EXPECT() *MockIReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetArrivals mocks base method.
+func (m *MockIReportRepository) GetArrivals(ctx context.Context) ([]model.ArrivalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArrivals", ctx)
+	ret0, _ := ret[0].([]model.ArrivalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArrivals indicates an expected call of GetArrivals.
+func (mr *MockIReportRepositoryMockRecorder) GetArrivals(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArrivals", reflect.TypeOf((*MockIReportRepository)(nil).GetArrivals), ctx)
+}
+
+// GetAttendance mocks base method.
+func (m *MockIReportRepository) GetAttendance(ctx context.Context) ([]model.AttendanceEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendance", ctx)
+	ret0, _ := ret[0].([]model.AttendanceEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendance indicates an expected call of GetAttendance.
+func (mr *MockIReportRepositoryMockRecorder) GetAttendance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockIReportRepository)(nil).GetAttendance), ctx)
+}
+
+// GetCateringEntries mocks base method.
+func (m *MockIReportRepository) GetCateringEntries(ctx context.Context) ([]model.CateringEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCateringEntries", ctx)
+	ret0, _ := ret[0].([]model.CateringEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCateringEntries indicates an expected call of GetCateringEntries.
+func (mr *MockIReportRepositoryMockRecorder) GetCateringEntries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCateringEntries", reflect.TypeOf((*MockIReportRepository)(nil).GetCateringEntries), ctx)
+}
+
+// GetTableUsage mocks base method.
+func (m *MockIReportRepository) GetTableUsage(ctx context.Context) ([]model.TableUtilization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableUsage", ctx)
+	ret0, _ := ret[0].([]model.TableUtilization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableUsage indicates an expected call of GetTableUsage.
+func (mr *MockIReportRepositoryMockRecorder) GetTableUsage(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableUsage", reflect.TypeOf((*MockIReportRepository)(nil).GetTableUsage), ctx)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/rsvp_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIRSVPRepository is a mock of IRSVPRepository interface.
+type MockIRSVPRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRSVPRepositoryMockRecorder
+}
+
+// MockIRSVPRepositoryMockRecorder is the mock recorder for MockIRSVPRepository.
+type MockIRSVPRepositoryMockRecorder struct {
+	mock *MockIRSVPRepository
+}
+
+// NewMockIRSVPRepository creates a new mock instance.
+func NewMockIRSVPRepository(ctrl *gomock.Controller) *MockIRSVPRepository {
+	mock := &MockIRSVPRepository{ctrl: ctrl}
+	mock.recorder = &MockIRSVPRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRSVPRepository) EXPECT() *MockIRSVPRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetInvitation mocks base method.
+func (m *MockIRSVPRepository) GetInvitation(ctx context.Context, token string) (*model.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitation", ctx, token)
+	ret0, _ := ret[0].(*model.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitation indicates an expected call of GetInvitation.
+func (mr *MockIRSVPRepositoryMockRecorder) GetInvitation(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitation", reflect.TypeOf((*MockIRSVPRepository)(nil).GetInvitation), ctx, token)
+}
+
+// UpdateRSVP mocks base method.
+func (m *MockIRSVPRepository) UpdateRSVP(ctx context.Context, invitation *model.Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRSVP", ctx, invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRSVP indicates an expected call of UpdateRSVP.
+func (mr *MockIRSVPRepositoryMockRecorder) UpdateRSVP(ctx, invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRSVP", reflect.TypeOf((*MockIRSVPRepository)(nil).UpdateRSVP), ctx, invitation)
+}
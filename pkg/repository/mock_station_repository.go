@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/station_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIStationRepository is a mock of IStationRepository interface.
+type MockIStationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStationRepositoryMockRecorder
+}
+
+// MockIStationRepositoryMockRecorder is the mock recorder for MockIStationRepository.
+type MockIStationRepositoryMockRecorder struct {
+	mock *MockIStationRepository
+}
+
+// NewMockIStationRepository creates a new mock instance.
+func NewMockIStationRepository(ctrl *gomock.Controller) *MockIStationRepository {
+	mock := &MockIStationRepository{ctrl: ctrl}
+	mock.recorder = &MockIStationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStationRepository) EXPECT() *MockIStationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateStation mocks base method.
+func (m *MockIStationRepository) CreateStation(ctx context.Context, station *model.Station) (*model.Station, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStation", ctx, station)
+	ret0, _ := ret[0].(*model.Station)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStation indicates an expected call of CreateStation.
+func (mr *MockIStationRepositoryMockRecorder) CreateStation(ctx, station interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStation", reflect.TypeOf((*MockIStationRepository)(nil).CreateStation), ctx, station)
+}
+
+// GetActivity mocks base method.
+func (m *MockIStationRepository) GetActivity(ctx context.Context, id string, before, limit int) ([]model.StationActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, id, before, limit)
+	ret0, _ := ret[0].([]model.StationActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockIStationRepositoryMockRecorder) GetActivity(ctx, id, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockIStationRepository)(nil).GetActivity), ctx, id, before, limit)
+}
+
+// GetStation mocks base method.
+func (m *MockIStationRepository) GetStation(ctx context.Context, id string) (*model.Station, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStation", ctx, id)
+	ret0, _ := ret[0].(*model.Station)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStation indicates an expected call of GetStation.
+func (mr *MockIStationRepositoryMockRecorder) GetStation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStation", reflect.TypeOf((*MockIStationRepository)(nil).GetStation), ctx, id)
+}
+
+// GetThroughput mocks base method.
+func (m *MockIStationRepository) GetThroughput(ctx context.Context) ([]model.StationThroughput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThroughput", ctx)
+	ret0, _ := ret[0].([]model.StationThroughput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThroughput indicates an expected call of GetThroughput.
+func (mr *MockIStationRepositoryMockRecorder) GetThroughput(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThroughput", reflect.TypeOf((*MockIStationRepository)(nil).GetThroughput), ctx)
+}
+
+// RegisterStation mocks base method.
+func (m *MockIStationRepository) RegisterStation(ctx context.Context, station *model.Station) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterStation", ctx, station)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterStation indicates an expected call of RegisterStation.
+func (mr *MockIStationRepositoryMockRecorder) RegisterStation(ctx, station interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStation", reflect.TypeOf((*MockIStationRepository)(nil).RegisterStation), ctx, station)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/sync_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISyncRepository is a mock of ISyncRepository interface.
+type MockISyncRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISyncRepositoryMockRecorder
+}
+
+// MockISyncRepositoryMockRecorder is the mock recorder for MockISyncRepository.
+type MockISyncRepositoryMockRecorder struct {
+	mock *MockISyncRepository
+}
+
+// NewMockISyncRepository creates a new mock instance.
+func NewMockISyncRepository(ctrl *gomock.Controller) *MockISyncRepository {
+	mock := &MockISyncRepository{ctrl: ctrl}
+	mock.recorder = &MockISyncRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISyncRepository) EXPECT() *MockISyncRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyOperations mocks base method.
+func (m *MockISyncRepository) ApplyOperations(ctx context.Context, station string, operations []model.DoorOperation, resolve ResolveFunc) ([]model.SyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyOperations", ctx, station, operations, resolve)
+	ret0, _ := ret[0].([]model.SyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyOperations indicates an expected call of ApplyOperations.
+func (mr *MockISyncRepositoryMockRecorder) ApplyOperations(ctx, station, operations, resolve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperations", reflect.TypeOf((*MockISyncRepository)(nil).ApplyOperations), ctx, station, operations, resolve)
+}
+
+// ApplySnapshot mocks base method.
+func (m *MockISyncRepository) ApplySnapshot(ctx context.Context, snapshot *model.Snapshot) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplySnapshot", ctx, snapshot)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplySnapshot indicates an expected call of ApplySnapshot.
+func (mr *MockISyncRepositoryMockRecorder) ApplySnapshot(ctx, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySnapshot", reflect.TypeOf((*MockISyncRepository)(nil).ApplySnapshot), ctx, snapshot)
+}
+
+// GetConflicts mocks base method.
+func (m *MockISyncRepository) GetConflicts(ctx context.Context, station string) ([]model.SyncConflict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConflicts", ctx, station)
+	ret0, _ := ret[0].([]model.SyncConflict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConflicts indicates an expected call of GetConflicts.
+func (mr *MockISyncRepositoryMockRecorder) GetConflicts(ctx, station interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConflicts", reflect.TypeOf((*MockISyncRepository)(nil).GetConflicts), ctx, station)
+}
+
+// GetPendingOperations mocks base method.
+func (m *MockISyncRepository) GetPendingOperations(ctx context.Context, limit int) ([]model.DoorOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperations", ctx, limit)
+	ret0, _ := ret[0].([]model.DoorOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperations indicates an expected call of GetPendingOperations.
+func (mr *MockISyncRepositoryMockRecorder) GetPendingOperations(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperations", reflect.TypeOf((*MockISyncRepository)(nil).GetPendingOperations), ctx, limit)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockISyncRepository) GetSnapshot(ctx context.Context) (*model.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx)
+	ret0, _ := ret[0].(*model.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockISyncRepositoryMockRecorder) GetSnapshot(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockISyncRepository)(nil).GetSnapshot), ctx)
+}
+
+// MarkOperationsSynced mocks base method.
+func (m *MockISyncRepository) MarkOperationsSynced(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOperationsSynced", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOperationsSynced indicates an expected call of MarkOperationsSynced.
+func (mr *MockISyncRepositoryMockRecorder) MarkOperationsSynced(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOperationsSynced", reflect.TypeOf((*MockISyncRepository)(nil).MarkOperationsSynced), ctx, ids)
+}
@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/table_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIEventTableRepository is a mock of IEventTableRepository interface.
+type MockIEventTableRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventTableRepositoryMockRecorder
+}
+
+// MockIEventTableRepositoryMockRecorder is the mock recorder for MockIEventTableRepository.
+type MockIEventTableRepositoryMockRecorder struct {
+	mock *MockIEventTableRepository
+}
+
+// NewMockIEventTableRepository creates a new mock instance.
+func NewMockIEventTableRepository(ctrl *gomock.Controller) *MockIEventTableRepository {
+	mock := &MockIEventTableRepository{ctrl: ctrl}
+	mock.recorder = &MockIEventTableRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventTableRepository) EXPECT() *MockIEventTableRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTable mocks base method.
+func (m *MockIEventTableRepository) CreateTable(ctx context.Context, table *model.EventTable) (*model.EventTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTable", ctx, table)
+	ret0, _ := ret[0].(*model.EventTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTable indicates an expected call of CreateTable.
+func (mr *MockIEventTableRepositoryMockRecorder) CreateTable(ctx, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockIEventTableRepository)(nil).CreateTable), ctx, table)
+}
+
+// DeleteTable mocks base method.
+func (m *MockIEventTableRepository) DeleteTable(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTable", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTable indicates an expected call of DeleteTable.
+func (mr *MockIEventTableRepositoryMockRecorder) DeleteTable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockIEventTableRepository)(nil).DeleteTable), ctx, id)
+}
+
+// GetEmptySeats mocks base method.
+func (m *MockIEventTableRepository) GetEmptySeats(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmptySeats", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmptySeats indicates an expected call of GetEmptySeats.
+func (mr *MockIEventTableRepositoryMockRecorder) GetEmptySeats(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeats", reflect.TypeOf((*MockIEventTableRepository)(nil).GetEmptySeats), ctx)
+}
+
+// GetEmptySeatsAtTable mocks base method.
+func (m *MockIEventTableRepository) GetEmptySeatsAtTable(ctx context.Context, id int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmptySeatsAtTable", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmptySeatsAtTable indicates an expected call of GetEmptySeatsAtTable.
+func (mr *MockIEventTableRepositoryMockRecorder) GetEmptySeatsAtTable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeatsAtTable", reflect.TypeOf((*MockIEventTableRepository)(nil).GetEmptySeatsAtTable), ctx, id)
+}
+
+// GetEmptySeatsByTable mocks base method.
+func (m *MockIEventTableRepository) GetEmptySeatsByTable(ctx context.Context, ids []int) (map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmptySeatsByTable", ctx, ids)
+	ret0, _ := ret[0].(map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmptySeatsByTable indicates an expected call of GetEmptySeatsByTable.
+func (mr *MockIEventTableRepositoryMockRecorder) GetEmptySeatsByTable(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeatsByTable", reflect.TypeOf((*MockIEventTableRepository)(nil).GetEmptySeatsByTable), ctx, ids)
+}
+
+// GetTable mocks base method.
+func (m *MockIEventTableRepository) GetTable(ctx context.Context, id int) (*model.EventTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTable", ctx, id)
+	ret0, _ := ret[0].(*model.EventTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTable indicates an expected call of GetTable.
+func (mr *MockIEventTableRepositoryMockRecorder) GetTable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTable", reflect.TypeOf((*MockIEventTableRepository)(nil).GetTable), ctx, id)
+}
+
+// GetTables mocks base method.
+func (m *MockIEventTableRepository) GetTables(ctx context.Context) ([]model.EventTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTables", ctx)
+	ret0, _ := ret[0].([]model.EventTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTables indicates an expected call of GetTables.
+func (mr *MockIEventTableRepositoryMockRecorder) GetTables(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTables", reflect.TypeOf((*MockIEventTableRepository)(nil).GetTables), ctx)
+}
+
+// GetTablesByID mocks base method.
+func (m *MockIEventTableRepository) GetTablesByID(ctx context.Context, ids []int) ([]model.EventTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTablesByID", ctx, ids)
+	ret0, _ := ret[0].([]model.EventTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTablesByID indicates an expected call of GetTablesByID.
+func (mr *MockIEventTableRepositoryMockRecorder) GetTablesByID(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTablesByID", reflect.TypeOf((*MockIEventTableRepository)(nil).GetTablesByID), ctx, ids)
+}
+
+// UpdateTable mocks base method.
+func (m *MockIEventTableRepository) UpdateTable(ctx context.Context, table *model.EventTable) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTable", ctx, table)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTable indicates an expected call of UpdateTable.
+func (mr *MockIEventTableRepositoryMockRecorder) UpdateTable(ctx, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTable", reflect.TypeOf((*MockIEventTableRepository)(nil).UpdateTable), ctx, table)
+}
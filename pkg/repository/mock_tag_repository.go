@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/tag_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITagRepository is a mock of ITagRepository interface.
+type MockITagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagRepositoryMockRecorder
+}
+
+// MockITagRepositoryMockRecorder is the mock recorder for MockITagRepository.
+type MockITagRepositoryMockRecorder struct {
+	mock *MockITagRepository
+}
+
+// NewMockITagRepository creates a new mock instance.
+func NewMockITagRepository(ctrl *gomock.Controller) *MockITagRepository {
+	mock := &MockITagRepository{ctrl: ctrl}
+	mock.recorder = &MockITagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagRepository) EXPECT() *MockITagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttachTag mocks base method.
+func (m *MockITagRepository) AttachTag(ctx context.Context, guestName, tagName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTag", ctx, guestName, tagName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachTag indicates an expected call of AttachTag.
+func (mr *MockITagRepositoryMockRecorder) AttachTag(ctx, guestName, tagName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockITagRepository)(nil).AttachTag), ctx, guestName, tagName)
+}
+
+// CreateTag mocks base method.
+func (m *MockITagRepository) CreateTag(ctx context.Context, params *model.TagData) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, params)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockITagRepositoryMockRecorder) CreateTag(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockITagRepository)(nil).CreateTag), ctx, params)
+}
+
+// DeleteTag mocks base method.
+func (m *MockITagRepository) DeleteTag(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockITagRepositoryMockRecorder) DeleteTag(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockITagRepository)(nil).DeleteTag), ctx, name)
+}
+
+// DetachTag mocks base method.
+func (m *MockITagRepository) DetachTag(ctx context.Context, guestName, tagName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", ctx, guestName, tagName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockITagRepositoryMockRecorder) DetachTag(ctx, guestName, tagName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockITagRepository)(nil).DetachTag), ctx, guestName, tagName)
+}
+
+// GetGuestTags mocks base method.
+func (m *MockITagRepository) GetGuestTags(ctx context.Context, guestName string) ([]model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestTags", ctx, guestName)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestTags indicates an expected call of GetGuestTags.
+func (mr *MockITagRepositoryMockRecorder) GetGuestTags(ctx, guestName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestTags", reflect.TypeOf((*MockITagRepository)(nil).GetGuestTags), ctx, guestName)
+}
+
+// GetTag mocks base method.
+func (m *MockITagRepository) GetTag(ctx context.Context, name string) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag", ctx, name)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockITagRepositoryMockRecorder) GetTag(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockITagRepository)(nil).GetTag), ctx, name)
+}
+
+// GetTags mocks base method.
+func (m *MockITagRepository) GetTags(ctx context.Context) ([]model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockITagRepositoryMockRecorder) GetTags(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockITagRepository)(nil).GetTags), ctx)
+}
+
+// UpdateTag mocks base method.
+func (m *MockITagRepository) UpdateTag(ctx context.Context, tag *model.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockITagRepositoryMockRecorder) UpdateTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockITagRepository)(nil).UpdateTag), ctx, tag)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/waitlist_repository_interface.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIWaitlistRepository is a mock of IWaitlistRepository interface.
+type MockIWaitlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWaitlistRepositoryMockRecorder
+}
+
+// MockIWaitlistRepositoryMockRecorder is the mock recorder for MockIWaitlistRepository.
+type MockIWaitlistRepositoryMockRecorder struct {
+	mock *MockIWaitlistRepository
+}
+
+// NewMockIWaitlistRepository creates a new mock instance.
+func NewMockIWaitlistRepository(ctrl *gomock.Controller) *MockIWaitlistRepository {
+	mock := &MockIWaitlistRepository{ctrl: ctrl}
+	mock.recorder = &MockIWaitlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWaitlistRepository) EXPECT() *MockIWaitlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockIWaitlistRepository) CreateEntry(ctx context.Context, params *model.WaitlistRequest) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, params)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockIWaitlistRepositoryMockRecorder) CreateEntry(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockIWaitlistRepository)(nil).CreateEntry), ctx, params)
+}
+
+// ExpireOffers mocks base method.
+func (m *MockIWaitlistRepository) ExpireOffers(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockIWaitlistRepositoryMockRecorder) ExpireOffers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockIWaitlistRepository)(nil).ExpireOffers), ctx)
+}
+
+// GetEntry mocks base method.
+func (m *MockIWaitlistRepository) GetEntry(ctx context.Context, id int) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockIWaitlistRepositoryMockRecorder) GetEntry(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockIWaitlistRepository)(nil).GetEntry), ctx, id)
+}
+
+// GetEntryByOfferToken mocks base method.
+func (m *MockIWaitlistRepository) GetEntryByOfferToken(ctx context.Context, token string) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntryByOfferToken", ctx, token)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntryByOfferToken indicates an expected call of GetEntryByOfferToken.
+func (mr *MockIWaitlistRepositoryMockRecorder) GetEntryByOfferToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntryByOfferToken", reflect.TypeOf((*MockIWaitlistRepository)(nil).GetEntryByOfferToken), ctx, token)
+}
+
+// GetOfferableSeats mocks base method.
+func (m *MockIWaitlistRepository) GetOfferableSeats(ctx context.Context) (map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfferableSeats", ctx)
+	ret0, _ := ret[0].(map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfferableSeats indicates an expected call of GetOfferableSeats.
+func (mr *MockIWaitlistRepositoryMockRecorder) GetOfferableSeats(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfferableSeats", reflect.TypeOf((*MockIWaitlistRepository)(nil).GetOfferableSeats), ctx)
+}
+
+// GetWaitlist mocks base method.
+func (m *MockIWaitlistRepository) GetWaitlist(ctx context.Context) ([]model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlist", ctx)
+	ret0, _ := ret[0].([]model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlist indicates an expected call of GetWaitlist.
+func (mr *MockIWaitlistRepositoryMockRecorder) GetWaitlist(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlist", reflect.TypeOf((*MockIWaitlistRepository)(nil).GetWaitlist), ctx)
+}
+
+// OfferEntry mocks base method.
+func (m *MockIWaitlistRepository) OfferEntry(ctx context.Context, entry *model.WaitlistEntry, table int, token string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferEntry", ctx, entry, table, token, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferEntry indicates an expected call of OfferEntry.
+func (mr *MockIWaitlistRepositoryMockRecorder) OfferEntry(ctx, entry, table, token, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferEntry", reflect.TypeOf((*MockIWaitlistRepository)(nil).OfferEntry), ctx, entry, table, token, ttl)
+}
+
+// UpdateEntry mocks base method.
+func (m *MockIWaitlistRepository) UpdateEntry(ctx context.Context, entry *model.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntry indicates an expected call of UpdateEntry.
+func (mr *MockIWaitlistRepositoryMockRecorder) UpdateEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockIWaitlistRepository)(nil).UpdateEntry), ctx, entry)
+}
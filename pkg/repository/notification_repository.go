@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Longest error message kept for a failed delivery, the size of the `last_error` column.
+const maxLastErrorLength = 1024
+
+/*
+MySQL implementation of a notification repository.
+
+Notifications are written to the `notification_outbox` table and delivered later by the
+outbox dispatcher, so a campaign is never lost because the SMTP server was down. Pending
+notifications are claimed with a random token before being sent, which lets several
+instances of the application share the outbox without sending the same email twice.
+*/
+type MySQLNotificationRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLNotificationRepository(connection *sql.DB, logger *slog.Logger) *MySQLNotificationRepository {
+	return &MySQLNotificationRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/**
+ * Retrieves the guests matching every field set in the segment, joining with the
+ * `seating` table to get their table. Guests without email are included, so the
+ * caller can report them.
+ * Errors while scanning a row are notified, but not handled.
+ *
+ * @param  segment  Segment of guests to retrieve
+ * @return          array of Recipient
+ */
+func (db *MySQLNotificationRepository) GetRecipients(ctx context.Context, segment model.Segment) ([]model.Recipient, error) {
+
+	sqlStatement := `
+		SELECT g.guest_id, g.name, IFNULL(g.email, ''), s.table_id, g.entourage, g.rsvp_status, IFNULL(g.invitation_token, '')
+		FROM guest as g
+		JOIN seating as s ON g.guest_id = s.guest_id
+		WHERE 1 = 1`
+	var args []interface{}
+	if segment.ArrivalStatus != "" {
+		sqlStatement += ` AND g.arrival_status = ?`
+		args = append(args, segment.ArrivalStatus)
+	}
+	if segment.RSVPStatus != "" {
+		sqlStatement += ` AND g.rsvp_status = ?`
+		args = append(args, segment.RSVPStatus)
+	}
+	if segment.Table != 0 {
+		sqlStatement += ` AND s.table_id = ?`
+		args = append(args, segment.Table)
+	}
+	sqlStatement += ` ORDER BY g.guest_id;`
+
+	ctx, span := startSpan(ctx, "MySQLNotificationRepository.GetRecipients", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var recipients []model.Recipient
+
+	for rows.Next() {
+		var r model.Recipient
+
+		err = rows.Scan(&r.GuestID, &r.Name, &r.Email, &r.Table, &r.Accompanying_guests, &r.RSVPStatus, &r.InvitationToken)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		recipients = append(recipients, r)
+	}
+	return recipients, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Inserts the notifications in the `notification_outbox` table as pending and due
+ * now, in a single transaction so a campaign is queued for all its recipients or none.
+ *
+ * @param  notifications  array of Notification to queue
+ */
+func (db *MySQLNotificationRepository) EnqueueNotifications(ctx context.Context, notifications []model.Notification) error {
+	sqlStatement := `
+		INSERT INTO notification_outbox (guest_id, recipient, kind, subject, text_body, html_body)
+		VALUES (NULLIF(?, 0), ?, ?, ?, ?, ?);
+	`
+	ctx, span := startSpan(ctx, "MySQLNotificationRepository.EnqueueNotifications", sqlStatement)
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, sqlStatement)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+	defer stmt.Close()
+
+	for _, n := range notifications {
+		_, err = stmt.ExecContext(ctx, n.GuestID, n.Recipient, n.Kind, n.Subject, n.TextBody, n.HTMLBody)
+		if err != nil {
+			return tracing.RecordError(span, err)
+		}
+	}
+
+	return tracing.RecordError(span, tx.Commit())
+}
+
+/**
+ * Claims the oldest pending notifications that are due by stamping them with a random
+ * token and moving their next attempt to the end of the lease, then reads them back by
+ * token. If the process dies while sending, the notifications are claimed again once
+ * the lease is over.
+ *
+ * @param  limit  maximum number of notifications to claim
+ * @param  lease  how long the notifications are hidden from other claims
+ * @return        array of claimed Notification
+ */
+func (db *MySQLNotificationRepository) ClaimDueNotifications(ctx context.Context, limit int, lease time.Duration) ([]model.Notification, error) {
+	claimStatement := `
+		UPDATE notification_outbox
+		SET claim_token = ?, next_attempt_at = DATE_ADD(NOW(), INTERVAL ? SECOND)
+		WHERE status = 'pending' AND next_attempt_at <= NOW()
+		ORDER BY notification_id
+		LIMIT ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLNotificationRepository.ClaimDueNotifications", claimStatement)
+	defer span.End()
+
+	token, err := newClaimToken()
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	res, err := db.Connection.ExecContext(ctx, claimStatement, token, int(lease.Seconds()), limit)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	rows, err := db.Connection.QueryContext(ctx, `
+		SELECT notification_id, IFNULL(guest_id, 0), recipient, kind, subject, text_body, html_body, status, attempts
+		FROM notification_outbox
+		WHERE claim_token = ?
+		ORDER BY notification_id;
+	`, token)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var notifications []model.Notification
+
+	for rows.Next() {
+		var n model.Notification
+
+		err = rows.Scan(&n.NotificationID, &n.GuestID, &n.Recipient, &n.Kind, &n.Subject, &n.TextBody, &n.HTMLBody, &n.Status, &n.Attempts)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		notifications = append(notifications, n)
+	}
+	return notifications, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Sets the notification as sent, recording the time of delivery.
+ *
+ * @param  id  id of the notification
+ */
+func (db *MySQLNotificationRepository) MarkNotificationSent(ctx context.Context, id int) error {
+	sqlStatement := `
+		UPDATE notification_outbox
+		SET status = 'sent', attempts = attempts + 1, sent_at = NOW(), last_error = NULL, claim_token = NULL
+		WHERE notification_id = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLNotificationRepository.MarkNotificationSent", sqlStatement)
+	defer span.End()
+
+	_, err := db.Connection.ExecContext(ctx, sqlStatement, id)
+	return tracing.RecordError(span, err)
+}
+
+/**
+ * Records a failed delivery and leaves the notification pending until retryIn has passed.
+ *
+ * @param  id         id of the notification
+ * @param  lastError  reason the delivery failed
+ * @param  retryIn    how long to wait before the next attempt
+ */
+func (db *MySQLNotificationRepository) RetryNotification(ctx context.Context, id int, lastError string, retryIn time.Duration) error {
+	sqlStatement := `
+		UPDATE notification_outbox
+		SET attempts = attempts + 1, last_error = ?, claim_token = NULL, next_attempt_at = DATE_ADD(NOW(), INTERVAL ? SECOND)
+		WHERE notification_id = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLNotificationRepository.RetryNotification", sqlStatement)
+	defer span.End()
+
+	_, err := db.Connection.ExecContext(ctx, sqlStatement, truncateError(lastError), int(retryIn.Seconds()), id)
+	return tracing.RecordError(span, err)
+}
+
+/**
+ * Records a failed delivery and sets the notification as failed, it won't be retried.
+ *
+ * @param  id         id of the notification
+ * @param  lastError  reason the delivery failed
+ */
+func (db *MySQLNotificationRepository) FailNotification(ctx context.Context, id int, lastError string) error {
+	sqlStatement := `
+		UPDATE notification_outbox
+		SET status = 'failed', attempts = attempts + 1, last_error = ?, claim_token = NULL
+		WHERE notification_id = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLNotificationRepository.FailNotification", sqlStatement)
+	defer span.End()
+
+	_, err := db.Connection.ExecContext(ctx, sqlStatement, truncateError(lastError), id)
+	return tracing.RecordError(span, err)
+}
+
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating claim token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func truncateError(message string) string {
+	if len(message) > maxLastErrorLength {
+		return message[:maxLastErrorLength]
+	}
+	return message
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `INotificationRepository` interface defines the methods used to select the recipients
+of a campaign and to manage the outbox the notifications are delivered from.
+*/
+type INotificationRepository interface {
+	// Retrieves the guests matching the segment, with what is needed to render their notifications.
+	GetRecipients(ctx context.Context, segment model.Segment) ([]model.Recipient, error)
+	// Adds the notifications to the outbox, all of them or none.
+	EnqueueNotifications(ctx context.Context, notifications []model.Notification) error
+	// Claims up to limit pending notifications that are due, hiding them from other claims for the lease.
+	ClaimDueNotifications(ctx context.Context, limit int, lease time.Duration) ([]model.Notification, error)
+	// Records the notification was delivered.
+	MarkNotificationSent(ctx context.Context, id int) error
+	// Records a failed delivery and schedules the notification to be retried.
+	RetryNotification(ctx context.Context, id int, lastError string, retryIn time.Duration) error
+	// Records a failed delivery and gives up on the notification.
+	FailNotification(ctx context.Context, id int, lastError string) error
+}
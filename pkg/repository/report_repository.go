@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+MySQL implementation of a report repository.
+
+It only reads data, the aggregation of the reports is left to the service layer.
+*/
+type MySQLReportRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLReportRepository(connection *sql.DB, logger *slog.Logger) *MySQLReportRepository {
+	return &MySQLReportRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/**
+ * Retrieves the guests that hold a seat and were not rejected at the door nor marked as
+ * no-shows, with their table, entourage and dietary restrictions. Guests that declined their invitation
+ * don't hold a seat, and are left out as well unless they turned up anyway.
+ * Errors while scanning a row are notified, but not handled.
+ *
+ * @return  array of CateringEntry ordered by table
+ */
+func (db *MySQLReportRepository) GetCateringEntries(ctx context.Context) ([]model.CateringEntry, error) {
+
+	sqlStatement := `
+		SELECT s.table_id, g.name, g.entourage, g.diet, g.diet_notes, g.allergies
+		FROM guest as g
+		JOIN seating as s ON g.guest_id = s.guest_id
+		WHERE NOT FIELD(g.arrival_status, 'rejected', 'no_show') AND (g.rsvp_status != 'declined' OR g.arrival_status != 'not_arrived')
+		ORDER BY s.table_id, g.name;
+	`
+	ctx, span := startSpan(ctx, "MySQLReportRepository.GetCateringEntries", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var entries []model.CateringEntry
+
+	for rows.Next() {
+		var entry model.CateringEntry
+
+		err = rows.Scan(&entry.Table, &entry.Name, &entry.Accompanying_guests, &entry.Diet, &entry.DietNotes, &entry.Allergies)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Retrieves the guests that turned up at the door, whether they were let in or not,
+ * ordered by arrival time.
+ * Errors while scanning a row are notified, but not handled.
+ *
+ * @return  array of ArrivalEntry ordered by arrival time
+ */
+func (db *MySQLReportRepository) GetArrivals(ctx context.Context) ([]model.ArrivalEntry, error) {
+
+	sqlStatement := `
+		SELECT g.arrived_at, g.arrival_status, g.entourage
+		FROM guest as g
+		WHERE g.arrived_at IS NOT NULL AND FIELD(g.arrival_status, 'arrived', 'left', 'rejected')
+		ORDER BY g.arrived_at;
+	`
+	ctx, span := startSpan(ctx, "MySQLReportRepository.GetArrivals", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var entries []model.ArrivalEntry
+
+	for rows.Next() {
+		var entry model.ArrivalEntry
+
+		err = rows.Scan(&entry.ArrivedAt, &entry.ArrivalStatus, &entry.Accompanying_guests)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Retrieves the capacity of every table along with its reserved and occupied seats.
+ * Seats are reserved as in the `seating_usage` view, by the guests expected that didn't decline
+ * and the guests arrived, and occupied by the guests that arrived and haven't left.
+ * Errors while scanning a row are notified, but not handled.
+ *
+ * @return  array of TableUtilization ordered by table, without the utilization
+ */
+func (db *MySQLReportRepository) GetTableUsage(ctx context.Context) ([]model.TableUtilization, error) {
+
+	sqlStatement := `
+		SELECT t.table_id,
+		       t.capacity,
+		       IFNULL(SUM(IF(g.arrival_status = 'arrived' OR (g.arrival_status = 'not_arrived' AND g.rsvp_status != 'declined'), g.entourage + 1, 0)), 0),
+		       IFNULL(SUM(IF(g.arrival_status = 'arrived', g.entourage + 1, 0)), 0)
+		FROM event_table as t
+		LEFT JOIN seating as s ON t.table_id = s.table_id
+		LEFT JOIN guest as g ON s.guest_id = g.guest_id
+		GROUP BY t.table_id, t.capacity
+		ORDER BY t.table_id;
+	`
+	ctx, span := startSpan(ctx, "MySQLReportRepository.GetTableUsage", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var tables []model.TableUtilization
+
+	for rows.Next() {
+		var table model.TableUtilization
+
+		err = rows.Scan(&table.Table, &table.Capacity, &table.ReservedSeats, &table.OccupiedSeats)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		tables = append(tables, table)
+	}
+	return tables, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Counts the guests per arrival status, leaving out those that declined their invitation
+ * and didn't turn up anyway, summing the entourage they came with and the entourage they
+ * were expected with. Guests that haven't arrived have no expected entourage yet.
+ * Errors while scanning a row are notified, but not handled.
+ *
+ * @return  array of AttendanceEntry, one per arrival status in use
+ */
+func (db *MySQLReportRepository) GetAttendance(ctx context.Context) ([]model.AttendanceEntry, error) {
+
+	sqlStatement := `
+		SELECT g.arrival_status, COUNT(*), IFNULL(SUM(g.entourage), 0), IFNULL(SUM(g.expected_entourage), 0)
+		FROM guest as g
+		WHERE g.rsvp_status != 'declined' OR g.arrival_status != 'not_arrived'
+		GROUP BY g.arrival_status;
+	`
+	ctx, span := startSpan(ctx, "MySQLReportRepository.GetAttendance", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var entries []model.AttendanceEntry
+
+	for rows.Next() {
+		var entry model.AttendanceEntry
+
+		err = rows.Scan(&entry.ArrivalStatus, &entry.Guests, &entry.Entourage, &entry.ExpectedEntourage)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, tracing.RecordError(span, rows.Err())
+}
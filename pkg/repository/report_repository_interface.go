@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IReportRepository` interface defines the queries reports are built from.
+*/
+type IReportRepository interface {
+	// Retrieves the seated guests that will be served a meal, ordered by table.
+	GetCateringEntries(ctx context.Context) ([]model.CateringEntry, error)
+	// Retrieves the guests that turned up at the door, ordered by arrival time.
+	GetArrivals(ctx context.Context) ([]model.ArrivalEntry, error)
+	// Retrieves the capacity, reserved and occupied seats of every table, ordered by table.
+	GetTableUsage(ctx context.Context) ([]model.TableUtilization, error)
+	// Counts the guests expected per arrival status, with their actual and expected entourage.
+	GetAttendance(ctx context.Context) ([]model.AttendanceEntry, error)
+}
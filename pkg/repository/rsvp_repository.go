@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+MySQL implementation of an RSVP repository.
+
+Invitations are not stored in a table of their own: they are the guests of the `guest`
+table looked up by their unique invitation token, joined with the `seating` table to
+know the table they were assigned to.
+*/
+type MySQLRSVPRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLRSVPRepository(connection *sql.DB, logger *slog.Logger) *MySQLRSVPRepository {
+	return &MySQLRSVPRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/**
+ * Retrieves the invitation of the guest with the given token.
+ * Returns a NotFound error if no guest has said token.
+ *
+ * @param  token  token of the invitation link
+ * @return        pointer to an instance of Invitation
+ */
+func (db *MySQLRSVPRepository) GetInvitation(ctx context.Context, token string) (*model.Invitation, error) {
+
+	var invitation model.Invitation
+	sqlStatement := `
+		SELECT g.guest_id, g.name, g.entourage, g.arrival_status, g.rsvp_status, g.version, s.table_id,
+			EXISTS (SELECT 1 FROM guest_tag as gt JOIN tag as t ON gt.tag_id = t.tag_id WHERE gt.guest_id = g.guest_id AND t.name = ?)
+		FROM guest as g
+		JOIN seating as s ON g.guest_id = s.guest_id
+		WHERE g.invitation_token = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLRSVPRepository.GetInvitation", sqlStatement)
+	defer span.End()
+
+	row := db.Connection.QueryRowContext(ctx, sqlStatement, model.VIPTag, token)
+	err := row.Scan(&invitation.GuestID, &invitation.Name, &invitation.Accompanying_guests, &invitation.ArrivalStatus, &invitation.RSVPStatus, &invitation.Version, &invitation.Table, &invitation.VIP)
+
+	return &invitation, tracing.RecordError(span, e.CheckDatabaseError(err, token, "token", "invitation"))
+}
+
+/**
+ * Stores the answer and the entourage of the invitation in the `guest` table, along
+ * with the time of the answer. As with guest updates, the record must still be at
+ * the version of the instance, otherwise a PreconditionFailed error is returned.
+ * On success the version of the instance is incremented.
+ *
+ * @param  invitation  pointer to Invitation
+ */
+func (db *MySQLRSVPRepository) UpdateRSVP(ctx context.Context, invitation *model.Invitation) error {
+	sqlStatement := `
+		UPDATE guest
+		SET
+			rsvp_status = ?,
+			entourage = ?,
+			rsvp_at = NOW(),
+			version = version + 1
+		WHERE
+			guest_id = ? AND version = ?
+	`
+	ctx, span := startSpan(ctx, "MySQLRSVPRepository.UpdateRSVP", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, invitation.RSVPStatus, invitation.Accompanying_guests, invitation.GuestID, invitation.Version)
+	if err != nil {
+		return tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(invitation.GuestID), "guestID", "guest"))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewPreconditionFailedError("invitation", invitation.Version))
+	}
+
+	invitation.Version++
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IRSVPRepository` interface defines the methods used to answer invitations through their link.
+*/
+type IRSVPRepository interface {
+	// Retrieves the invitation of the guest with the given invitation token.
+	GetInvitation(ctx context.Context, token string) (*model.Invitation, error)
+	// Stores the answer and entourage of an invitation, if it wasn't modified since it was read.
+	UpdateRSVP(ctx context.Context, invitation *model.Invitation) error
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/VividCortex/mysqlerr"
+	"github.com/go-sql-driver/mysql"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+MySQL implementation of a station repository.
+
+Stations are stored in the `station` table. Every change of the arrival of a guest in
+`guest_status_change` records the station and staff member of the request that made it,
+taken from its context, so what went through a station is read from that table.
+*/
+type MySQLStationRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLStationRepository(connection *sql.DB, logger *slog.Logger) *MySQLStationRepository {
+	return &MySQLStationRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/**
+ * Retrieves a station from the `station` table using its id.
+ * Returns a NotFound error if no station has said id.
+ *
+ * @param  id  id of the station to fetch
+ * @return     pointer to an instance of Station
+ */
+func (db *MySQLStationRepository) GetStation(ctx context.Context, id string) (*model.Station, error) {
+
+	var station model.Station
+	sqlStatement := `SELECT station_id, name, created_at FROM station WHERE station_id = ?;`
+
+	ctx, span := startSpan(ctx, "MySQLStationRepository.GetStation", sqlStatement)
+	defer span.End()
+
+	err := db.Connection.QueryRowContext(ctx, sqlStatement, id).Scan(&station.StationID, &station.Name, &station.CreatedAt)
+
+	return &station, tracing.RecordError(span, e.CheckDatabaseError(err, id, "id", "station"))
+}
+
+/**
+ * Inserts a new record in the `station` table and returns it.
+ * If a station with the same id exists, an AlreadyExists error is returned.
+ *
+ * @param  station  pointer to Station with the id and name of the station
+ * @return          pointer to the instance of Station created
+ */
+func (db *MySQLStationRepository) CreateStation(ctx context.Context, station *model.Station) (*model.Station, error) {
+	sqlStatement := `INSERT INTO station (station_id, name) VALUES(?, ?);`
+
+	ctx, span := startSpan(ctx, "MySQLStationRepository.CreateStation", sqlStatement)
+	defer span.End()
+
+	_, err := db.Connection.ExecContext(ctx, sqlStatement, station.StationID, station.Name)
+	if err != nil {
+		return nil, tracing.RecordError(span, e.CheckDatabaseError(err, station.StationID, "id", "station"))
+	}
+
+	return db.GetStation(ctx, station.StationID)
+}
+
+/**
+ * Inserts a record in the `station` table unless one has the same id, in which case it is left as is.
+ *
+ * @param  station  pointer to Station with the id and name of the station
+ */
+func (db *MySQLStationRepository) RegisterStation(ctx context.Context, station *model.Station) error {
+	sqlStatement := `INSERT IGNORE INTO station (station_id, name) VALUES(?, ?);`
+
+	ctx, span := startSpan(ctx, "MySQLStationRepository.RegisterStation", sqlStatement)
+	defer span.End()
+
+	_, err := db.Connection.ExecContext(ctx, sqlStatement, station.StationID, station.Name)
+	return tracing.RecordError(span, err)
+}
+
+/**
+ * Returns every station registered in `station`, ordered by id, with the changes of
+ * `guest_status_change` made at it that weren't undone. Only the first arrival of a
+ * guest counts, so changing the entourage of a guest already in isn't another arrival.
+ * The arrival times are those of the guests, which for the operations synced by a
+ * door station are the times the door let them in.
+ *
+ * @return  array of StationThroughput, without ArrivalsPerHour
+ */
+func (db *MySQLStationRepository) GetThroughput(ctx context.Context) ([]model.StationThroughput, error) {
+	sqlStatement := `
+		SELECT
+			s.station_id, s.name, s.created_at,
+			COUNT(IF(c.to_status = 'arrived', 1, NULL)),
+			IFNULL(SUM(IF(c.to_status = 'arrived', c.to_entourage + 1, 0)), 0),
+			COUNT(IF(c.to_status = 'left', 1, NULL)),
+			COUNT(IF(c.to_status = 'rejected', 1, NULL)),
+			COUNT(IF(c.to_status = 'arrived' AND c.to_arrived_at >= NOW() - INTERVAL 1 HOUR, 1, NULL)),
+			MIN(IF(c.to_status = 'arrived', c.to_arrived_at, NULL)),
+			MAX(IF(c.to_status = 'arrived', c.to_arrived_at, NULL))
+		FROM station as s
+		LEFT JOIN guest_status_change as c
+			ON c.station_id = s.station_id AND c.reverted_at IS NULL AND c.from_status <> c.to_status
+		GROUP BY s.station_id, s.name, s.created_at
+		ORDER BY s.station_id;
+	`
+	ctx, span := startSpan(ctx, "MySQLStationRepository.GetThroughput", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	stations := []model.StationThroughput{}
+	for rows.Next() {
+		var station model.StationThroughput
+		var first, last sql.NullString
+		if err := rows.Scan(&station.StationID, &station.Name, &station.CreatedAt, &station.Arrivals, &station.People,
+			&station.Departures, &station.Rejections, &station.ArrivalsLastHour, &first, &last); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		station.FirstArrivalAt = nullString(first)
+		station.LastArrivalAt = nullString(last)
+		stations = append(stations, station)
+	}
+
+	return stations, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Returns the arrivals, departures and rejections of `guest_status_change` made at the station,
+ * newest first, including those that were undone.
+ *
+ * @param  id      id of the station
+ * @param  before  only changes with a lower id, to page through older changes, or 0 for the newest
+ * @param  limit   maximum number of changes returned
+ * @return         array of StationActivity
+ */
+func (db *MySQLStationRepository) GetActivity(ctx context.Context, id string, before int, limit int) ([]model.StationActivity, error) {
+	sqlStatement := `
+		SELECT c.change_id, c.guest_id, g.name, c.to_status, c.to_entourage, IFNULL(c.staff, ''), c.changed_at, c.reverted_at
+		FROM guest_status_change as c
+		JOIN guest as g ON g.guest_id = c.guest_id
+		WHERE c.station_id = ? AND c.to_status IN ('arrived', 'left', 'rejected') AND (? = 0 OR c.change_id < ?)
+		ORDER BY c.change_id DESC
+		LIMIT ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLStationRepository.GetActivity", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, id, before, before, limit)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	activity := []model.StationActivity{}
+	for rows.Next() {
+		var entry model.StationActivity
+		var revertedAt sql.NullString
+		if err := rows.Scan(&entry.ChangeID, &entry.GuestID, &entry.Name, &entry.ArrivalStatus, &entry.Accompanying_guests,
+			&entry.Staff, &entry.ChangedAt, &revertedAt); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		entry.RevertedAt = revertedAt.String
+		activity = append(activity, entry)
+	}
+
+	return activity, tracing.RecordError(span, rows.Err())
+}
+
+/*
+`checkStationError` turns the error of recording a change at a station that isn't registered,
+which the foreign key of `guest_status_change` rejects, into a BadInput error.
+*/
+func checkStationError(ctx context.Context, err error) error {
+	if driverErr, ok := err.(*mysql.MySQLError); ok && driverErr.Number == mysqlerr.ER_NO_REFERENCED_ROW_2 {
+		return e.NewBadInputError(fmt.Sprintf("station %s is not registered", model.CheckpointFrom(ctx).Station))
+	}
+	return err
+}
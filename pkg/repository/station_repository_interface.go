@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+This is an interface `IStationRepository` for database logic regarding the stations at the entrances
+of the event and the guests that went through them.
+*/
+type IStationRepository interface {
+	// This method retrieves a single station by its id.
+	GetStation(ctx context.Context, id string) (*model.Station, error)
+	// This method creates a new station.
+	CreateStation(ctx context.Context, station *model.Station) (*model.Station, error)
+	// This method creates the station unless it exists already.
+	RegisterStation(ctx context.Context, station *model.Station) error
+	// This method retrieves every station with the guests that went through it.
+	GetThroughput(ctx context.Context) ([]model.StationThroughput, error)
+	// This method retrieves the latest guests let in, turned away or out at a station.
+	GetActivity(ctx context.Context, id string, before int, limit int) ([]model.StationActivity, error)
+}
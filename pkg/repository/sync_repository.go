@@ -0,0 +1,561 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+MySQL implementation of a sync repository.
+
+The operation log of a door station is its `guest_status_change` table: every arrival and departure
+is already recorded there in the transaction that makes it, so check-ins are never lost while the
+central instance can't be reached. The central instance keeps the operations it applied in the
+`sync_operation` table, by door station, so operations sent again are only applied once.
+*/
+type MySQLSyncRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLSyncRepository(connection *sql.DB, logger *slog.Logger) *MySQLSyncRepository {
+	return &MySQLSyncRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/*
+`ResolveFunc` decides what an operation of a door station does to the guest, given the free seats left
+at their table, by updating the arrival of the guest and returning the outcome of the operation.
+The guest is nil when there is no guest with the name of the operation.
+*/
+type ResolveFunc func(guest *model.Guest, operation model.DoorOperation, freeSeats int) model.SyncResult
+
+/**
+ * Applies the operations of a door station in a single transaction, in the order given. The guests
+ * and their tables are locked, and the free seats of each table are kept up to date as each operation
+ * is applied. Changes to the guests are recorded in `guest_status_change` like any other, and the
+ * outcome of each operation is kept in `sync_operation`. Operations the door station synced before
+ * aren't applied again, they are answered with the outcome they had. Returns a BadInput error if
+ * the door station isn't registered.
+ *
+ * @param   station     door station that recorded the operations
+ * @param   operations  operations of the door station, in the order they were recorded
+ * @param   resolve     decides what each operation does
+ * @return              array with the SyncResult of each operation, in order
+ */
+func (db *MySQLSyncRepository) ApplyOperations(ctx context.Context, station string, operations []model.DoorOperation, resolve ResolveFunc) ([]model.SyncResult, error) {
+	ctx, span := startSpan(ctx, "MySQLSyncRepository.ApplyOperations", "SELECT station; SELECT sync_operation FOR UPDATE; SELECT guest FOR UPDATE; SELECT event_table FOR UPDATE; UPDATE guest; INSERT sync_operation")
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	// Only registered door stations sync, the station is locked so it isn't deleted meanwhile
+	var registered int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM station WHERE station_id = ? LOCK IN SHARE MODE;`, station).Scan(&registered)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	if registered == 0 {
+		return nil, tracing.RecordError(span, e.NewBadInputError(fmt.Sprintf("station %s is not registered", station)))
+	}
+
+	ids := make([]string, len(operations))
+	for i, operation := range operations {
+		ids[i] = operation.OperationID
+	}
+	in, args := inList(ids)
+	rows, err := tx.QueryContext(ctx, `
+		SELECT operation_id, result, IFNULL(conflict, ''), detail
+		FROM sync_operation
+		WHERE station = ? AND operation_id IN (`+in+`)
+		FOR UPDATE;
+	`, append([]interface{}{station}, args...)...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	synced := make(map[string]model.SyncResult)
+	for rows.Next() {
+		var result model.SyncResult
+		if err := rows.Scan(&result.OperationID, &result.Result, &result.Conflict, &result.Detail); err != nil {
+			rows.Close()
+			return nil, tracing.RecordError(span, err)
+		}
+		synced[result.OperationID] = result
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	var names []string
+	for _, operation := range operations {
+		if _, ok := synced[operation.OperationID]; !ok {
+			names = append(names, operation.Name)
+		}
+	}
+
+	byName := make(map[string]*model.Guest)
+	tableOf := make(map[int]int)
+	var tableIDs []int
+	if len(names) > 0 {
+		in, args := inList(names)
+		rows, err := tx.QueryContext(ctx, `
+			SELECT `+guestColumns+`, IFNULL(s.table_id, 0)
+			FROM guest
+			LEFT JOIN seating as s ON guest.guest_id = s.guest_id
+			WHERE guest.name IN (`+in+`)
+			FOR UPDATE;
+		`, args...)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		for rows.Next() {
+			guest := &model.Guest{}
+			var tableID int
+			if err := scanGuest(rows, guest, &tableID); err != nil {
+				rows.Close()
+				return nil, tracing.RecordError(span, err)
+			}
+			byName[guest.Name] = guest
+			tableOf[guest.GuestID] = tableID
+			if tableID != 0 {
+				tableIDs = append(tableIDs, tableID)
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+	}
+
+	freeSeats, err := lockFreeSeats(ctx, tx, tableIDs)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	results := make([]model.SyncResult, len(operations))
+	for i, operation := range operations {
+		if result, ok := synced[operation.OperationID]; ok {
+			results[i] = result
+			continue
+		}
+
+		// Guests without a table have no free seats to take
+		var guestID interface{}
+		guest := byName[operation.Name]
+		if guest == nil {
+			results[i] = resolve(nil, operation, 0)
+		} else {
+			guestID = guest.GuestID
+			tableID := tableOf[guest.GuestID]
+			before := *guest
+			results[i] = resolve(guest, operation, freeSeats[tableID])
+
+			if before.ArrivalStatus != guest.ArrivalStatus || before.Entourage != guest.Entourage || before.ArrivalTime() != guest.ArrivalTime() {
+				if tableID != 0 {
+					freeSeats[tableID] -= seatsTaken(guest) - seatsTaken(&before)
+				}
+				checkpoint := model.Checkpoint{Station: station, Staff: operation.Staff}
+				if err := updateArrival(model.WithCheckpoint(ctx, checkpoint), tx, guest); err != nil {
+					return nil, tracing.RecordError(span, err)
+				}
+			}
+		}
+
+		var conflict interface{}
+		if results[i].Conflict != "" {
+			conflict = results[i].Conflict
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO sync_operation (station, operation_id, guest_id, name, kind, accompanying_guests, recorded_at, staff, result, conflict, detail)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+		`, station, operation.OperationID, guestID, operation.Name, operation.Kind, operation.Accompanying_guests, operation.RecordedAt, operation.Staff,
+			results[i].Result, conflict, results[i].Detail)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+
+		// An operation listed twice in the batch is only applied once
+		synced[operation.OperationID] = results[i]
+	}
+
+	return results, tracing.RecordError(span, tx.Commit())
+}
+
+/**
+ * Retrieves from the `sync_operation` table the operations that conflicted with the central
+ * instance, in the order they were synced. When a door station is given, only its operations
+ * are returned.
+ *
+ * @param   station  door station to filter by, or empty for all of them
+ * @return           array of SyncConflict
+ */
+func (db *MySQLSyncRepository) GetConflicts(ctx context.Context, station string) ([]model.SyncConflict, error) {
+	sqlStatement := `
+		SELECT station, operation_id, name, kind, accompanying_guests, IFNULL(recorded_at, ''), staff, conflict, detail, synced_at
+		FROM sync_operation
+		WHERE result = 'conflict' AND (? = '' OR station = ?)
+		ORDER BY synced_at, station, operation_id;
+	`
+	ctx, span := startSpan(ctx, "MySQLSyncRepository.GetConflicts", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, station, station)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	conflicts := []model.SyncConflict{}
+	for rows.Next() {
+		var c model.SyncConflict
+		err := rows.Scan(&c.Station, &c.OperationID, &c.Name, &c.Kind, &c.Accompanying_guests, &c.RecordedAt, &c.Staff, &c.Conflict, &c.Detail, &c.SyncedAt)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		conflicts = append(conflicts, c)
+	}
+	return conflicts, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Retrieves every table, and every guest with their table, arrival and tags, in a single
+ * read-only transaction so the guests and tables are consistent with each other.
+ *
+ * @return  pointer to the Snapshot
+ */
+func (db *MySQLSyncRepository) GetSnapshot(ctx context.Context) (*model.Snapshot, error) {
+	ctx, span := startSpan(ctx, "MySQLSyncRepository.GetSnapshot", "SELECT event_table; SELECT guest")
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT table_id, capacity, version, created_at, updated_at FROM event_table ORDER BY table_id;`)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	snapshot := &model.Snapshot{Tables: []model.EventTable{}, Guests: []model.SnapshotGuest{}}
+	for rows.Next() {
+		var eTable model.EventTable
+		if err := rows.Scan(&eTable.TableID, &eTable.Capacity, &eTable.Version, &eTable.CreatedAt, &eTable.UpdatedAt); err != nil {
+			rows.Close()
+			return nil, tracing.RecordError(span, err)
+		}
+		snapshot.Tables = append(snapshot.Tables, eTable)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	rows, err = tx.QueryContext(ctx, `
+		SELECT g.guest_id, g.name, IFNULL(s.table_id, 0), g.entourage, g.expected_entourage, g.arrival_status, g.arrived_at,
+			g.rsvp_status, g.rsvp_at, IFNULL(g.invitation_token, ''), g.version, g.pass_version, g.created_at,
+			IFNULL(g.email, ''), g.phone, g.diet, g.diet_notes, g.allergies, g.accessibility_needs, IFNULL(g.notes, ''),
+			(SELECT GROUP_CONCAT(t.name ORDER BY t.name) FROM guest_tag as gt JOIN tag as t ON gt.tag_id = t.tag_id WHERE gt.guest_id = g.guest_id)
+		FROM guest as g
+		LEFT JOIN seating as s ON g.guest_id = s.guest_id
+		ORDER BY g.guest_id;
+	`)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var guest model.SnapshotGuest
+		var expectedEntourage sql.NullInt64
+		var arrivedAt, rsvpAt, tags sql.NullString
+		err := rows.Scan(&guest.GuestID, &guest.Name, &guest.Table, &guest.Accompanying_guests, &expectedEntourage, &guest.ArrivalStatus, &arrivedAt,
+			&guest.RSVPStatus, &rsvpAt, &guest.InvitationToken, &guest.Version, &guest.PassVersion, &guest.CreatedAt,
+			&guest.Email, &guest.Phone, &guest.Diet, &guest.DietNotes, &guest.Allergies, &guest.AccessibilityNeeds, &guest.Notes, &tags)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		guest.ExpectedEntourage = nullInt(expectedEntourage)
+		guest.ArrivedAt = nullString(arrivedAt)
+		guest.RSVPAt = nullString(rsvpAt)
+		guest.Tags = []string{}
+		if tags.Valid && tags.String != "" {
+			guest.Tags = strings.Split(tags.String, ",")
+		}
+		snapshot.Guests = append(snapshot.Guests, guest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	return snapshot, tracing.RecordError(span, tx.Commit())
+}
+
+// Condition on the `guest_status_change` table aliased as c selecting the operations not synced yet.
+// Rejections, no-shows and changes that were undone are left out, they aren't operations of the door.
+const pendingOperation = `c.synced_at IS NULL AND c.reverted_at IS NULL AND c.to_status IN ('arrived', 'left')`
+
+/**
+ * Retrieves from the `guest_status_change` table the arrivals and departures that weren't
+ * synced yet, oldest first.
+ *
+ * @param   limit  maximum number of operations returned
+ * @return         array of DoorOperation in the order they were recorded
+ */
+func (db *MySQLSyncRepository) GetPendingOperations(ctx context.Context, limit int) ([]model.DoorOperation, error) {
+	sqlStatement := `
+		SELECT c.operation_id, g.name, IF(c.to_status = 'arrived', 'arrive', 'leave'), c.to_entourage, c.changed_at, IFNULL(c.staff, '')
+		FROM guest_status_change as c
+		JOIN guest as g ON c.guest_id = g.guest_id
+		WHERE ` + pendingOperation + `
+		ORDER BY c.change_id
+		LIMIT ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLSyncRepository.GetPendingOperations", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, limit)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var operations []model.DoorOperation
+	for rows.Next() {
+		var operation model.DoorOperation
+		if err := rows.Scan(&operation.OperationID, &operation.Name, &operation.Kind, &operation.Accompanying_guests, &operation.RecordedAt, &operation.Staff); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		operations = append(operations, operation)
+	}
+	return operations, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Marks the changes of `guest_status_change` with the given operation ids as synced.
+ *
+ * @param  ids  ids of the operations synced
+ */
+func (db *MySQLSyncRepository) MarkOperationsSynced(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	in, args := inList(ids)
+	sqlStatement := `UPDATE guest_status_change SET synced_at = CURRENT_TIMESTAMP WHERE operation_id IN (` + in + `);`
+
+	ctx, span := startSpan(ctx, "MySQLSyncRepository.MarkOperationsSynced", sqlStatement)
+	defer span.End()
+
+	_, err := db.Connection.ExecContext(ctx, sqlStatement, args...)
+	return tracing.RecordError(span, err)
+}
+
+/**
+ * Replaces the tables and guests with those of the snapshot in a single transaction, without
+ * recording the changes as operations. Tables and guests missing from the snapshot are deleted.
+ * Guests with operations not synced yet are left as they were, the next sync sends those operations first.
+ * Every guest is locked before looking for their operations, so an arrival being recorded
+ * meanwhile is either waited for or made after the guest is replaced.
+ *
+ * @param   snapshot  pointer to the Snapshot of the central instance
+ * @return            number of guests left as they were for having operations not synced
+ */
+func (db *MySQLSyncRepository) ApplySnapshot(ctx context.Context, snapshot *model.Snapshot) (int, error) {
+	ctx, span := startSpan(ctx, "MySQLSyncRepository.ApplySnapshot", "SELECT guest FOR UPDATE; INSERT event_table; INSERT guest; INSERT seating; INSERT guest_tag; DELETE guest")
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	type localGuest struct {
+		id   int
+		name string
+	}
+	var local []localGuest
+	locked, err := tx.QueryContext(ctx, `SELECT guest_id, name FROM guest ORDER BY guest_id FOR UPDATE;`)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+	for locked.Next() {
+		var guest localGuest
+		if err := locked.Scan(&guest.id, &guest.name); err != nil {
+			locked.Close()
+			return 0, tracing.RecordError(span, err)
+		}
+		local = append(local, guest)
+	}
+	locked.Close()
+	if err := locked.Err(); err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT DISTINCT g.name
+		FROM guest_status_change as c
+		JOIN guest as g ON c.guest_id = g.guest_id
+		WHERE `+pendingOperation+`;
+	`)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+	pending := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return 0, tracing.RecordError(span, err)
+		}
+		pending[name] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	// Tables
+	tableIDs := make([]int, len(snapshot.Tables))
+	for i, eTable := range snapshot.Tables {
+		tableIDs[i] = eTable.TableID
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_table (table_id, capacity, version) VALUES (?, ?, ?)
+			ON DUPLICATE KEY UPDATE capacity = VALUES(capacity), version = VALUES(version);
+		`, eTable.TableID, eTable.Capacity, eTable.Version)
+		if err != nil {
+			return 0, tracing.RecordError(span, err)
+		}
+	}
+	deleteTables, deleteArgs := `DELETE FROM event_table;`, []interface{}{}
+	if len(tableIDs) > 0 {
+		in, args := inList(tableIDs)
+		deleteTables, deleteArgs = `DELETE FROM event_table WHERE table_id NOT IN (`+in+`);`, args
+	}
+	if _, err := tx.ExecContext(ctx, deleteTables, deleteArgs...); err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	// Tags, created here when they were only created at the central instance
+	tags := make(map[string]bool)
+	for _, guest := range snapshot.Guests {
+		for _, tag := range guest.Tags {
+			if !tags[tag] {
+				tags[tag] = true
+				if _, err := tx.ExecContext(ctx, `INSERT IGNORE INTO tag (name) VALUES (?);`, tag); err != nil {
+					return 0, tracing.RecordError(span, err)
+				}
+			}
+		}
+	}
+
+	// Guests
+	skipped := 0
+	inSnapshot := make(map[int]bool)
+	for _, guest := range snapshot.Guests {
+		inSnapshot[guest.GuestID] = true
+		if pending[guest.Name] {
+			skipped++
+			continue
+		}
+		if err := replaceGuest(ctx, tx, &guest); err != nil {
+			return 0, tracing.RecordError(span, err)
+		}
+	}
+
+	// Guests deleted at the central instance, or that only ever existed here, along with their seating and tags
+	var missing []int
+	for _, guest := range local {
+		if !inSnapshot[guest.id] && !pending[guest.name] {
+			missing = append(missing, guest.id)
+		}
+	}
+	if len(missing) > 0 {
+		in, args := inList(missing)
+		if _, err := tx.ExecContext(ctx, `DELETE FROM guest WHERE guest_id IN (`+in+`);`, args...); err != nil {
+			return 0, tracing.RecordError(span, err)
+		}
+	}
+
+	return skipped, tracing.RecordError(span, tx.Commit())
+}
+
+// Replaces the guest with the one of the snapshot, along with their table and tags.
+// Passes used at either instance stay used, the highest pass version is kept.
+// `updated_at` is left to MySQL, as it tracks when the guest was written here.
+func replaceGuest(ctx context.Context, tx *sql.Tx, guest *model.SnapshotGuest) error {
+	// A guest added here under a name the central instance gave to another guest is replaced by theirs
+	if _, err := tx.ExecContext(ctx, `DELETE FROM guest WHERE name = ? AND guest_id <> ?;`, guest.Name, guest.GuestID); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO guest (guest_id, name, entourage, expected_entourage, arrival_status, arrived_at, rsvp_status, rsvp_at, invitation_token,
+			version, pass_version, created_at, email, phone, diet, diet_notes, allergies, accessibility_needs, notes)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULLIF(?, ''), ?, ?, ?, NULLIF(?, ''), ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			name = VALUES(name),
+			entourage = VALUES(entourage),
+			expected_entourage = VALUES(expected_entourage),
+			arrival_status = VALUES(arrival_status),
+			arrived_at = VALUES(arrived_at),
+			rsvp_status = VALUES(rsvp_status),
+			rsvp_at = VALUES(rsvp_at),
+			invitation_token = VALUES(invitation_token),
+			version = VALUES(version),
+			pass_version = GREATEST(pass_version, VALUES(pass_version)),
+			created_at = VALUES(created_at),
+			email = VALUES(email),
+			phone = VALUES(phone),
+			diet = VALUES(diet),
+			diet_notes = VALUES(diet_notes),
+			allergies = VALUES(allergies),
+			accessibility_needs = VALUES(accessibility_needs),
+			notes = VALUES(notes);
+	`, guest.GuestID, guest.Name, guest.Accompanying_guests, guest.ExpectedEntourage, guest.ArrivalStatus, guest.ArrivedAt, guest.RSVPStatus, guest.RSVPAt,
+		guest.InvitationToken, guest.Version, guest.PassVersion, guest.CreatedAt,
+		guest.Email, guest.Phone, guest.Diet, guest.DietNotes, guest.Allergies, guest.AccessibilityNeeds, guest.Notes)
+	if err != nil {
+		return err
+	}
+
+	if guest.Table == 0 {
+		_, err = tx.ExecContext(ctx, `DELETE FROM seating WHERE guest_id = ?;`, guest.GuestID)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO seating (guest_id, table_id) VALUES (?, ?)
+			ON DUPLICATE KEY UPDATE table_id = VALUES(table_id);
+		`, guest.GuestID, guest.Table)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM guest_tag WHERE guest_id = ?;`, guest.GuestID); err != nil {
+		return err
+	}
+	if len(guest.Tags) == 0 {
+		return nil
+	}
+	in, args := inList(guest.Tags)
+	_, err = tx.ExecContext(ctx, `INSERT INTO guest_tag (guest_id, tag_id) SELECT ?, tag_id FROM tag WHERE name IN (`+in+`);`,
+		append([]interface{}{guest.GuestID}, args...)...)
+	return err
+}
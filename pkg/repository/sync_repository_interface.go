@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `ISyncRepository` interface defines the database logic of syncing door stations with the central instance.
+The central instance applies the operations of the door stations and serves them its snapshot, while each
+door station reads its operation log and replaces its copy of the tables and guests with the snapshot.
+*/
+type ISyncRepository interface {
+	// Applies the operations of a door station in a single transaction, deciding with resolve what each one does.
+	ApplyOperations(ctx context.Context, station string, operations []model.DoorOperation, resolve ResolveFunc) ([]model.SyncResult, error)
+	// Retrieves the operations that conflicted with the central instance, optionally only those of a door station.
+	GetConflicts(ctx context.Context, station string) ([]model.SyncConflict, error)
+	// Retrieves the tables and guests as of a single point in time.
+	GetSnapshot(ctx context.Context) (*model.Snapshot, error)
+	// Retrieves the operations of the operation log that weren't synced yet, in the order they were recorded.
+	GetPendingOperations(ctx context.Context, limit int) ([]model.DoorOperation, error)
+	// Marks the operations of the operation log with the given ids as synced.
+	MarkOperationsSynced(ctx context.Context, ids []string) error
+	// Replaces the tables and guests with those of the snapshot, deleting those missing from it, except the guests with operations not synced yet.
+	ApplySnapshot(ctx context.Context, snapshot *model.Snapshot) (int, error)
+}
@@ -1,12 +1,14 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 
 	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
 )
 
 /*
@@ -17,11 +19,13 @@ level to handle.
 */
 type MySQLEventTableRepository struct {
 	Connection *sql.DB
+	logger     *slog.Logger
 }
 
-func NewMySQLEventTableRepository(connection *sql.DB) *MySQLEventTableRepository {
+func NewMySQLEventTableRepository(connection *sql.DB, logger *slog.Logger) *MySQLEventTableRepository {
 	return &MySQLEventTableRepository{
 		Connection: connection,
+		logger:     logger,
 	}
 }
 
@@ -32,10 +36,18 @@ func NewMySQLEventTableRepository(connection *sql.DB) *MySQLEventTableRepository
  *
  * @return      array of event tables
  */
-func (db *MySQLEventTableRepository) GetTables() ([]model.EventTable, error) {
+func (db *MySQLEventTableRepository) GetTables(ctx context.Context) ([]model.EventTable, error) {
 
-	sqlStatement := `SELECT * FROM event_table;`
-	rows, err := db.Connection.Query(sqlStatement)
+	sqlStatement := `SELECT table_id, capacity, version, created_at, updated_at FROM event_table;`
+
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.GetTables", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
 
 	var tables []model.EventTable
 
@@ -43,15 +55,15 @@ func (db *MySQLEventTableRepository) GetTables() ([]model.EventTable, error) {
 	for rows.Next() {
 		var eTable model.EventTable
 
-		err = rows.Scan(&eTable.TableID, &eTable.Capacity, &eTable.CreatedAt, &eTable.UpdatedAt)
+		err = rows.Scan(&eTable.TableID, &eTable.Capacity, &eTable.Version, &eTable.CreatedAt, &eTable.UpdatedAt)
 
 		if err != nil {
-			log.Print("[ERROR] ", err.Error())
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
 		}
 
 		tables = append(tables, eTable)
 	}
-	return tables, nil
+	return tables, tracing.RecordError(span, rows.Err())
 }
 
 /**
@@ -63,16 +75,62 @@ func (db *MySQLEventTableRepository) GetTables() ([]model.EventTable, error) {
  * @param  id  id of the event table to fetch
  * @return     pointer to the instance of EventTable
  */
-func (db *MySQLEventTableRepository) GetTable(id int) (*model.EventTable, error) {
+func (db *MySQLEventTableRepository) GetTable(ctx context.Context, id int) (*model.EventTable, error) {
 
 	var eTable model.EventTable
-	sqlStatement := `SELECT * FROM event_table WHERE table_id = ?;`
+	sqlStatement := `
+		SELECT table_id, capacity, version, created_at, updated_at
+		FROM event_table
+		WHERE table_id = ?;
+	`
+
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.GetTable", sqlStatement)
+	defer span.End()
 
 	// Fetch record where the id matches
-	row := db.Connection.QueryRow(sqlStatement, id)
-	err := row.Scan(&eTable.TableID, &eTable.Capacity, &eTable.CreatedAt, &eTable.UpdatedAt)
+	row := db.Connection.QueryRowContext(ctx, sqlStatement, id)
+	err := row.Scan(&eTable.TableID, &eTable.Capacity, &eTable.Version, &eTable.CreatedAt, &eTable.UpdatedAt)
 
-	return &eTable, e.CheckDatabaseError(err, fmt.Sprint(id), "tableID", "table")
+	return &eTable, tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(id), "tableID", "table"))
+}
+
+/**
+ * Retrieves the records from `event_table` with any of the ids passed in the parameters,
+ * in a single query. Ids without a table are left out of the result instead of being an error.
+ *
+ * @param  ids  ids of the event tables to fetch
+ * @return      array of event tables
+ */
+func (db *MySQLEventTableRepository) GetTablesByID(ctx context.Context, ids []int) ([]model.EventTable, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	in, args := inList(ids)
+	sqlStatement := `
+		SELECT table_id, capacity, version, created_at, updated_at
+		FROM event_table
+		WHERE table_id IN (` + in + `);
+	`
+
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.GetTablesByID", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	var tables []model.EventTable
+	for rows.Next() {
+		var eTable model.EventTable
+		if err := rows.Scan(&eTable.TableID, &eTable.Capacity, &eTable.Version, &eTable.CreatedAt, &eTable.UpdatedAt); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		tables = append(tables, eTable)
+	}
+	return tables, tracing.RecordError(span, rows.Err())
 }
 
 /**
@@ -82,24 +140,65 @@ func (db *MySQLEventTableRepository) GetTable(id int) (*model.EventTable, error)
  * @param  table pointer to instance of EventTable with data to use in insertion
  * @return       pointer to instance of EventTable with TableID added
  */
-func (db *MySQLEventTableRepository) CreateTable(table *model.EventTable) (*model.EventTable, error) {
+func (db *MySQLEventTableRepository) CreateTable(ctx context.Context, table *model.EventTable) (*model.EventTable, error) {
+	sqlStatement := `INSERT INTO event_table (capacity) VALUES(?);`
+
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.CreateTable", sqlStatement)
+	defer span.End()
+
 	// insert the event table record into the mysql table
-	res, err := db.Connection.Exec(`INSERT INTO event_table (capacity) VALUES(?);`, table.Capacity)
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, table.Capacity)
 	if err != nil {
-		return table, err
+		return table, tracing.RecordError(span, err)
 	}
 	id, err := res.LastInsertId()
 
 	if err != nil {
-		return table, err
+		return table, tracing.RecordError(span, err)
 	}
 
 	// update the model obj with the returned id before returning it
 	table.TableID = int(id)
+	table.Version = 1
 
 	return table, nil
 }
 
+/**
+ * Updates the capacity of a record in `event_table`. The update only happens if the
+ * record is still at the version of the instance, otherwise a PreconditionFailed error
+ * is returned. On success the version of the instance is incremented.
+ *
+ * @param  table  pointer to instance of EventTable with the new data and the version it was read at
+ */
+func (db *MySQLEventTableRepository) UpdateTable(ctx context.Context, table *model.EventTable) error {
+	sqlStatement := `
+		UPDATE event_table
+		SET capacity = ?, version = version + 1
+		WHERE table_id = ? AND version = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.UpdateTable", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, table.Capacity, table.TableID, table.Version)
+	if err != nil {
+		return tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(table.TableID), "tableID", "table"))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewPreconditionFailedError("table", table.Version))
+	}
+
+	table.Version++
+
+	return nil
+}
+
 /**
  * Return the remaining capacity at a table given the table id.
  * Uses the view seating_usage for the query.
@@ -107,7 +206,7 @@ func (db *MySQLEventTableRepository) CreateTable(table *model.EventTable) (*mode
  * @param  id  id of the event table
  * @return     amount of free seats at the table
  */
-func (db *MySQLEventTableRepository) GetEmptySeatsAtTable(id int) (int, error) {
+func (db *MySQLEventTableRepository) GetEmptySeatsAtTable(ctx context.Context, id int) (int, error) {
 
 	var result int
 
@@ -116,9 +215,50 @@ func (db *MySQLEventTableRepository) GetEmptySeatsAtTable(id int) (int, error) {
 		FROM seating_usage
 		WHERE table_id = ?;
 	`
-	err := db.Connection.QueryRow(sqlStatement, id).Scan(&result)
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.GetEmptySeatsAtTable", sqlStatement)
+	defer span.End()
+
+	err := db.Connection.QueryRowContext(ctx, sqlStatement, id).Scan(&result)
 
-	return result, e.CheckDatabaseError(err, fmt.Sprint(id), "tableID", "table")
+	return result, tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(id), "tableID", "table"))
+}
+
+/**
+ * Return the remaining capacity at each of the tables given their ids, in a single
+ * query. Uses the view seating_usage for the query.
+ *
+ * @param  ids  ids of the event tables
+ * @return      map of table id to the amount of free seats at the table
+ */
+func (db *MySQLEventTableRepository) GetEmptySeatsByTable(ctx context.Context, ids []int) (map[int]int, error) {
+	free := make(map[int]int)
+	if len(ids) == 0 {
+		return free, nil
+	}
+
+	in, args := inList(ids)
+	sqlStatement := `
+		SELECT table_id, free_seats
+		FROM seating_usage
+		WHERE table_id IN (` + in + `);
+	`
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.GetEmptySeatsByTable", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, args...)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, seats int
+		if err := rows.Scan(&id, &seats); err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		free[id] = seats
+	}
+	return free, tracing.RecordError(span, rows.Err())
 }
 
 /**
@@ -126,24 +266,62 @@ func (db *MySQLEventTableRepository) GetEmptySeatsAtTable(id int) (int, error) {
  *
  * @return  amount of empty seats in all the tables
  */
-func (db *MySQLEventTableRepository) GetEmptySeats() (int, error) {
+func (db *MySQLEventTableRepository) GetEmptySeats(ctx context.Context) (int, error) {
 
 	var result int
 
 	sqlStatement := `SELECT SUM(free_seats) FROM seating_usage;`
 
-	err := db.Connection.QueryRow(sqlStatement).Scan(&result)
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.GetEmptySeats", sqlStatement)
+	defer span.End()
+
+	err := db.Connection.QueryRowContext(ctx, sqlStatement).Scan(&result)
 
-	return result, e.CheckDatabaseError(err, "", "", "free seats")
+	return result, tracing.RecordError(span, e.CheckDatabaseError(err, "", "", "free seats"))
 }
 
 /**
- * To implement in the future.
- * Should delete the table from the database. If table had any guests, their arrival status
- * must be updated to allocate (since they need to be allocated to a new table).
+ * Deletes the table from the database. The table is locked while its guests are counted, and the
+ * deletion is refused with a TableNotEmpty error while any guest sat at it still holds a seat, since
+ * removing the table would remove their seating. The seating of the guests that left or didn't come
+ * is removed by the foreign key of `seating`. Returns a NotFound error if no table has the id.
  *
  * @param  id  id of the event table to delete
  */
-func (db *MySQLEventTableRepository) DeleteTable(id int) error {
-	panic("Implement me!")
+func (db *MySQLEventTableRepository) DeleteTable(ctx context.Context, id int) error {
+	sqlStatement := `DELETE FROM event_table WHERE table_id = ?;`
+
+	ctx, span := startSpan(ctx, "MySQLEventTableRepository.DeleteTable", "SELECT event_table FOR UPDATE; SELECT guest; "+sqlStatement)
+	defer span.End()
+
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+	defer tx.Rollback()
+
+	// Locking the table keeps guests from being sat at it until the deletion ends
+	var tableID int
+	err = tx.QueryRowContext(ctx, `SELECT table_id FROM event_table WHERE table_id = ? FOR UPDATE;`, id).Scan(&tableID)
+	if err != nil {
+		return tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(id), "tableID", "table"))
+	}
+
+	var seated int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM guest
+		WHERE guest_id IN (SELECT guest_id FROM seating WHERE table_id = ?) AND FIELD(arrival_status, 'not_arrived', 'arrived');`, id).Scan(&seated)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if seated > 0 {
+		return tracing.RecordError(span, e.NewTableNotEmptyError(fmt.Sprint(id), seated))
+	}
+
+	if _, err = tx.ExecContext(ctx, sqlStatement, id); err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	return tracing.RecordError(span, tx.Commit())
 }
@@ -1,21 +1,31 @@
 package repository
 
-import "github.com/fpetrikovich/go-guestlist/pkg/model"
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
 
 /*
 The `IEventTableRepository` interface defines a set of methods for managing event tables in an event management system.
 */
 type IEventTableRepository interface {
 	// Retrieves a list of all event tables.
-	GetTables() ([]model.EventTable, error)
+	GetTables(ctx context.Context) ([]model.EventTable, error)
 	// Retrieves the event table with the given id.
-	GetTable(id int) (*model.EventTable, error)
+	GetTable(ctx context.Context, id int) (*model.EventTable, error)
+	// Retrieves the event tables with any of the given ids in a single query.
+	GetTablesByID(ctx context.Context, ids []int) ([]model.EventTable, error)
 	// Creates a new event table with the given parameters.
-	CreateTable(table *model.EventTable) (*model.EventTable, error)
+	CreateTable(ctx context.Context, table *model.EventTable) (*model.EventTable, error)
+	// Updates the capacity of an event table, if it wasn't modified since it was read.
+	UpdateTable(ctx context.Context, table *model.EventTable) error
 	// Deletes the event table with the given id.
-	DeleteTable(id int) error
+	DeleteTable(ctx context.Context, id int) error
 	// Retrieves the number of empty seats at a particular event table with the given id.
-	GetEmptySeatsAtTable(id int) (int, error)
+	GetEmptySeatsAtTable(ctx context.Context, id int) (int, error)
+	// Retrieves the number of empty seats at each of the given event tables in a single query.
+	GetEmptySeatsByTable(ctx context.Context, ids []int) (map[int]int, error)
 	// Retrieves the total number of empty seats across all event tables.
-	GetEmptySeats() (int, error)
+	GetEmptySeats(ctx context.Context) (int, error)
 }
@@ -0,0 +1,314 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+MySQL implementation of a tag repository.
+
+Tags are stored in the `tag` table and attached to guests through the `guest_tag` table.
+Attaching or detaching a tag changes the guest, so it also increments the version of the
+record in the `guest` table.
+*/
+type MySQLTagRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLTagRepository(connection *sql.DB, logger *slog.Logger) *MySQLTagRepository {
+	return &MySQLTagRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/**
+ * Returns an array of model.Tag registered in `tag`, ordered by name.
+ * If an error occurs while scanning a particular row, will log the error,
+ * but continue scanning other rows.
+ *
+ * @return  array of tags
+ */
+func (db *MySQLTagRepository) GetTags(ctx context.Context) ([]model.Tag, error) {
+
+	sqlStatement := `
+		SELECT tag_id, name, description, version, created_at, updated_at
+		FROM tag
+		ORDER BY name;
+	`
+	ctx, span := startSpan(ctx, "MySQLTagRepository.GetTags", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	tags, err := db.scanTags(ctx, rows)
+	return tags, tracing.RecordError(span, err)
+}
+
+/**
+ * Retrieves a tag from the `tag` table using the unique attribute name.
+ * Returns a NotFound error if no tag has said name.
+ *
+ * @param  name  name of the tag to fetch
+ * @return       pointer to an instance of Tag
+ */
+func (db *MySQLTagRepository) GetTag(ctx context.Context, name string) (*model.Tag, error) {
+
+	var tag model.Tag
+	sqlStatement := `
+		SELECT tag_id, name, description, version, created_at, updated_at
+		FROM tag
+		WHERE name = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLTagRepository.GetTag", sqlStatement)
+	defer span.End()
+
+	err := db.Connection.QueryRowContext(ctx, sqlStatement, name).
+		Scan(&tag.TagID, &tag.Name, &tag.Description, &tag.Version, &tag.CreatedAt, &tag.UpdatedAt)
+
+	return &tag, tracing.RecordError(span, e.CheckDatabaseError(err, name, "name", "tag"))
+}
+
+/**
+ * Inserts a new record in the `tag` table and returns it with its id.
+ * If a tag with the same name exists, an AlreadyExists error is returned.
+ *
+ * @param  params  pointer to TagData with the name and description of the tag
+ * @return         pointer to the instance of Tag created
+ */
+func (db *MySQLTagRepository) CreateTag(ctx context.Context, params *model.TagData) (*model.Tag, error) {
+	sqlStatement := `INSERT INTO tag (name, description) VALUES(?, ?);`
+
+	ctx, span := startSpan(ctx, "MySQLTagRepository.CreateTag", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, params.Name, params.Description)
+	if err != nil {
+		return nil, tracing.RecordError(span, e.CheckDatabaseError(err, params.Name, "name", "tag"))
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	return &model.Tag{TagID: int(id), Name: params.Name, Description: params.Description, Version: 1}, nil
+}
+
+/**
+ * Updates the description of a record in `tag`. The update only happens if the record
+ * is still at the version of the instance, otherwise a PreconditionFailed error is
+ * returned. On success the version of the instance is incremented.
+ *
+ * @param  tag  pointer to instance of Tag with the new data and the version it was read at
+ */
+func (db *MySQLTagRepository) UpdateTag(ctx context.Context, tag *model.Tag) error {
+	sqlStatement := `
+		UPDATE tag
+		SET description = ?, version = version + 1
+		WHERE tag_id = ? AND version = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLTagRepository.UpdateTag", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, tag.Description, tag.TagID, tag.Version)
+	if err != nil {
+		return tracing.RecordError(span, e.CheckDatabaseError(err, tag.Name, "name", "tag"))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewPreconditionFailedError("tag", tag.Version))
+	}
+
+	tag.Version++
+
+	return nil
+}
+
+/**
+ * Deletes the record of `tag` with the given name. The tag is detached from every
+ * guest by the foreign key of `guest_tag`. Returns a NotFound error if no tag has said name.
+ *
+ * @param  name  name of the tag to delete
+ */
+func (db *MySQLTagRepository) DeleteTag(ctx context.Context, name string) error {
+	sqlStatement := `DELETE FROM tag WHERE name = ?;`
+
+	ctx, span := startSpan(ctx, "MySQLTagRepository.DeleteTag", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, name)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewNotFoundError(name, "name", "tag"))
+	}
+
+	return nil
+}
+
+/**
+ * Retrieves the tags attached to the guest with the given name, ordered by name.
+ * Returns a NotFound error if no guest has said name.
+ *
+ * @param  guestName  name of the guest
+ * @return            array of tags
+ */
+func (db *MySQLTagRepository) GetGuestTags(ctx context.Context, guestName string) ([]model.Tag, error) {
+	sqlStatement := `
+		SELECT t.tag_id, t.name, t.description, t.version, t.created_at, t.updated_at
+		FROM tag as t
+		JOIN guest_tag as gt ON t.tag_id = gt.tag_id
+		WHERE gt.guest_id = ?
+		ORDER BY t.name;
+	`
+	ctx, span := startSpan(ctx, "MySQLTagRepository.GetGuestTags", sqlStatement)
+	defer span.End()
+
+	guestID, err := db.getGuestID(ctx, db.Connection, guestName)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement, guestID)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	tags, err := db.scanTags(ctx, rows)
+	return tags, tracing.RecordError(span, err)
+}
+
+/**
+ * Attaches the tag to the guest, incrementing the version of the guest. Attaching a
+ * tag the guest already has changes nothing. Returns a NotFound error if the guest
+ * or the tag do not exist.
+ *
+ * @param  guestName  name of the guest
+ * @param  tagName    name of the tag to attach
+ */
+func (db *MySQLTagRepository) AttachTag(ctx context.Context, guestName string, tagName string) error {
+	sqlStatement := `INSERT IGNORE INTO guest_tag (guest_id, tag_id) VALUES(?, ?);`
+
+	ctx, span := startSpan(ctx, "MySQLTagRepository.AttachTag", sqlStatement)
+	defer span.End()
+
+	return tracing.RecordError(span, db.changeGuestTag(ctx, guestName, tagName, sqlStatement, false))
+}
+
+/**
+ * Detaches the tag from the guest, incrementing the version of the guest. Returns a
+ * NotFound error if the guest or the tag do not exist, or the guest doesn't have the tag.
+ *
+ * @param  guestName  name of the guest
+ * @param  tagName    name of the tag to detach
+ */
+func (db *MySQLTagRepository) DetachTag(ctx context.Context, guestName string, tagName string) error {
+	sqlStatement := `DELETE FROM guest_tag WHERE guest_id = ? AND tag_id = ?;`
+
+	ctx, span := startSpan(ctx, "MySQLTagRepository.DetachTag", sqlStatement)
+	defer span.End()
+
+	return tracing.RecordError(span, db.changeGuestTag(ctx, guestName, tagName, sqlStatement, true))
+}
+
+/*
+`changeGuestTag` runs the statement that inserts or deletes the row of `guest_tag` of the guest and tag,
+in a transaction that also increments the version of the guest when the row changed.
+When `mustChange` is set, a NotFound error is returned if no row changed.
+*/
+func (db *MySQLTagRepository) changeGuestTag(ctx context.Context, guestName string, tagName string, sqlStatement string, mustChange bool) error {
+	tx, err := db.Connection.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	guestID, err := db.getGuestID(ctx, tx, guestName)
+	if err != nil {
+		return err
+	}
+
+	var tagID int
+	err = tx.QueryRowContext(ctx, `SELECT tag_id FROM tag WHERE name = ?;`, tagName).Scan(&tagID)
+	if err != nil {
+		return e.CheckDatabaseError(err, tagName, "name", "tag")
+	}
+
+	res, err := tx.ExecContext(ctx, sqlStatement, guestID, tagID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		if mustChange {
+			return e.NewNotFoundError(tagName, "name", "tag of guest "+guestName)
+		}
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE guest SET version = version + 1 WHERE guest_id = ?;`, guestID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Queries with either the connection or a transaction.
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func (db *MySQLTagRepository) getGuestID(ctx context.Context, q queryer, guestName string) (int, error) {
+	var guestID int
+	err := q.QueryRowContext(ctx, `SELECT guest_id FROM guest WHERE name = ?;`, guestName).Scan(&guestID)
+	return guestID, e.CheckDatabaseError(err, guestName, "name", "guest")
+}
+
+// Scans every row of tags. Errors while scanning a row are logged, but not handled.
+func (db *MySQLTagRepository) scanTags(ctx context.Context, rows *sql.Rows) ([]model.Tag, error) {
+	tags := []model.Tag{}
+
+	// Foreach tag
+	for rows.Next() {
+		var tag model.Tag
+
+		err := rows.Scan(&tag.TagID, &tag.Name, &tag.Description, &tag.Version, &tag.CreatedAt, &tag.UpdatedAt)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+		}
+
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
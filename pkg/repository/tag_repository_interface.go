@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+This is an interface `ITagRepository` for database logic regarding tags and the guests they are attached to.
+*/
+type ITagRepository interface {
+	// This method retrieves all the tags.
+	GetTags(ctx context.Context) ([]model.Tag, error)
+	// This method retrieves a single tag by its name.
+	GetTag(ctx context.Context, name string) (*model.Tag, error)
+	// This method creates a new tag.
+	CreateTag(ctx context.Context, params *model.TagData) (*model.Tag, error)
+	// This method updates the description of a given tag.
+	UpdateTag(ctx context.Context, tag *model.Tag) error
+	// This method deletes a tag by its name, detaching it from every guest.
+	DeleteTag(ctx context.Context, name string) error
+	// This method retrieves the tags attached to a guest.
+	GetGuestTags(ctx context.Context, guestName string) ([]model.Tag, error)
+	// This method attaches a tag to a guest.
+	AttachTag(ctx context.Context, guestName string, tagName string) error
+	// This method detaches a tag from a guest.
+	DetachTag(ctx context.Context, guestName string, tagName string) error
+}
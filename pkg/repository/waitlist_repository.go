@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Columns of the `waitlist_entry` table read into a WaitlistEntry, in the order expected by scanWaitlistEntry.
+const waitlistColumns = `entry_id, name, IFNULL(email, ''), party_size, priority, status, IFNULL(table_id, 0),
+		IFNULL(offer_token, ''), IFNULL(offer_expires_at, ''), version, created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWaitlistEntry(row rowScanner, entry *model.WaitlistEntry) error {
+	return row.Scan(&entry.EntryID, &entry.Name, &entry.Email, &entry.PartySize, &entry.Priority, &entry.Status, &entry.Table,
+		&entry.OfferToken, &entry.OfferExpiresAt, &entry.Version, &entry.CreatedAt, &entry.UpdatedAt)
+}
+
+/*
+MySQL implementation of a waitlist repository.
+
+Parties are stored in the `waitlist_entry` table. Offers expire at a time computed by MySQL,
+so every instance of the application agrees on which offers are still valid.
+*/
+type MySQLWaitlistRepository struct {
+	Connection *sql.DB
+	logger     *slog.Logger
+}
+
+func NewMySQLWaitlistRepository(connection *sql.DB, logger *slog.Logger) *MySQLWaitlistRepository {
+	return &MySQLWaitlistRepository{
+		Connection: connection,
+		logger:     logger,
+	}
+}
+
+/**
+ * Retrieves the entries of `waitlist_entry` that are waiting or hold an offer, ordered
+ * by priority and then by age, which is the order seats are offered in.
+ * If an error occurs while scanning a particular row, will log the error,
+ * but continue scanning other rows.
+ *
+ * @return  array of WaitlistEntry
+ */
+func (db *MySQLWaitlistRepository) GetWaitlist(ctx context.Context) ([]model.WaitlistEntry, error) {
+	sqlStatement := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entry
+		WHERE FIELD(status, 'waiting', 'offered')
+		ORDER BY priority DESC, entry_id;
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.GetWaitlist", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	entries := []model.WaitlistEntry{}
+
+	// Foreach entry
+	for rows.Next() {
+		var entry model.WaitlistEntry
+
+		err = scanWaitlistEntry(rows, &entry)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, tracing.RecordError(span, rows.Err())
+}
+
+/**
+ * Retrieves an entry of `waitlist_entry` by its id.
+ * Returns a NotFound error if no entry has said id.
+ *
+ * @param  id  id of the entry
+ * @return     pointer to an instance of WaitlistEntry
+ */
+func (db *MySQLWaitlistRepository) GetEntry(ctx context.Context, id int) (*model.WaitlistEntry, error) {
+	var entry model.WaitlistEntry
+	sqlStatement := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entry
+		WHERE entry_id = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.GetEntry", sqlStatement)
+	defer span.End()
+
+	err := scanWaitlistEntry(db.Connection.QueryRowContext(ctx, sqlStatement, id), &entry)
+
+	return &entry, tracing.RecordError(span, e.CheckDatabaseError(err, fmt.Sprint(id), "id", "waitlist entry"))
+}
+
+/**
+ * Retrieves the entry of `waitlist_entry` that was offered seats with the token.
+ * Returns a NotFound error if no entry has said token.
+ *
+ * @param  token  token of the offer link
+ * @return        pointer to an instance of WaitlistEntry
+ */
+func (db *MySQLWaitlistRepository) GetEntryByOfferToken(ctx context.Context, token string) (*model.WaitlistEntry, error) {
+	var entry model.WaitlistEntry
+	sqlStatement := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entry
+		WHERE offer_token = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.GetEntryByOfferToken", sqlStatement)
+	defer span.End()
+
+	err := scanWaitlistEntry(db.Connection.QueryRowContext(ctx, sqlStatement, token), &entry)
+
+	return &entry, tracing.RecordError(span, e.CheckDatabaseError(err, token, "token", "offer"))
+}
+
+/**
+ * Inserts a new record in the `waitlist_entry` table as waiting, and returns it.
+ *
+ * @param  params  pointer to WaitlistRequest
+ * @return         pointer to the instance of WaitlistEntry created
+ */
+func (db *MySQLWaitlistRepository) CreateEntry(ctx context.Context, params *model.WaitlistRequest) (*model.WaitlistEntry, error) {
+	sqlStatement := `
+		INSERT INTO waitlist_entry (name, email, party_size, priority)
+		VALUES(?, NULLIF(?, ''), ?, ?);
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.CreateEntry", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, params.Name, params.Email, params.PartySize, params.Priority)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	entry, err := db.GetEntry(ctx, int(id))
+	return entry, tracing.RecordError(span, err)
+}
+
+/**
+ * Updates the status and table of a record in `waitlist_entry`. The update only happens
+ * if the record is still at the version of the instance, otherwise a PreconditionFailed
+ * error is returned. On success the version of the instance is incremented.
+ *
+ * @param  entry  pointer to WaitlistEntry with the new data and the version it was read at
+ */
+func (db *MySQLWaitlistRepository) UpdateEntry(ctx context.Context, entry *model.WaitlistEntry) error {
+	sqlStatement := `
+		UPDATE waitlist_entry
+		SET status = ?, table_id = NULLIF(?, 0), version = version + 1
+		WHERE entry_id = ? AND version = ?;
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.UpdateEntry", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, entry.Status, entry.Table, entry.EntryID, entry.Version)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewPreconditionFailedError("waitlist entry", entry.Version))
+	}
+
+	entry.Version++
+
+	return nil
+}
+
+/**
+ * Offers the seats of a table to a waiting record of `waitlist_entry`, storing the token
+ * of the offer link and its expiry, which is ttl from now. Like UpdateEntry, the record
+ * must still be at the version of the instance. On success the instance is updated with
+ * the offer.
+ *
+ * @param  entry  pointer to WaitlistEntry to offer the seats to
+ * @param  table  id of the table with the free seats
+ * @param  token  unique token of the offer link
+ * @param  ttl    how long the offer is valid for
+ */
+func (db *MySQLWaitlistRepository) OfferEntry(ctx context.Context, entry *model.WaitlistEntry, table int, token string, ttl time.Duration) error {
+	sqlStatement := `
+		UPDATE waitlist_entry
+		SET status = 'offered', table_id = ?, offer_token = ?,
+			offer_expires_at = DATE_ADD(NOW(), INTERVAL ? SECOND), version = version + 1
+		WHERE entry_id = ? AND version = ? AND status = 'waiting';
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.OfferEntry", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement, table, token, int(ttl.Seconds()), entry.EntryID, entry.Version)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if n == 0 {
+		return tracing.RecordError(span, e.NewPreconditionFailedError("waitlist entry", entry.Version))
+	}
+
+	offered, err := db.GetEntry(ctx, entry.EntryID)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+	*entry = *offered
+
+	return nil
+}
+
+/**
+ * Sets the offers of `waitlist_entry` whose expiry has passed as expired.
+ *
+ * @return  number of offers expired
+ */
+func (db *MySQLWaitlistRepository) ExpireOffers(ctx context.Context) (int, error) {
+	sqlStatement := `
+		UPDATE waitlist_entry
+		SET status = 'expired', version = version + 1
+		WHERE status = 'offered' AND offer_expires_at <= NOW();
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.ExpireOffers", sqlStatement)
+	defer span.End()
+
+	res, err := db.Connection.ExecContext(ctx, sqlStatement)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	n, err := res.RowsAffected()
+	return int(n), tracing.RecordError(span, err)
+}
+
+/**
+ * Retrieves the free seats of every table from the `seating_usage` view, minus the seats
+ * of the offers not answered yet, so the same seats are not offered twice.
+ *
+ * @return  free seats per table id
+ */
+func (db *MySQLWaitlistRepository) GetOfferableSeats(ctx context.Context) (map[int]int, error) {
+	sqlStatement := `
+		SELECT su.table_id, su.free_seats - IFNULL(SUM(w.party_size), 0)
+		FROM seating_usage as su
+		LEFT JOIN waitlist_entry as w ON w.table_id = su.table_id AND w.status = 'offered'
+		GROUP BY su.table_id, su.free_seats;
+	`
+	ctx, span := startSpan(ctx, "MySQLWaitlistRepository.GetOfferableSeats", sqlStatement)
+	defer span.End()
+
+	rows, err := db.Connection.QueryContext(ctx, sqlStatement)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	defer rows.Close()
+
+	seats := make(map[int]int)
+
+	// Foreach table
+	for rows.Next() {
+		var table, free int
+
+		err = rows.Scan(&table, &free)
+
+		if err != nil {
+			db.logger.ErrorContext(ctx, "Failed to scan row.", "error", err)
+			continue
+		}
+
+		seats[table] = free
+	}
+	return seats, tracing.RecordError(span, rows.Err())
+}
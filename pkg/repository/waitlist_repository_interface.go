@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+This is an interface `IWaitlistRepository` for database logic regarding the parties waiting for seats.
+*/
+type IWaitlistRepository interface {
+	// This method retrieves the parties waiting or holding an offer, in the order seats are offered to them.
+	GetWaitlist(ctx context.Context) ([]model.WaitlistEntry, error)
+	// This method retrieves a single entry by its id.
+	GetEntry(ctx context.Context, id int) (*model.WaitlistEntry, error)
+	// This method retrieves the entry an offer was made to by the token of the offer.
+	GetEntryByOfferToken(ctx context.Context, token string) (*model.WaitlistEntry, error)
+	// This method adds a party to the waitlist.
+	CreateEntry(ctx context.Context, params *model.WaitlistRequest) (*model.WaitlistEntry, error)
+	// This method updates the status and table of a given entry.
+	UpdateEntry(ctx context.Context, entry *model.WaitlistEntry) error
+	// This method offers the seats of a table to a given entry, valid for the ttl.
+	OfferEntry(ctx context.Context, entry *model.WaitlistEntry, table int, token string, ttl time.Duration) error
+	// This method expires the offers that weren't answered in time, returning how many.
+	ExpireOffers(ctx context.Context) (int, error)
+	// This method retrieves the free seats of each table, minus the seats held by pending offers.
+	GetOfferableSeats(ctx context.Context) (map[int]int, error)
+}
@@ -0,0 +1,180 @@
+package routes
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+
+	guestlist "github.com/fpetrikovich/go-guestlist"
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+	"github.com/fpetrikovich/go-guestlist/pkg/handler"
+	mw "github.com/fpetrikovich/go-guestlist/pkg/middleware"
+)
+
+// Handlers of every resource served by the API.
+type Handlers struct {
+	Table        *handler.EventTableHandler
+	Guest        *handler.GuestHandler
+	Tag          *handler.TagHandler
+	RSVP         *handler.RSVPHandler
+	Waitlist     *handler.WaitlistHandler
+	Pass         *handler.PassHandler
+	Notification *handler.NotificationHandler
+	Report       *handler.ReportHandler
+	Sync         *handler.SyncHandler
+	Station      *handler.StationHandler
+	GraphQL      *handler.GraphQLHandler
+	Health       *handler.HealthHandler
+	Docs         *handler.DocsHandler
+}
+
+/*
+The NewRouter function builds the router the app serves: every request is traced, logged with its request id
+and given the station and staff member it is made at, before reaching the routes registered by `Register`.
+The app and the tests of its clients build their router with it, so they are served through the same middleware.
+*/
+func NewRouter(h *Handlers, cfg *config.Config, logger *slog.Logger) (*mux.Router, error) {
+	router := mux.NewRouter()
+	router.Use(otelmux.Middleware(cfg.ServiceName))
+	router.Use(mw.RequestID(logger))
+	router.Use(mw.Checkpoint(cfg.StationID))
+
+	if err := Register(router, h, cfg, logger); err != nil {
+		return nil, err
+	}
+	return router, nil
+}
+
+/*
+The Register function maps the URL paths of the API to the handlers in h on a `mux.Router`.
+Each route is given a request deadline from the configuration, so a stuck database cannot hang a handler forever,
+and its own rate limit per client, so a single misbehaving client cannot starve the database connection pool.
+Requests are validated against the OpenAPI specification embedded in the binary before reaching the handlers.
+The API is served under `/v1` and `/v2`, and the unversioned routes it had before keep answering with a `Deprecation` header.
+This function provides a centralized location for managing application routes, registered by `NewRouter`.
+*/
+func Register(router *mux.Router, h *Handlers, cfg *config.Config, logger *slog.Logger) error {
+	// Requests are checked against the API specification before reaching the handlers
+	validator, err := mw.NewRequestValidator(guestlist.APISpec, cfg.MaxBodyBytes, logger)
+	if err != nil {
+		return err
+	}
+
+	// Each route gets its own per-client rate limit and a deadline for the request context,
+	// depending on whether the route reads or writes, and its request body capped in size
+	bodyLimit := mw.MaxBodyBytes(cfg.MaxBodyBytes)
+	errs := mw.Errors(logger)
+	read := func(h mw.AppHandler) http.Handler {
+		limiter := mw.NewRateLimiter(cfg.ReadRateLimit, cfg.ReadRateBurst, cfg.RateLimitKeys)
+		return limiter.Limit(bodyLimit(validator.Validate(mw.Timeout(cfg.ReadTimeout, errs(h)))))
+	}
+	// Routes that write also replay the stored response when retried with the same Idempotency-Key
+	idempotency := mw.Idempotency(mw.NewMemoryIdempotencyStore(), cfg.IdempotencyWindow, cfg.MaxBodyBytes, cfg.RateLimitKeys)
+	write := func(h mw.AppHandler) http.Handler {
+		limiter := mw.NewRateLimiter(cfg.WriteRateLimit, cfg.WriteRateBurst, cfg.RateLimitKeys)
+		return limiter.Limit(bodyLimit(validator.Validate(idempotency(mw.Timeout(cfg.WriteTimeout, errs(h))))))
+	}
+	// Routes meant for the staff rather than the guests are only served to the keys of the staff
+	staff := mw.RequireAPIKey(cfg.StaffAPIKeys)
+
+	// Routes of the API, each served under /v1 at its first path and under /v2 at its path in
+	// the resource layout of /v2. Both versions share the handler, and so the rate limit, of the route.
+	routes := []struct {
+		method  string
+		v1      string
+		v2      string
+		handler http.Handler
+	}{
+		// Table Routes
+		{"GET", "/tables/{id}", "/tables/{id}", read(h.Table.GetTable)},
+		{"GET", "/tables", "/tables", read(h.Table.GetTables)},
+		{"POST", "/tables", "/tables", write(h.Table.CreateTable)},
+		{"PUT", "/tables/{id}", "/tables/{id}", write(h.Table.UpdateTable)},
+		{"DELETE", "/tables/{id}", "/tables/{id}", write(h.Table.DeleteTable)},
+		{"GET", "/seats_empty", "/seats", read(h.Table.GetEmptySeats)},
+		// Guest Routes, arrivals and departures are their own resource in /v2
+		{"GET", "/guest_list/{name}", "/guests/{name}", read(h.Guest.GetGuest)},
+		{"GET", "/guest_list", "/guests", read(h.Guest.GetGuestList)},
+		{"POST", "/guest_list/{name}", "/guests/{name}", write(h.Guest.CreateGuest)},
+		{"PUT", "/guest_list/{name}/profile", "/guests/{name}/profile", write(h.Guest.UpdateGuestProfile)},
+		{"PUT", "/guests/{name}", "/arrivals/{name}", write(h.Guest.UpdateGuest)},
+		{"GET", "/guests", "/arrivals", read(h.Guest.GetArrivedGuests)},
+		{"DELETE", "/guests/{name}", "/arrivals/{name}", write(h.Guest.DeleteGuest)},
+		{"POST", "/guests/arrivals", "/arrivals", write(h.Guest.ArriveGuests)},
+		{"POST", "/guests/{name}/undo", "/arrivals/{name}/undo", write(h.Guest.UndoStatusChange)},
+		// Tag Routes
+		{"GET", "/tags", "/tags", read(h.Tag.GetTags)},
+		{"POST", "/tags", "/tags", write(h.Tag.CreateTag)},
+		{"GET", "/tags/{name}", "/tags/{name}", read(h.Tag.GetTag)},
+		{"PUT", "/tags/{name}", "/tags/{name}", write(h.Tag.UpdateTag)},
+		{"DELETE", "/tags/{name}", "/tags/{name}", write(h.Tag.DeleteTag)},
+		{"GET", "/guest_list/{name}/tags", "/guests/{name}/tags", read(h.Tag.GetGuestTags)},
+		{"PUT", "/guest_list/{name}/tags/{tag}", "/guests/{name}/tags/{tag}", write(h.Tag.AttachTag)},
+		{"DELETE", "/guest_list/{name}/tags/{tag}", "/guests/{name}/tags/{tag}", write(h.Tag.DetachTag)},
+		// Waitlist Routes, offers are public to the holder of the offer link
+		{"GET", "/waitlist", "/waitlist", read(h.Waitlist.GetWaitlist)},
+		{"POST", "/waitlist", "/waitlist", write(h.Waitlist.Enqueue)},
+		{"DELETE", "/waitlist/{id:[0-9]+}", "/waitlist/{id:[0-9]+}", write(h.Waitlist.Cancel)},
+		{"GET", "/waitlist/offers/{token}", "/waitlist/offers/{token}", read(h.Waitlist.GetOffer)},
+		{"POST", "/waitlist/offers/{token}", "/waitlist/offers/{token}", write(h.Waitlist.AnswerOffer)},
+		// Check-in Routes, passes are public to the holder of the invitation link and given by guest id to the staff
+		{"GET", "/rsvp/{token}/pass", "/rsvp/{token}/pass", read(h.Pass.GetPass)},
+		{"GET", "/guests/{id:[0-9]+}/pass", "/passes/{id:[0-9]+}", staff(read(h.Pass.GetGuestPass))},
+		{"POST", "/checkin/scan", "/passes/scan", write(h.Pass.Scan)},
+		// Report Routes
+		{"GET", "/reports/catering", "/reports/catering", read(h.Report.GetCateringReport)},
+		{"GET", "/reports/arrivals", "/reports/arrivals", read(h.Report.GetArrivalTimeline)},
+		{"GET", "/reports/tables", "/reports/tables", read(h.Report.GetTableUtilization)},
+		{"GET", "/reports/attendance", "/reports/attendance", read(h.Report.GetAttendanceReport)},
+		// Notification Routes
+		{"POST", "/notifications/preview", "/notifications/preview", write(h.Notification.Preview)},
+		{"POST", "/notifications/campaigns", "/notifications/campaigns", write(h.Notification.StartCampaign)},
+		// Sync Routes, for the door stations and the staff reviewing their conflicts
+		{"POST", "/sync/operations", "/sync/operations", staff(write(h.Sync.PushOperations))},
+		{"GET", "/sync/conflicts", "/sync/conflicts", staff(read(h.Sync.GetConflicts))},
+		{"GET", "/sync/snapshot", "/sync/snapshot", staff(read(h.Sync.GetSnapshot))},
+		// Station Routes
+		{"GET", "/stations", "/stations", read(h.Station.GetStations)},
+		{"POST", "/stations", "/stations", write(h.Station.CreateStation)},
+		{"GET", "/stations/{id}/activity", "/stations/{id}/activity", read(h.Station.GetActivity)},
+		// RSVP Routes, public to the holder of the invitation link
+		{"GET", "/rsvp/{token}", "/rsvp/{token}", read(h.RSVP.GetInvitation)},
+		{"POST", "/rsvp/{token}", "/rsvp/{token}", write(h.RSVP.Respond)},
+	}
+
+	// The unversioned paths of /v1 keep working for the clients in the field, marked as deprecated
+	v1 := router.PathPrefix("/v1").Subrouter()
+	v2 := router.PathPrefix("/v2").Subrouter()
+	legacy := router.NewRoute().Subrouter()
+	legacy.Use(mw.Deprecated(cfg.LegacyDeprecatedAt, cfg.LegacySunset, "/v1"))
+	for _, route := range routes {
+		v1.Handle(route.v1, route.handler).Methods(route.method)
+		v2.Handle(route.v2, route.handler).Methods(route.method)
+		legacy.Handle(route.v1, route.handler).Methods(route.method)
+	}
+
+	// GraphQL Routes, queries and mutations share the route so it is limited like the routes that write
+	router.Handle("/graphql", write(h.GraphQL.Query)).Methods("POST")
+
+	// Probes
+	router.HandleFunc("/healthz", h.Health.Liveness).Methods("GET")
+	router.HandleFunc("/readyz", h.Health.Readiness).Methods("GET")
+
+	// Documentation
+	router.HandleFunc("/openapi.yaml", h.Docs.GetSpecYAML).Methods("GET")
+	router.HandleFunc("/openapi.json", h.Docs.GetSpecJSON).Methods("GET")
+	router.HandleFunc("/docs", h.Docs.GetDocs).Methods("GET")
+	router.HandleFunc("/docs/{asset}", h.Docs.GetAsset).Methods("GET")
+
+	// ping
+	router.HandleFunc("/ping", handlerPing)
+
+	return nil
+}
+
+func handlerPing(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "pong\n")
+}
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+)
+
+/*
+`toStatus` turns the errors of the service layer into gRPC statuses, the same way
+`exception.ErrorCaseHanding` turns them into HTTP responses for the REST API.
+Unknown errors are reported as Internal without their message, which is only logged.
+*/
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// The call deadline was hit or the client went away somewhere down the layers
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "Request timed out.")
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "Request cancelled.")
+	}
+
+	switch err.(type) {
+	case *e.NotFoundError:
+		return status.Error(codes.NotFound, err.Error())
+	case *e.AlreadyExistsError:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case *e.BadInputError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case *e.ExceedsCapacityError, *e.ArrivalStatusError, *e.PassAlreadyUsedError, *e.WaitlistStatusError, *e.TableNotEmptyError, *e.CentralOnlyError, *e.PreconditionRequiredError:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case *e.PreconditionFailedError:
+		return status.Error(codes.Aborted, err.Error())
+	case *e.InvalidPassError:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case *e.PayloadTooLargeError:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case *e.MissingDataError:
+		return status.Error(codes.Internal, err.Error())
+	default:
+		return status.Error(codes.Internal, "Server error.")
+	}
+}
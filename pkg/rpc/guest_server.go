@@ -0,0 +1,193 @@
+package rpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	pb "github.com/fpetrikovich/go-guestlist/pkg/rpc/guestlistpb"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+/*
+The `GuestServer` serves the `GuestService` of the gRPC API on top of the guest service
+used by the REST handlers, so both APIs share the same rules.
+*/
+type GuestServer struct {
+	pb.UnimplementedGuestServiceServer
+	service  service.IGuestService
+	arrivals *service.ArrivalFeed
+	logger   *slog.Logger
+}
+
+func NewGuestServer(gs service.IGuestService, arrivals *service.ArrivalFeed, logger *slog.Logger) *GuestServer {
+	return &GuestServer{service: gs, arrivals: arrivals, logger: logger}
+}
+
+func (gs *GuestServer) GetGuestList(ctx context.Context, req *pb.GetGuestListRequest) (*pb.GetGuestListResponse, error) {
+	guests, err := gs.service.GetGuestList(ctx, req.GetTag())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &pb.GetGuestListResponse{Guests: make([]*pb.GuestSeat, 0, len(guests))}
+	for _, guest := range guests {
+		res.Guests = append(res.Guests, &pb.GuestSeat{
+			Name:               guest.Name,
+			Table:              int32(guest.Table),
+			AccompanyingGuests: int32(guest.Accompanying_guests),
+		})
+	}
+	return res, nil
+}
+
+func (gs *GuestServer) GetArrivedGuests(ctx context.Context, req *pb.GetArrivedGuestsRequest) (*pb.GetArrivedGuestsResponse, error) {
+	guests, err := gs.service.GetArrivedGuests(ctx, req.GetTag())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &pb.GetArrivedGuestsResponse{Guests: make([]*pb.GuestArrival, 0, len(guests))}
+	for _, guest := range guests {
+		res.Guests = append(res.Guests, &pb.GuestArrival{
+			Name:               guest.Name,
+			AccompanyingGuests: int32(guest.Accompanying_guests),
+			ArrivedAt:          guest.Arrived_at,
+		})
+	}
+	return res, nil
+}
+
+func (gs *GuestServer) GetGuest(ctx context.Context, req *pb.GetGuestRequest) (*pb.Guest, error) {
+	gs.logger.InfoContext(ctx, "Fetching guest.", logging.GuestName(req.GetName()))
+
+	guest, err := gs.service.GetGuest(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toGuest(guest), nil
+}
+
+func (gs *GuestServer) CreateGuest(ctx context.Context, req *pb.CreateGuestRequest) (*pb.CreateGuestResponse, error) {
+	params := &model.GuestData{
+		Name:                req.GetName(),
+		Table:               int(req.GetTable()),
+		Accompanying_guests: int(req.GetAccompanyingGuests()),
+		GuestProfile:        fromProfile(req.GetProfile()),
+	}
+
+	gs.logger.InfoContext(ctx, "Adding guest.", logging.GuestName(params.Name), "table_id", params.Table, "entourage", params.Accompanying_guests)
+
+	if err := gs.service.CreateGuest(ctx, params); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateGuestResponse{Name: params.Name}, nil
+}
+
+func (gs *GuestServer) UpdateGuest(ctx context.Context, req *pb.UpdateGuestRequest) (*pb.Guest, error) {
+	params := &model.GuestData{
+		Name:                req.GetName(),
+		Accompanying_guests: int(req.GetAccompanyingGuests()),
+	}
+
+	guest, err := gs.service.UpdateGuest(ctx, params, int(req.GetVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toGuest(guest), nil
+}
+
+func (gs *GuestServer) UpdateGuestProfile(ctx context.Context, req *pb.UpdateGuestProfileRequest) (*pb.Guest, error) {
+	profile := fromProfile(req.GetProfile())
+
+	guest, err := gs.service.UpdateGuestProfile(ctx, req.GetName(), &profile, int(req.GetVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toGuest(guest), nil
+}
+
+func (gs *GuestServer) DeleteGuest(ctx context.Context, req *pb.DeleteGuestRequest) (*pb.DeleteGuestResponse, error) {
+	gs.logger.InfoContext(ctx, "Guest leaving.", logging.GuestName(req.GetName()))
+
+	if err := gs.service.DeleteGuest(ctx, req.GetName()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteGuestResponse{}, nil
+}
+
+/*
+`WatchArrivals` streams every guest let in or rejected at the door, through either API,
+until the client cancels or the server stops. Clients that can't keep up miss arrivals
+rather than slowing the check-in down.
+*/
+func (gs *GuestServer) WatchArrivals(_ *pb.WatchArrivalsRequest, stream pb.GuestService_WatchArrivalsServer) error {
+	ctx := stream.Context()
+
+	arrivals, unsubscribe := gs.arrivals.Subscribe()
+	defer unsubscribe()
+
+	gs.logger.InfoContext(ctx, "Watching arrivals.")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case guest := <-arrivals:
+			if err := stream.Send(toGuest(&guest)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// `toGuest` converts a guest of the service layer into its message.
+func toGuest(guest *model.Guest) *pb.Guest {
+	return &pb.Guest{
+		GuestId:            int32(guest.GuestID),
+		Name:               guest.Name,
+		AccompanyingGuests: int32(guest.Entourage),
+		ArrivalStatus:      string(guest.ArrivalStatus),
+		ArrivedAt:          arrivedAt(guest.ArrivedAt),
+		RsvpStatus:         string(guest.RSVPStatus),
+		Version:            int32(guest.Version),
+		Tags:               guest.Tags,
+		Profile: &pb.GuestProfile{
+			Email:              guest.Email,
+			Phone:              guest.Phone,
+			Diet:               string(guest.Diet),
+			DietNotes:          guest.DietNotes,
+			Allergies:          guest.Allergies,
+			AccessibilityNeeds: guest.AccessibilityNeeds,
+			Notes:              guest.Notes,
+		},
+		CreatedAt: guest.CreatedAt,
+		UpdatedAt: guest.UpdateAt,
+	}
+}
+
+// `fromProfile` converts a profile message into the profile of the service layer, empty when missing.
+func fromProfile(profile *pb.GuestProfile) model.GuestProfile {
+	return model.GuestProfile{
+		Email:              profile.GetEmail(),
+		Phone:              profile.GetPhone(),
+		Diet:               model.DietType(profile.GetDiet()),
+		DietNotes:          profile.GetDietNotes(),
+		Allergies:          profile.GetAllergies(),
+		AccessibilityNeeds: profile.GetAccessibilityNeeds(),
+		Notes:              profile.GetNotes(),
+	}
+}
+
+// `arrivedAt` formats the arrival time, read from the database as bytes or set by the service as a string.
+func arrivedAt(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
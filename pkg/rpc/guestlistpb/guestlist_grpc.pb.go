@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: guestlist/v1/guestlist.proto
+
+// Typed RPC API of the guest list, mirroring the guest and table routes of the REST API.
+
+package guestlistpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GuestService_GetGuestList_FullMethodName       = "/guestlist.v1.GuestService/GetGuestList"
+	GuestService_GetArrivedGuests_FullMethodName   = "/guestlist.v1.GuestService/GetArrivedGuests"
+	GuestService_GetGuest_FullMethodName           = "/guestlist.v1.GuestService/GetGuest"
+	GuestService_CreateGuest_FullMethodName        = "/guestlist.v1.GuestService/CreateGuest"
+	GuestService_UpdateGuest_FullMethodName        = "/guestlist.v1.GuestService/UpdateGuest"
+	GuestService_UpdateGuestProfile_FullMethodName = "/guestlist.v1.GuestService/UpdateGuestProfile"
+	GuestService_DeleteGuest_FullMethodName        = "/guestlist.v1.GuestService/DeleteGuest"
+	GuestService_WatchArrivals_FullMethodName      = "/guestlist.v1.GuestService/WatchArrivals"
+)
+
+// GuestServiceClient is the client API for GuestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuestServiceClient interface {
+	// Lists every guest with their table, only those with the tag when one is given.
+	GetGuestList(ctx context.Context, in *GetGuestListRequest, opts ...grpc.CallOption) (*GetGuestListResponse, error)
+	// Lists the guests that arrived, only those with the tag when one is given.
+	GetArrivedGuests(ctx context.Context, in *GetArrivedGuestsRequest, opts ...grpc.CallOption) (*GetArrivedGuestsResponse, error)
+	// Retrieves a guest by name.
+	GetGuest(ctx context.Context, in *GetGuestRequest, opts ...grpc.CallOption) (*Guest, error)
+	// Adds a guest to the guest list, seated at a table with room for their entourage.
+	CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*CreateGuestResponse, error)
+	// Checks a guest in with the entourage they came with. Guests whose entourage no longer fits are rejected.
+	UpdateGuest(ctx context.Context, in *UpdateGuestRequest, opts ...grpc.CallOption) (*Guest, error)
+	// Replaces the contact details, diet and accessibility needs of a guest.
+	UpdateGuestProfile(ctx context.Context, in *UpdateGuestProfileRequest, opts ...grpc.CallOption) (*Guest, error)
+	// Marks an arrived guest as left, releasing their seats.
+	DeleteGuest(ctx context.Context, in *DeleteGuestRequest, opts ...grpc.CallOption) (*DeleteGuestResponse, error)
+	// Streams the guests let in or rejected at the door from now on, until the client cancels.
+	WatchArrivals(ctx context.Context, in *WatchArrivalsRequest, opts ...grpc.CallOption) (GuestService_WatchArrivalsClient, error)
+}
+
+type guestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuestServiceClient(cc grpc.ClientConnInterface) GuestServiceClient {
+	return &guestServiceClient{cc}
+}
+
+func (c *guestServiceClient) GetGuestList(ctx context.Context, in *GetGuestListRequest, opts ...grpc.CallOption) (*GetGuestListResponse, error) {
+	out := new(GetGuestListResponse)
+	err := c.cc.Invoke(ctx, GuestService_GetGuestList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) GetArrivedGuests(ctx context.Context, in *GetArrivedGuestsRequest, opts ...grpc.CallOption) (*GetArrivedGuestsResponse, error) {
+	out := new(GetArrivedGuestsResponse)
+	err := c.cc.Invoke(ctx, GuestService_GetArrivedGuests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) GetGuest(ctx context.Context, in *GetGuestRequest, opts ...grpc.CallOption) (*Guest, error) {
+	out := new(Guest)
+	err := c.cc.Invoke(ctx, GuestService_GetGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*CreateGuestResponse, error) {
+	out := new(CreateGuestResponse)
+	err := c.cc.Invoke(ctx, GuestService_CreateGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) UpdateGuest(ctx context.Context, in *UpdateGuestRequest, opts ...grpc.CallOption) (*Guest, error) {
+	out := new(Guest)
+	err := c.cc.Invoke(ctx, GuestService_UpdateGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) UpdateGuestProfile(ctx context.Context, in *UpdateGuestProfileRequest, opts ...grpc.CallOption) (*Guest, error) {
+	out := new(Guest)
+	err := c.cc.Invoke(ctx, GuestService_UpdateGuestProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) DeleteGuest(ctx context.Context, in *DeleteGuestRequest, opts ...grpc.CallOption) (*DeleteGuestResponse, error) {
+	out := new(DeleteGuestResponse)
+	err := c.cc.Invoke(ctx, GuestService_DeleteGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestServiceClient) WatchArrivals(ctx context.Context, in *WatchArrivalsRequest, opts ...grpc.CallOption) (GuestService_WatchArrivalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuestService_ServiceDesc.Streams[0], GuestService_WatchArrivals_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guestServiceWatchArrivalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuestService_WatchArrivalsClient interface {
+	Recv() (*Guest, error)
+	grpc.ClientStream
+}
+
+type guestServiceWatchArrivalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guestServiceWatchArrivalsClient) Recv() (*Guest, error) {
+	m := new(Guest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GuestServiceServer is the server API for GuestService service.
+// All implementations must embed UnimplementedGuestServiceServer
+// for forward compatibility
+type GuestServiceServer interface {
+	// Lists every guest with their table, only those with the tag when one is given.
+	GetGuestList(context.Context, *GetGuestListRequest) (*GetGuestListResponse, error)
+	// Lists the guests that arrived, only those with the tag when one is given.
+	GetArrivedGuests(context.Context, *GetArrivedGuestsRequest) (*GetArrivedGuestsResponse, error)
+	// Retrieves a guest by name.
+	GetGuest(context.Context, *GetGuestRequest) (*Guest, error)
+	// Adds a guest to the guest list, seated at a table with room for their entourage.
+	CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error)
+	// Checks a guest in with the entourage they came with. Guests whose entourage no longer fits are rejected.
+	UpdateGuest(context.Context, *UpdateGuestRequest) (*Guest, error)
+	// Replaces the contact details, diet and accessibility needs of a guest.
+	UpdateGuestProfile(context.Context, *UpdateGuestProfileRequest) (*Guest, error)
+	// Marks an arrived guest as left, releasing their seats.
+	DeleteGuest(context.Context, *DeleteGuestRequest) (*DeleteGuestResponse, error)
+	// Streams the guests let in or rejected at the door from now on, until the client cancels.
+	WatchArrivals(*WatchArrivalsRequest, GuestService_WatchArrivalsServer) error
+	mustEmbedUnimplementedGuestServiceServer()
+}
+
+// UnimplementedGuestServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGuestServiceServer struct {
+}
+
+func (UnimplementedGuestServiceServer) GetGuestList(context.Context, *GetGuestListRequest) (*GetGuestListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestList not implemented")
+}
+func (UnimplementedGuestServiceServer) GetArrivedGuests(context.Context, *GetArrivedGuestsRequest) (*GetArrivedGuestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArrivedGuests not implemented")
+}
+func (UnimplementedGuestServiceServer) GetGuest(context.Context, *GetGuestRequest) (*Guest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuest not implemented")
+}
+func (UnimplementedGuestServiceServer) CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuest not implemented")
+}
+func (UnimplementedGuestServiceServer) UpdateGuest(context.Context, *UpdateGuestRequest) (*Guest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuest not implemented")
+}
+func (UnimplementedGuestServiceServer) UpdateGuestProfile(context.Context, *UpdateGuestProfileRequest) (*Guest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuestProfile not implemented")
+}
+func (UnimplementedGuestServiceServer) DeleteGuest(context.Context, *DeleteGuestRequest) (*DeleteGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuest not implemented")
+}
+func (UnimplementedGuestServiceServer) WatchArrivals(*WatchArrivalsRequest, GuestService_WatchArrivalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchArrivals not implemented")
+}
+func (UnimplementedGuestServiceServer) mustEmbedUnimplementedGuestServiceServer() {}
+
+// UnsafeGuestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuestServiceServer will
+// result in compilation errors.
+type UnsafeGuestServiceServer interface {
+	mustEmbedUnimplementedGuestServiceServer()
+}
+
+func RegisterGuestServiceServer(s grpc.ServiceRegistrar, srv GuestServiceServer) {
+	s.RegisterService(&GuestService_ServiceDesc, srv)
+}
+
+func _GuestService_GetGuestList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).GetGuestList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_GetGuestList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).GetGuestList(ctx, req.(*GetGuestListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_GetArrivedGuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArrivedGuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).GetArrivedGuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_GetArrivedGuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).GetArrivedGuests(ctx, req.(*GetArrivedGuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_GetGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).GetGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_GetGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).GetGuest(ctx, req.(*GetGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_CreateGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).CreateGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_CreateGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).CreateGuest(ctx, req.(*CreateGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_UpdateGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).UpdateGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_UpdateGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).UpdateGuest(ctx, req.(*UpdateGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_UpdateGuestProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuestProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).UpdateGuestProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_UpdateGuestProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).UpdateGuestProfile(ctx, req.(*UpdateGuestProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_DeleteGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestServiceServer).DeleteGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestService_DeleteGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestServiceServer).DeleteGuest(ctx, req.(*DeleteGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestService_WatchArrivals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchArrivalsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuestServiceServer).WatchArrivals(m, &guestServiceWatchArrivalsServer{stream})
+}
+
+type GuestService_WatchArrivalsServer interface {
+	Send(*Guest) error
+	grpc.ServerStream
+}
+
+type guestServiceWatchArrivalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guestServiceWatchArrivalsServer) Send(m *Guest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GuestService_ServiceDesc is the grpc.ServiceDesc for GuestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "guestlist.v1.GuestService",
+	HandlerType: (*GuestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGuestList",
+			Handler:    _GuestService_GetGuestList_Handler,
+		},
+		{
+			MethodName: "GetArrivedGuests",
+			Handler:    _GuestService_GetArrivedGuests_Handler,
+		},
+		{
+			MethodName: "GetGuest",
+			Handler:    _GuestService_GetGuest_Handler,
+		},
+		{
+			MethodName: "CreateGuest",
+			Handler:    _GuestService_CreateGuest_Handler,
+		},
+		{
+			MethodName: "UpdateGuest",
+			Handler:    _GuestService_UpdateGuest_Handler,
+		},
+		{
+			MethodName: "UpdateGuestProfile",
+			Handler:    _GuestService_UpdateGuestProfile_Handler,
+		},
+		{
+			MethodName: "DeleteGuest",
+			Handler:    _GuestService_DeleteGuest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchArrivals",
+			Handler:       _GuestService_WatchArrivals_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "guestlist/v1/guestlist.proto",
+}
+
+const (
+	TableService_GetTables_FullMethodName            = "/guestlist.v1.TableService/GetTables"
+	TableService_GetTable_FullMethodName             = "/guestlist.v1.TableService/GetTable"
+	TableService_CreateTable_FullMethodName          = "/guestlist.v1.TableService/CreateTable"
+	TableService_UpdateTable_FullMethodName          = "/guestlist.v1.TableService/UpdateTable"
+	TableService_DeleteTable_FullMethodName          = "/guestlist.v1.TableService/DeleteTable"
+	TableService_GetEmptySeatsAtTable_FullMethodName = "/guestlist.v1.TableService/GetEmptySeatsAtTable"
+	TableService_GetEmptySeats_FullMethodName        = "/guestlist.v1.TableService/GetEmptySeats"
+)
+
+// TableServiceClient is the client API for TableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TableServiceClient interface {
+	// Lists every table.
+	GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error)
+	// Retrieves a table by id.
+	GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// Adds a table with the given capacity.
+	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// Changes the capacity of a table, which can't go below the seats in use.
+	UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// Removes a table.
+	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error)
+	// Counts the empty seats of a table.
+	GetEmptySeatsAtTable(ctx context.Context, in *GetEmptySeatsAtTableRequest, opts ...grpc.CallOption) (*EmptySeats, error)
+	// Counts the empty seats across every table.
+	GetEmptySeats(ctx context.Context, in *GetEmptySeatsRequest, opts ...grpc.CallOption) (*EmptySeats, error)
+}
+
+type tableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTableServiceClient(cc grpc.ClientConnInterface) TableServiceClient {
+	return &tableServiceClient{cc}
+}
+
+func (c *tableServiceClient) GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error) {
+	out := new(GetTablesResponse)
+	err := c.cc.Invoke(ctx, TableService_GetTables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, TableService_GetTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, TableService_CreateTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, TableService_UpdateTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error) {
+	out := new(DeleteTableResponse)
+	err := c.cc.Invoke(ctx, TableService_DeleteTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) GetEmptySeatsAtTable(ctx context.Context, in *GetEmptySeatsAtTableRequest, opts ...grpc.CallOption) (*EmptySeats, error) {
+	out := new(EmptySeats)
+	err := c.cc.Invoke(ctx, TableService_GetEmptySeatsAtTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) GetEmptySeats(ctx context.Context, in *GetEmptySeatsRequest, opts ...grpc.CallOption) (*EmptySeats, error) {
+	out := new(EmptySeats)
+	err := c.cc.Invoke(ctx, TableService_GetEmptySeats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TableServiceServer is the server API for TableService service.
+// All implementations must embed UnimplementedTableServiceServer
+// for forward compatibility
+type TableServiceServer interface {
+	// Lists every table.
+	GetTables(context.Context, *GetTablesRequest) (*GetTablesResponse, error)
+	// Retrieves a table by id.
+	GetTable(context.Context, *GetTableRequest) (*Table, error)
+	// Adds a table with the given capacity.
+	CreateTable(context.Context, *CreateTableRequest) (*Table, error)
+	// Changes the capacity of a table, which can't go below the seats in use.
+	UpdateTable(context.Context, *UpdateTableRequest) (*Table, error)
+	// Removes a table.
+	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error)
+	// Counts the empty seats of a table.
+	GetEmptySeatsAtTable(context.Context, *GetEmptySeatsAtTableRequest) (*EmptySeats, error)
+	// Counts the empty seats across every table.
+	GetEmptySeats(context.Context, *GetEmptySeatsRequest) (*EmptySeats, error)
+	mustEmbedUnimplementedTableServiceServer()
+}
+
+// UnimplementedTableServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTableServiceServer struct {
+}
+
+func (UnimplementedTableServiceServer) GetTables(context.Context, *GetTablesRequest) (*GetTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTables not implemented")
+}
+func (UnimplementedTableServiceServer) GetTable(context.Context, *GetTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTable not implemented")
+}
+func (UnimplementedTableServiceServer) CreateTable(context.Context, *CreateTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTable not implemented")
+}
+func (UnimplementedTableServiceServer) UpdateTable(context.Context, *UpdateTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTable not implemented")
+}
+func (UnimplementedTableServiceServer) DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTable not implemented")
+}
+func (UnimplementedTableServiceServer) GetEmptySeatsAtTable(context.Context, *GetEmptySeatsAtTableRequest) (*EmptySeats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmptySeatsAtTable not implemented")
+}
+func (UnimplementedTableServiceServer) GetEmptySeats(context.Context, *GetEmptySeatsRequest) (*EmptySeats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmptySeats not implemented")
+}
+func (UnimplementedTableServiceServer) mustEmbedUnimplementedTableServiceServer() {}
+
+// UnsafeTableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TableServiceServer will
+// result in compilation errors.
+type UnsafeTableServiceServer interface {
+	mustEmbedUnimplementedTableServiceServer()
+}
+
+func RegisterTableServiceServer(s grpc.ServiceRegistrar, srv TableServiceServer) {
+	s.RegisterService(&TableService_ServiceDesc, srv)
+}
+
+func _TableService_GetTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).GetTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_GetTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).GetTables(ctx, req.(*GetTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_GetTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).GetTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_GetTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).GetTable(ctx, req.(*GetTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).CreateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_CreateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).CreateTable(ctx, req.(*CreateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_UpdateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).UpdateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_UpdateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).UpdateTable(ctx, req.(*UpdateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).DeleteTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_DeleteTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).DeleteTable(ctx, req.(*DeleteTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_GetEmptySeatsAtTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmptySeatsAtTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).GetEmptySeatsAtTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_GetEmptySeatsAtTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).GetEmptySeatsAtTable(ctx, req.(*GetEmptySeatsAtTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_GetEmptySeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmptySeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).GetEmptySeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_GetEmptySeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).GetEmptySeats(ctx, req.(*GetEmptySeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TableService_ServiceDesc is the grpc.ServiceDesc for TableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "guestlist.v1.TableService",
+	HandlerType: (*TableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTables",
+			Handler:    _TableService_GetTables_Handler,
+		},
+		{
+			MethodName: "GetTable",
+			Handler:    _TableService_GetTable_Handler,
+		},
+		{
+			MethodName: "CreateTable",
+			Handler:    _TableService_CreateTable_Handler,
+		},
+		{
+			MethodName: "UpdateTable",
+			Handler:    _TableService_UpdateTable_Handler,
+		},
+		{
+			MethodName: "DeleteTable",
+			Handler:    _TableService_DeleteTable_Handler,
+		},
+		{
+			MethodName: "GetEmptySeatsAtTable",
+			Handler:    _TableService_GetEmptySeatsAtTable_Handler,
+		},
+		{
+			MethodName: "GetEmptySeats",
+			Handler:    _TableService_GetEmptySeats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guestlist/v1/guestlist.proto",
+}
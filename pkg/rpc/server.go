@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	pb "github.com/fpetrikovich/go-guestlist/pkg/rpc/guestlistpb"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+/*
+`NewServer` creates the gRPC server of the guest and table services. Calls are traced like
+the REST routes, and unary calls without a deadline are given `timeout`, so a stuck database
+cannot hang them forever. Like the `X-Station-ID` and `X-Staff` headers of the REST routes,
+the `x-station-id` and `x-staff` metadata give the station and staff member the changes of
+the guests are recorded with, the station being `defaultStation` when missing.
+Reflection is enabled so tools like grpcurl can list the services.
+*/
+func NewServer(gs service.IGuestService, ts service.IEventTableService, arrivals *service.ArrivalFeed, timeout time.Duration, defaultStation string, logger *slog.Logger) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unaryInterceptor(timeout, defaultStation, logger)),
+	)
+
+	pb.RegisterGuestServiceServer(server, NewGuestServer(gs, arrivals, logger))
+	pb.RegisterTableServiceServer(server, NewTableServer(ts, logger))
+	reflection.Register(server)
+
+	return server
+}
+
+// `unaryInterceptor` gives the calls without a deadline the default timeout, stores the checkpoint of the call, and logs the calls that fail.
+func unaryInterceptor(timeout time.Duration, defaultStation string, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		checkpoint := model.Checkpoint{Station: firstValue(ctx, "x-station-id"), Staff: firstValue(ctx, "x-staff")}
+		if checkpoint.Station == "" {
+			checkpoint.Station = defaultStation
+		}
+		ctx = model.WithCheckpoint(ctx, checkpoint)
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			logger.WarnContext(ctx, "gRPC call failed.", "method", info.FullMethod, "code", status.Code(err).String(), "error", err)
+		}
+		return res, err
+	}
+}
+
+// Returns the first value of the metadata of the call with the key, or an empty string if there is none.
+func firstValue(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,182 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	pb "github.com/fpetrikovich/go-guestlist/pkg/rpc/guestlistpb"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+// `dial` serves the services in memory and returns a connection to them.
+func dial(t *testing.T, gs service.IGuestService, ts service.IEventTableService, arrivals *service.ArrivalFeed) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	server := NewServer(gs, ts, arrivals, time.Second, "", logging.NewNop())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func Test_GuestServer_GetGuest(t *testing.T) {
+	t.Run("Returns_NotFound_When_Guest_Doesnt_Exist", func(t *testing.T) {
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuest(gomock.Any(), "Flor").
+			Return(nil, ex.NewNotFoundError("Flor", "name", "guest")).
+			Times(1)
+
+		client := pb.NewGuestServiceClient(dial(t, mockService, nil, nil))
+
+		_, err := client.GetGuest(context.Background(), &pb.GetGuestRequest{Name: "Flor"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "guest with name Flor not found.", status.Convert(err).Message())
+	})
+
+	t.Run("Returns_Guest_With_Profile", func(t *testing.T) {
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			GetGuest(gomock.Any(), "Flor").
+			Return(&model.Guest{
+				GuestID:       1,
+				Name:          "Flor",
+				Entourage:     2,
+				ArrivalStatus: model.Arrived,
+				ArrivedAt:     []byte("2024-12-20 21:03:10"),
+				Version:       4,
+				Tags:          []string{model.VIPTag},
+				GuestProfile:  model.GuestProfile{Email: "flor@example.com", Diet: model.DietVegan},
+			}, nil).
+			Times(1)
+
+		client := pb.NewGuestServiceClient(dial(t, mockService, nil, nil))
+
+		guest, err := client.GetGuest(context.Background(), &pb.GetGuestRequest{Name: "Flor"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), guest.AccompanyingGuests)
+		assert.Equal(t, "arrived", guest.ArrivalStatus)
+		assert.Equal(t, "2024-12-20 21:03:10", guest.ArrivedAt)
+		assert.Equal(t, int32(4), guest.Version)
+		assert.Equal(t, []string{"vip"}, guest.Tags)
+		assert.Equal(t, "vegan", guest.Profile.Diet)
+	})
+}
+
+func Test_GuestServer_UpdateGuest(t *testing.T) {
+	t.Run("Returns_Aborted_When_Version_Changed", func(t *testing.T) {
+		mockService := service.NewMockIGuestService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: "Flor", Accompanying_guests: 3}, 2).
+			Return(nil, ex.NewPreconditionFailedError("guest", 2)).
+			Times(1)
+
+		client := pb.NewGuestServiceClient(dial(t, mockService, nil, nil))
+
+		_, err := client.UpdateGuest(context.Background(), &pb.UpdateGuestRequest{Name: "Flor", AccompanyingGuests: 3, Version: 2})
+
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+}
+
+func Test_GuestServer_WatchArrivals(t *testing.T) {
+	arrivals := service.NewArrivalFeed()
+	client := pb.NewGuestServiceClient(dial(t, nil, nil, arrivals))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchArrivals(ctx, &pb.WatchArrivalsRequest{})
+	assert.Nil(t, err)
+
+	// keep publishing until the stream subscribed and received the arrival
+	received := make(chan *pb.Guest, 1)
+	go func() {
+		guest, err := stream.Recv()
+		if err == nil {
+			received <- guest
+		}
+	}()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		arrivals.Publish(model.Guest{Name: "Flor", ArrivalStatus: model.Rejected, ArrivedAt: "2024-12-20 21:03:10"})
+		select {
+		case guest := <-received:
+			assert.Equal(t, "Flor", guest.Name)
+			assert.Equal(t, "rejected", guest.ArrivalStatus)
+			return
+		case <-timeout:
+			t.Fatal("no arrival received")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func Test_TableServer_UpdateTable(t *testing.T) {
+	t.Run("Returns_InvalidArgument_When_Capacity_Below_Seats_In_Use", func(t *testing.T) {
+		mockService := service.NewMockIEventTableService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateTable(gomock.Any(), 1, 2, service.AnyVersion).
+			Return(nil, ex.NewBadInputError("capacity 2 is below the 6 seats in use")).
+			Times(1)
+
+		client := pb.NewTableServiceClient(dial(t, nil, mockService, nil))
+
+		_, err := client.UpdateTable(context.Background(), &pb.UpdateTableRequest{Id: 1, Capacity: 2})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Returns_Table_When_Updated", func(t *testing.T) {
+		mockService := service.NewMockIEventTableService(gomock.NewController(t))
+		mockService.
+			EXPECT().
+			UpdateTable(gomock.Any(), 1, 12, 3).
+			Return(&model.EventTable{TableID: 1, Capacity: 12, Version: 4}, nil).
+			Times(1)
+
+		client := pb.NewTableServiceClient(dial(t, nil, mockService, nil))
+
+		table, err := client.UpdateTable(context.Background(), &pb.UpdateTableRequest{Id: 1, Capacity: 12, Version: 3})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(12), table.Capacity)
+		assert.Equal(t, int32(4), table.Version)
+	})
+}
+
+func Test_ToStatus(t *testing.T) {
+	assert.Nil(t, toStatus(nil))
+	assert.Equal(t, codes.AlreadyExists, status.Code(toStatus(ex.NewAlreadyExistsError("Flor", "name", "guest"))))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(toStatus(ex.NewExceedsCapacityError(1, 2))))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(toStatus(context.DeadlineExceeded)))
+
+	err := toStatus(assert.AnError)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "Server error.", status.Convert(err).Message())
+}
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	pb "github.com/fpetrikovich/go-guestlist/pkg/rpc/guestlistpb"
+	"github.com/fpetrikovich/go-guestlist/pkg/service"
+)
+
+/*
+The `TableServer` serves the `TableService` of the gRPC API on top of the table service
+used by the REST handlers.
+*/
+type TableServer struct {
+	pb.UnimplementedTableServiceServer
+	service service.IEventTableService
+	logger  *slog.Logger
+}
+
+func NewTableServer(ts service.IEventTableService, logger *slog.Logger) *TableServer {
+	return &TableServer{service: ts, logger: logger}
+}
+
+func (ts *TableServer) GetTables(ctx context.Context, _ *pb.GetTablesRequest) (*pb.GetTablesResponse, error) {
+	tables, err := ts.service.GetTables(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &pb.GetTablesResponse{Tables: make([]*pb.Table, 0, len(tables))}
+	for i := range tables {
+		res.Tables = append(res.Tables, toTable(&tables[i]))
+	}
+	return res, nil
+}
+
+func (ts *TableServer) GetTable(ctx context.Context, req *pb.GetTableRequest) (*pb.Table, error) {
+	ts.logger.InfoContext(ctx, "Fetching table.", "table_id", req.GetId())
+
+	table, err := ts.service.GetTable(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTable(table), nil
+}
+
+func (ts *TableServer) CreateTable(ctx context.Context, req *pb.CreateTableRequest) (*pb.Table, error) {
+	ts.logger.InfoContext(ctx, "Creating table.", "capacity", req.GetCapacity())
+
+	table, err := ts.service.CreateTable(ctx, &model.EventTable{Capacity: int(req.GetCapacity())})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTable(table), nil
+}
+
+func (ts *TableServer) UpdateTable(ctx context.Context, req *pb.UpdateTableRequest) (*pb.Table, error) {
+	ts.logger.InfoContext(ctx, "Updating table.", "table_id", req.GetId(), "capacity", req.GetCapacity())
+
+	table, err := ts.service.UpdateTable(ctx, int(req.GetId()), int(req.GetCapacity()), int(req.GetVersion()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTable(table), nil
+}
+
+func (ts *TableServer) DeleteTable(ctx context.Context, req *pb.DeleteTableRequest) (*pb.DeleteTableResponse, error) {
+	ts.logger.InfoContext(ctx, "Deleting table.", "table_id", req.GetId())
+
+	if err := ts.service.DeleteTable(ctx, int(req.GetId())); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteTableResponse{}, nil
+}
+
+func (ts *TableServer) GetEmptySeatsAtTable(ctx context.Context, req *pb.GetEmptySeatsAtTableRequest) (*pb.EmptySeats, error) {
+	seats, err := ts.service.GetEmptySeatsAtTable(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.EmptySeats{SeatsEmpty: int32(seats)}, nil
+}
+
+func (ts *TableServer) GetEmptySeats(ctx context.Context, _ *pb.GetEmptySeatsRequest) (*pb.EmptySeats, error) {
+	seats, err := ts.service.GetEmptySeats(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.EmptySeats{SeatsEmpty: int32(seats)}, nil
+}
+
+// `toTable` converts a table of the service layer into its message.
+func toTable(table *model.EventTable) *pb.Table {
+	return &pb.Table{
+		Id:        int32(table.TableID),
+		Capacity:  int32(table.Capacity),
+		Version:   int32(table.Version),
+		CreatedAt: table.CreatedAt,
+		UpdatedAt: table.UpdatedAt,
+	}
+}
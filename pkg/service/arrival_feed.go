@@ -0,0 +1,58 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+// Arrivals buffered for each subscriber before newer ones are dropped.
+const arrivalFeedBuffer = 32
+
+/*
+The `ArrivalFeed` broadcasts the arrivals handled by the guest service to whoever watches
+them, e.g. the door screens streaming arrivals over gRPC.
+
+Publishing never blocks: subscribers that fall more than `arrivalFeedBuffer` arrivals behind
+miss the newer ones. A nil feed is valid and ignores arrivals.
+*/
+type ArrivalFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan model.Guest]struct{}
+}
+
+func NewArrivalFeed() *ArrivalFeed {
+	return &ArrivalFeed{subscribers: make(map[chan model.Guest]struct{})}
+}
+
+// Publish sends the guest, just arrived or rejected at the door, to every subscriber.
+func (f *ArrivalFeed) Publish(guest model.Guest) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for c := range f.subscribers {
+		select {
+		case c <- guest:
+		default:
+		}
+	}
+}
+
+// Subscribe returns the channel that receives the arrivals from now on, and the function that stops them.
+func (f *ArrivalFeed) Subscribe() (<-chan model.Guest, func()) {
+	c := make(chan model.Guest, arrivalFeedBuffer)
+	f.mu.Lock()
+	f.subscribers[c] = struct{}{}
+	f.mu.Unlock()
+
+	var once sync.Once
+	return c, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			delete(f.subscribers, c)
+			f.mu.Unlock()
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+import "time"
+
+// The `Clock` interface tells the current time, so tests can control the time seen by the services.
+type Clock interface {
+	Now() time.Time
+}
+
+// `SystemClock` is the Clock that tells the time of the system.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
@@ -1,131 +1,496 @@
 package service
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"log/slog"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+
 	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
 	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
 )
 
+var tracer = otel.Tracer("github.com/fpetrikovich/go-guestlist/pkg/service")
+
 /*
 The methods of this service allow for the retrieval of guest data, creation of new guests,
 updating existing guests, and deleting guests.
 
 Additionally, this service checks if the number of accompanying guests is a valid input,
 and checks if there is enough room at a table for the guests before creating or updating a guest.
+The last `vipReserveSeats` free seats of each table are held back for guests tagged as VIP, so guests
+added to a table, which have no tags yet, and guests arriving without the tag can't take them.
+Mistakes at the door can be undone within `undoWindow` of the change.
+A `doorStation` copies its guests from the central instance, so it can't add guests of its own.
+When a guest leaves, `seatsFreed` is notified so the waitlist can offer their seats.
+Every arrival, let in or rejected, is published to `arrivals` for those watching the door.
 
 The package also includes error handling for any exceptions that may occur during the process.
 */
 type DefaultGuestService struct {
 	guestRepository repository.IGuestRepository
 	tableService    IEventTableService
+	vipReserveSeats int
+	undoWindow      time.Duration
+	doorStation     bool
+	seatsFreed      *SeatsFreedSignal
+	arrivals        *ArrivalFeed
+	logger          *slog.Logger
 }
 
-func NewDefaultGuestService(gRepo repository.IGuestRepository, tService IEventTableService) *DefaultGuestService {
+func NewDefaultGuestService(gRepo repository.IGuestRepository, tService IEventTableService, vipReserveSeats int, undoWindow time.Duration, doorStation bool, seatsFreed *SeatsFreedSignal, arrivals *ArrivalFeed, logger *slog.Logger) *DefaultGuestService {
 	return &DefaultGuestService{
 		guestRepository: gRepo,
 		tableService:    tService,
+		vipReserveSeats: vipReserveSeats,
+		undoWindow:      undoWindow,
+		doorStation:     doorStation,
+		seatsFreed:      seatsFreed,
+		arrivals:        arrivals,
+		logger:          logger,
 	}
 }
 
-func (d *DefaultGuestService) GetGuestList() ([]model.GuestData, error) {
-	return d.guestRepository.GetGuestList()
+func (d *DefaultGuestService) GetGuestList(ctx context.Context, tag string) ([]model.GuestData, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.GetGuestList")
+	defer span.End()
+
+	tag, err := normalizeTagFilter(tag)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	guests, err := d.guestRepository.GetGuestList(ctx, tag)
+	return guests, tracing.RecordError(span, err)
 }
 
-func (d *DefaultGuestService) GetArrivedGuests() ([]model.GuestArrival, error) {
-	return d.guestRepository.GetArrivedGuests()
+func (d *DefaultGuestService) GetArrivedGuests(ctx context.Context, tag string) ([]model.GuestArrival, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.GetArrivedGuests")
+	defer span.End()
+
+	tag, err := normalizeTagFilter(tag)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	guests, err := d.guestRepository.GetArrivedGuests(ctx, tag)
+	return guests, tracing.RecordError(span, err)
 }
 
-func (d *DefaultGuestService) GetGuest(name string) (*model.Guest, error) {
+func (d *DefaultGuestService) GetGuest(ctx context.Context, name string) (*model.Guest, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.GetGuest")
+	defer span.End()
+
 	// Check name doesnt have spaces
 	err := e.ValidateStringInput(name)
 	if err != nil {
-		return &model.Guest{}, err
+		return &model.Guest{}, tracing.RecordError(span, err)
 	}
-	return d.guestRepository.GetGuest(name)
+	guest, err := d.guestRepository.GetGuest(ctx, name)
+	return guest, tracing.RecordError(span, err)
+}
+
+func (d *DefaultGuestService) GetGuestsByName(ctx context.Context, names []string) ([]model.Guest, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.GetGuestsByName")
+	defer span.End()
+
+	guests, err := d.guestRepository.GetGuestsByName(ctx, names)
+	return guests, tracing.RecordError(span, err)
+}
+
+func (d *DefaultGuestService) GetGuestsAtTables(ctx context.Context, tableIDs []int) (map[int][]model.Guest, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.GetGuestsAtTables")
+	defer span.End()
+
+	guests, err := d.guestRepository.GetGuestsAtTables(ctx, tableIDs)
+	return guests, tracing.RecordError(span, err)
+}
+
+func (d *DefaultGuestService) GetSeating(ctx context.Context, guestIDs []int) ([]model.Seating, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.GetSeating")
+	defer span.End()
+
+	seating, err := d.guestRepository.GetSeating(ctx, guestIDs)
+	return seating, tracing.RecordError(span, err)
 }
 
 /**
  * Creates a new guest to add to the guestlist. Checks if the guests fits at the specified
- * table, checking if the input parameters are valid.
+ * table, outside the seats held back for VIPs, checking if the input parameters are valid,
+ * and generates the token of the guest's invitation link.
  * If the guest and their entourage do not fit in the table, returns an ExceedsCapacity err.
+ * A door station returns a CentralOnly err, guests are only added at the central instance.
  *
  * @param  params  pointer to GuestData
  */
-func (d *DefaultGuestService) CreateGuest(params *model.GuestData) error {
+func (d *DefaultGuestService) CreateGuest(ctx context.Context, params *model.GuestData) error {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.CreateGuest")
+	defer span.End()
+
+	// The guests of a door station are replaced by those of the central instance on every sync
+	if d.doorStation {
+		return tracing.RecordError(span, e.NewCentralOnlyError("Guests can be added"))
+	}
+
 	// Check entourage is a valid number
 	err := e.ValidatePositiveInput(params.Accompanying_guests)
 	if err != nil {
-		return err
+		return tracing.RecordError(span, err)
 	}
 
 	// Check name doesnt have spaces
 	err = e.ValidateStringInput(params.Name)
 	if err != nil {
-		return err
+		return tracing.RecordError(span, err)
 	}
 
-	// Check table capacity
-	free, err := d.tableService.GetEmptySeatsAtTable(params.Table)
+	// Check contact details and diet
+	err = validateProfile(&params.GuestProfile)
 	if err != nil {
-		return err
+		return tracing.RecordError(span, err)
 	}
 
+	// Check table capacity, new guests aren't tagged as VIP yet
+	free, err := d.tableService.GetEmptySeatsAtTable(ctx, params.Table)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+	free = seatsOutsideReserve(free, d.vipReserveSeats, false)
+
 	// No room at table
 	if free < (params.Accompanying_guests + 1) {
-		return e.NewExceedsCapacityError(free, (params.Accompanying_guests+1)-free)
+		return tracing.RecordError(span, e.NewExceedsCapacityError(free, (params.Accompanying_guests+1)-free))
 	}
 
-	return d.guestRepository.CreateGuest(params)
+	token, err := NewInvitationToken()
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	return tracing.RecordError(span, d.guestRepository.CreateGuest(ctx, params, token))
 }
 
 /**
  * Handle the arrival of a guest to the event.
  * Sets the guest as arrived if the new entourage still fits in the table. Sets the
  * guest as rejected if they no longer fit in the table. Updates the arrival time to now.
+ * The seats held back for VIPs only count as free for guests with the VIP tag.
+ * Guests marked as no-shows gave up their seats, so their whole party must fit again.
+ * If the guest is no longer at the expected version, returns a PreconditionFailed error.
  *
- * @param  params  pointer to GuestData
+ * @param  params   pointer to GuestData
+ * @param  version  version the client read the guest at, or AnyVersion
+ * @return          pointer to the updated Guest
  */
-func (d *DefaultGuestService) UpdateGuest(params *model.GuestData) error {
+func (d *DefaultGuestService) UpdateGuest(ctx context.Context, params *model.GuestData, version int) (*model.Guest, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.UpdateGuest")
+	defer span.End()
 
 	// Check entourage is a valid number
 	err := e.ValidatePositiveInput(params.Accompanying_guests)
 	if err != nil {
-		return err
+		return nil, tracing.RecordError(span, err)
 	}
 
 	// fetch the guest to update
-	guest, err := d.guestRepository.GetGuest(params.Name)
+	guest, err := d.guestRepository.GetGuest(ctx, params.Name)
 	if err != nil {
-		return err
+		return nil, tracing.RecordError(span, err)
 	}
 
-	// difference between what was expected and who they brought ==> + if they brought more
-	entourageDiff := params.Accompanying_guests - guest.Entourage
-	freeSeats, err := d.guestRepository.GetGuestTableFreeSeats(params.Name)
+	// someone else changed the guest since the client read it
+	if version != AnyVersion && guest.Version != version {
+		return nil, tracing.RecordError(span, e.NewPreconditionFailedError("guest", version))
+	}
+
+	freeSeats, err := d.guestRepository.GetGuestTableFreeSeats(ctx, params.Name)
 	if err != nil {
-		return err
+		return nil, tracing.RecordError(span, err)
 	}
 
+	d.admit(guest, params.Accompanying_guests, freeSeats)
+	vip := guest.HasTag(model.VIPTag)
+
+	span.SetAttributes(
+		attribute.String("guest.arrival_status", string(guest.ArrivalStatus)),
+		attribute.Bool("guest.vip", vip),
+	)
+
+	d.logger.InfoContext(ctx, "Updating guest arrival.",
+		"guest_id", guest.GuestID,
+		logging.GuestName(guest.Name),
+		"arrival_status", guest.ArrivalStatus,
+		"entourage", guest.Entourage,
+		"free_seats", freeSeats,
+		"vip", vip,
+	)
+
+	err = d.guestRepository.UpdateGuest(ctx, guest)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	d.arrivals.Publish(*guest)
+
+	return guest, nil
+}
+
+/**
+ * Lets in a batch of guests, like a bus arriving at the door, in a single transaction.
+ * Each guest is admitted like in UpdateGuest, with the seats taken by the guests before
+ * them in the batch already accounted for. Guests that can't be found don't stop the batch,
+ * they are reported as not found.
+ * The arrivals are validated first: the batch can't be empty or larger than MaxArrivalBatch,
+ * and every guest needs an id or name, a valid entourage and to be listed once.
+ *
+ * @param  arrivals  guests arriving, in the order they are let in
+ * @return           pointer to the BatchArrivalReport with the result of each guest
+ */
+func (d *DefaultGuestService) ArriveGuests(ctx context.Context, arrivals []model.BatchArrival) (*model.BatchArrivalReport, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.ArriveGuests")
+	defer span.End()
+
+	if err := validateBatchArrivals(arrivals); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	guests, err := d.guestRepository.ArriveGuests(ctx, arrivals, d.admit)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	report := &model.BatchArrivalReport{Results: make([]model.BatchArrivalResult, len(arrivals))}
+	for i, guest := range guests {
+		if guest == nil {
+			report.Results[i] = model.BatchArrivalResult{
+				GuestID:             arrivals[i].GuestID,
+				Name:                arrivals[i].Name,
+				Status:              model.BatchNotFound,
+				Accompanying_guests: arrivals[i].Accompanying_guests,
+			}
+			report.NotFound++
+			continue
+		}
+
+		status := model.BatchArrived
+		if guest.ArrivalStatus == model.Rejected {
+			status = model.BatchRejected
+			report.Rejected++
+		} else {
+			report.Arrived++
+		}
+		report.Results[i] = model.BatchArrivalResult{
+			GuestID:             guest.GuestID,
+			Name:                guest.Name,
+			Status:              status,
+			Accompanying_guests: guest.Entourage,
+			Version:             guest.Version,
+		}
+
+		d.arrivals.Publish(*guest)
+	}
+
+	span.SetAttributes(
+		attribute.Int("batch.size", len(arrivals)),
+		attribute.Int("batch.arrived", report.Arrived),
+		attribute.Int("batch.rejected", report.Rejected),
+	)
+
+	d.logger.InfoContext(ctx, "Batch of guests arrived.",
+		"size", len(arrivals),
+		"arrived", report.Arrived,
+		"rejected", report.Rejected,
+		"not_found", report.NotFound,
+	)
+
+	return report, nil
+}
+
+// Maximum number of guests let in by a single call to ArriveGuests.
+const MaxArrivalBatch = 200
+
+func validateBatchArrivals(arrivals []model.BatchArrival) error {
+	if len(arrivals) == 0 {
+		return e.NewBadInputError("no arrivals in the batch")
+	}
+	if len(arrivals) > MaxArrivalBatch {
+		return e.NewBadInputError(fmt.Sprintf("more than %d arrivals in the batch", MaxArrivalBatch))
+	}
+
+	ids := make(map[int]bool)
+	names := make(map[string]bool)
+	for i, arrival := range arrivals {
+		if err := e.ValidatePositiveInput(arrival.Accompanying_guests); err != nil {
+			return err
+		}
+		switch {
+		case arrival.GuestID < 0:
+			return e.NewBadInputError(fmt.Sprintf("arrivals[%d] has an invalid guest_id", i))
+		case arrival.GuestID != 0:
+			if ids[arrival.GuestID] {
+				return e.NewBadInputError(fmt.Sprintf("guest %d is listed more than once", arrival.GuestID))
+			}
+			ids[arrival.GuestID] = true
+		case arrival.Name != "":
+			if names[arrival.Name] {
+				return e.NewBadInputError(fmt.Sprintf("guest %s is listed more than once", arrival.Name))
+			}
+			names[arrival.Name] = true
+		default:
+			return e.NewBadInputError(fmt.Sprintf("arrivals[%d] has no guest_id or name", i))
+		}
+	}
+	return nil
+}
+
+/*
+`admit` sets the guest as arrived with entourage if they still fit in the free seats of their table,
+or as rejected otherwise, updating the arrival time to now.
+The seats held back for VIPs only count as free for guests with the VIP tag.
+Guests marked as no-shows gave up their seats, so their whole party must fit again.
+*/
+func (d *DefaultGuestService) admit(guest *model.Guest, entourage int, freeSeats int) {
+	// difference between what was expected and who they brought ==> + if they brought more
+	entourageDiff := entourage - guest.Entourage
+	// no-shows turning up late, and guests that declined turning up anyway, no longer hold
+	// their seats, so the whole party needs room
+	if guest.ArrivalStatus == model.NoShow || (guest.ArrivalStatus == model.NotArrived && guest.RSVPStatus == model.Declined) {
+		entourageDiff = entourage + 1
+	}
+
+	// seats held back for VIPs can't be taken by anyone else
+	availableSeats := seatsOutsideReserve(freeSeats, d.vipReserveSeats, guest.HasTag(model.VIPTag))
+
 	// updating guest model
-	guest.Entourage = params.Accompanying_guests
+	guest.Entourage = entourage
 	guest.ArrivedAt = time.Now().Format("2006-01-02 15:04:05")
 
 	// check if there is room for them in the table
-	if freeSeats < entourageDiff {
+	if availableSeats < entourageDiff {
 		// update user to rejected
 		guest.ArrivalStatus = model.Rejected
 	} else {
 		guest.ArrivalStatus = model.Arrived
 	}
+}
+
+/*
+`seatsOutsideReserve` returns the free seats of a table a party can take. The last `reserve`
+free seats are held back for guests tagged as VIP, so they only count as free for a `vip` party.
+*/
+func seatsOutsideReserve(freeSeats int, reserve int, vip bool) int {
+	if vip || freeSeats <= 0 {
+		return freeSeats
+	}
+	return max(freeSeats-reserve, 0)
+}
+
+/**
+ * Replaces the contact details, diet and accessibility needs of a guest. The profile
+ * is validated first, and the diet defaults to none when missing.
+ * If the guest is no longer at the expected version, returns a PreconditionFailed error.
+ *
+ * @param  name     name of the guest
+ * @param  profile  pointer to the new GuestProfile
+ * @param  version  version the client read the guest at, or AnyVersion
+ * @return          pointer to the updated Guest
+ */
+func (d *DefaultGuestService) UpdateGuestProfile(ctx context.Context, name string, profile *model.GuestProfile, version int) (*model.Guest, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.UpdateGuestProfile")
+	defer span.End()
+
+	err := validateProfile(profile)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	guest, err := d.guestRepository.GetGuest(ctx, name)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
 
-	log.Print("[INFO] Updating guest: ", *guest)
+	// someone else changed the guest since the client read it
+	if version != AnyVersion && guest.Version != version {
+		return nil, tracing.RecordError(span, e.NewPreconditionFailedError("guest", version))
+	}
+
+	guest.GuestProfile = *profile
+
+	d.logger.InfoContext(ctx, "Updating guest profile.", "guest_id", guest.GuestID, logging.GuestName(guest.Name))
+
+	err = d.guestRepository.UpdateGuestProfile(ctx, guest)
+
+	return guest, tracing.RecordError(span, err)
+}
+
+func (d *DefaultGuestService) DeleteGuest(ctx context.Context, name string) error {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.DeleteGuest")
+	defer span.End()
+
+	err := d.guestRepository.DeleteGuest(ctx, name)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	d.seatsFreed.Notify()
+
+	return nil
+}
+
+/**
+ * Undoes the last change of the arrival of a guest, like checking in the wrong guest or letting
+ * out one that didn't leave, restoring the status, entourage and arrival time before the change.
+ * Only changes made within the undo window can be undone, and undoing again undoes the change
+ * before. Undoing never takes seats that were given to someone else in the meantime.
+ * If the guest is no longer at the expected version, returns a PreconditionFailed error.
+ *
+ * @param  name     name of the guest
+ * @param  version  version the client read the guest at, or AnyVersion
+ * @return          pointer to the StatusChange undone, with the new version of the guest
+ */
+func (d *DefaultGuestService) UndoStatusChange(ctx context.Context, name string, version int) (*model.StatusChange, error) {
+	ctx, span := tracer.Start(ctx, "DefaultGuestService.UndoStatusChange")
+	defer span.End()
+
+	// Check name doesnt have spaces
+	err := e.ValidateStringInput(name)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	change, err := d.guestRepository.UndoStatusChange(ctx, name, version, d.undoWindow)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("guest.arrival_status", string(change.Before.ArrivalStatus)),
+		attribute.String("guest.undone_status", string(change.After.ArrivalStatus)),
+	)
+
+	d.logger.InfoContext(ctx, "Undid guest arrival change.",
+		"guest_id", change.GuestID,
+		logging.GuestName(change.Name),
+		"change_id", change.ChangeID,
+		"from", change.After.ArrivalStatus,
+		"to", change.Before.ArrivalStatus,
+	)
+
+	// the seats of an undone arrival, or of a smaller entourage, go back to the table
+	if holdsSeats(change.After.ArrivalStatus) && (!holdsSeats(change.Before.ArrivalStatus) || change.Before.Accompanying_guests < change.After.Accompanying_guests) {
+		d.seatsFreed.Notify()
+	}
 
-	return d.guestRepository.UpdateGuest(guest)
+	return change, nil
 }
 
-func (d *DefaultGuestService) DeleteGuest(name string) error {
-	return d.guestRepository.DeleteGuest(name)
+// Tells whether guests with the arrival status keep their seats at the table.
+func holdsSeats(status model.GuestStatus) bool {
+	return status == model.NotArrived || status == model.Arrived
 }
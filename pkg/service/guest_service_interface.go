@@ -1,6 +1,13 @@
 package service
 
-import "github.com/fpetrikovich/go-guestlist/pkg/model"
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+// Expected version that skips the optimistic concurrency check, for callers that don't hold a version.
+const AnyVersion = 0
 
 /*
 The `IGuestService` is an interface that defines methods for managing guest data.
@@ -9,16 +16,28 @@ allowing different implementations to be swapped in and out as needed while stil
 adhering to the same interface.
 */
 type IGuestService interface {
-	// Retrieves a list of all guests represented by `[]model.GuestData`.
-	GetGuestList() ([]model.GuestData, error)
-	// Retrieves a list of arrived guests represented by `[]model.GuestArrival`.
-	GetArrivedGuests() ([]model.GuestArrival, error)
+	// Retrieves a list of all guests represented by `[]model.GuestData`, only those with the tag when one is given.
+	GetGuestList(ctx context.Context, tag string) ([]model.GuestData, error)
+	// Retrieves a list of arrived guests represented by `[]model.GuestArrival`, only those with the tag when one is given.
+	GetArrivedGuests(ctx context.Context, tag string) ([]model.GuestArrival, error)
 	// Retrieves a single guest by name represented by a pointer to `model.Guest`.
-	GetGuest(name string) (*model.Guest, error)
+	GetGuest(ctx context.Context, name string) (*model.Guest, error)
+	// Retrieves the guests with any of the given names in a single lookup, leaving out the names without a guest.
+	GetGuestsByName(ctx context.Context, names []string) ([]model.Guest, error)
+	// Retrieves the guests seated at any of the given tables in a single lookup, grouped by table id.
+	GetGuestsAtTables(ctx context.Context, tableIDs []int) (map[int][]model.Guest, error)
+	// Retrieves the tables of the given guests in a single lookup.
+	GetSeating(ctx context.Context, guestIDs []int) ([]model.Seating, error)
 	// Creates a new guest with parameters represented by `model.GuestData`.
-	CreateGuest(params *model.GuestData) error
-	// Updates an existing guest with parameters represented by `model.GuestData`.
-	UpdateGuest(params *model.GuestData) error
+	CreateGuest(ctx context.Context, params *model.GuestData) error
+	// Updates an existing guest with parameters represented by `model.GuestData`, if it is still at the expected version.
+	UpdateGuest(ctx context.Context, params *model.GuestData, version int) (*model.Guest, error)
+	// Lets in a batch of guests in a single transaction, reporting whether each one arrived, was rejected or wasn't found.
+	ArriveGuests(ctx context.Context, arrivals []model.BatchArrival) (*model.BatchArrivalReport, error)
+	// Replaces the contact details, diet and accessibility needs of a guest, if it is still at the expected version.
+	UpdateGuestProfile(ctx context.Context, name string, profile *model.GuestProfile, version int) (*model.Guest, error)
 	// Deletes a guest by name.
-	DeleteGuest(name string) error
+	DeleteGuest(ctx context.Context, name string) error
+	// Undoes the last change of the arrival of a guest, if it is still at the expected version, reporting the change undone.
+	UndoStatusChange(ctx context.Context, name string, version int) (*model.StatusChange, error)
 }
@@ -1,9 +1,13 @@
 package service
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
 
 	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
 	"github.com/fpetrikovich/go-guestlist/pkg/repository"
 	"github.com/golang/mock/gomock"
@@ -27,8 +31,8 @@ func Test_DefaultTableService_UpdateGuest(t *testing.T) {
 			Table:               1,
 		}
 
-		dms := NewDefaultGuestService(nil, nil)
-		err := dms.UpdateGuest(&testCase)
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+		_, err := dms.UpdateGuest(context.Background(), &testCase, AnyVersion)
 		assert.Equal(t, err.Error(), ex.NewBadInputError("-4").Error())
 	})
 
@@ -45,16 +49,37 @@ func Test_DefaultTableService_UpdateGuest(t *testing.T) {
 		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
 		mockRepository.
 			EXPECT().
-			GetGuest(name).
+			GetGuest(gomock.Any(), name).
 			Return(&model.Guest{}, errNotFound).
 			Times(1)
 
-		ms := NewDefaultGuestService(mockRepository, nil)
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
 
-		err := ms.UpdateGuest(&testCase)
+		_, err := ms.UpdateGuest(context.Background(), &testCase, AnyVersion)
 		assert.Equal(t, err.Error(), errNotFound.Error())
 	})
 
+	t.Run("Return_PreconditionFailed_When_Version_Changed", func(t *testing.T) {
+
+		testCase := model.GuestData{
+			Name:                name,
+			Accompanying_guests: 4,
+			Table:               1,
+		}
+
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuest(gomock.Any(), name).
+			Return(&model.Guest{GuestID: 1, Name: name, Version: 3}, nil).
+			Times(1)
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		_, err := ms.UpdateGuest(context.Background(), &testCase, 2)
+		assert.Equal(t, err.Error(), ex.NewPreconditionFailedError("guest", 2).Error())
+	})
+
 	t.Run("Set_Rejected_When_Entourage_Exceeds_Capacity", func(t *testing.T) {
 
 		testCase := model.GuestData{
@@ -67,24 +92,24 @@ func Test_DefaultTableService_UpdateGuest(t *testing.T) {
 
 		mockRepository.
 			EXPECT().
-			GetGuest(name).
+			GetGuest(gomock.Any(), name).
 			Return(&guest, nil).
 			Times(1)
 
 		mockRepository.
 			EXPECT().
-			GetGuestTableFreeSeats(name).
+			GetGuestTableFreeSeats(gomock.Any(), name).
 			Return(3, nil).
 			Times(1)
 
 		mockRepository.
 			EXPECT().
-			UpdateGuest(&guest).
+			UpdateGuest(gomock.Any(), &guest).
 			Return(nil).
 			Times(1)
-		ms := NewDefaultGuestService(mockRepository, nil)
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
 
-		_ = ms.UpdateGuest(&testCase)
+		_, _ = ms.UpdateGuest(context.Background(), &testCase, AnyVersion)
 		assert.Equal(t, guest.ArrivalStatus, model.GuestStatus("rejected"))
 	})
 
@@ -111,30 +136,141 @@ func Test_DefaultTableService_UpdateGuest(t *testing.T) {
 
 		mockRepository.
 			EXPECT().
-			GetGuest(name).
+			GetGuest(gomock.Any(), name).
 			Return(&guest, nil).
 			Times(len(testCases))
 
 		mockRepository.
 			EXPECT().
-			GetGuestTableFreeSeats(name).
+			GetGuestTableFreeSeats(gomock.Any(), name).
 			Return(3, nil).
 			Times(len(testCases))
 
 		mockRepository.
 			EXPECT().
-			UpdateGuest(&guest).
+			UpdateGuest(gomock.Any(), &guest).
 			Return(nil).
 			Times(len(testCases))
 
-		ms := NewDefaultGuestService(mockRepository, nil)
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
 
 		for _, test := range testCases {
-			err := ms.UpdateGuest(&test)
+			_, err := ms.UpdateGuest(context.Background(), &test, AnyVersion)
 			assert.Equal(t, guest.ArrivalStatus, model.GuestStatus("arrived"))
 			assert.Nil(t, err)
 		}
 	})
+
+	t.Run("Use_Reserved_Seats_Only_When_Guest_Is_VIP", func(t *testing.T) {
+		// 3 free seats, 2 of them held back for VIPs
+		testCases := []struct {
+			name   string
+			guest  model.Guest
+			brings int
+			status model.GuestStatus
+		}{
+			{"Regular_Guest_Bringing_More", model.Guest{Name: name, Entourage: 3}, 5, model.Rejected},
+			{"Regular_Guest_Bringing_One_More", model.Guest{Name: name, Entourage: 3}, 4, model.Arrived},
+			{"Regular_Guest_Bringing_Same", model.Guest{Name: name, Entourage: 3}, 3, model.Arrived},
+			{"VIP_Guest_Bringing_More", model.Guest{Name: name, Entourage: 3, Tags: []string{"press", model.VIPTag}}, 6, model.Arrived},
+		}
+
+		for _, test := range testCases {
+			t.Run(test.name, func(t *testing.T) {
+				guest := test.guest
+
+				mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+				mockRepository.EXPECT().GetGuest(gomock.Any(), name).Return(&guest, nil).Times(1)
+				mockRepository.EXPECT().GetGuestTableFreeSeats(gomock.Any(), name).Return(3, nil).Times(1)
+				mockRepository.EXPECT().UpdateGuest(gomock.Any(), &guest).Return(nil).Times(1)
+
+				ms := NewDefaultGuestService(mockRepository, nil, 2, 0, false, nil, nil, logging.NewNop())
+
+				updated, err := ms.UpdateGuest(context.Background(), &model.GuestData{Name: name, Accompanying_guests: test.brings}, AnyVersion)
+				assert.Nil(t, err)
+				assert.EqualValues(t, test.status, updated.ArrivalStatus)
+			})
+		}
+	})
+
+	t.Run("Publish_Arrival_When_Updated", func(t *testing.T) {
+		guest := model.Guest{GuestID: 1, Name: name, Entourage: 3}
+
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.EXPECT().GetGuest(gomock.Any(), name).Return(&guest, nil).Times(1)
+		mockRepository.EXPECT().GetGuestTableFreeSeats(gomock.Any(), name).Return(0, nil).Times(1)
+		mockRepository.EXPECT().UpdateGuest(gomock.Any(), &guest).Return(nil).Times(1)
+
+		arrivals := NewArrivalFeed()
+		watched, unsubscribe := arrivals.Subscribe()
+		defer unsubscribe()
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, arrivals, logging.NewNop())
+
+		_, err := ms.UpdateGuest(context.Background(), &model.GuestData{Name: name, Accompanying_guests: 3}, AnyVersion)
+		assert.Nil(t, err)
+
+		published := <-watched
+		assert.Equal(t, name, published.Name)
+		assert.EqualValues(t, model.Arrived, published.ArrivalStatus)
+	})
+
+	t.Run("Need_Seats_For_Whole_Party_When_No_Show_Arrives_Late", func(t *testing.T) {
+		// the seats of a no-show were released, so 3 free seats only fit the guest and 2 more
+		for brings, status := range map[int]model.GuestStatus{2: model.Arrived, 3: model.Rejected} {
+			guest := model.Guest{Name: name, Entourage: 3, ArrivalStatus: model.NoShow}
+
+			mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+			mockRepository.EXPECT().GetGuest(gomock.Any(), name).Return(&guest, nil).Times(1)
+			mockRepository.EXPECT().GetGuestTableFreeSeats(gomock.Any(), name).Return(3, nil).Times(1)
+			mockRepository.EXPECT().UpdateGuest(gomock.Any(), &guest).Return(nil).Times(1)
+
+			ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+			updated, err := ms.UpdateGuest(context.Background(), &model.GuestData{Name: name, Accompanying_guests: brings}, AnyVersion)
+			assert.Nil(t, err)
+			assert.EqualValues(t, status, updated.ArrivalStatus, brings)
+		}
+	})
+
+	t.Run("Reject_Declined_Guest_Arriving_At_Full_Table", func(t *testing.T) {
+		// the guest declined, so their seats were given up and the table filled up
+		guest := model.Guest{Name: name, Entourage: 1, ArrivalStatus: model.NotArrived, RSVPStatus: model.Declined}
+
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.EXPECT().GetGuest(gomock.Any(), name).Return(&guest, nil).Times(1)
+		mockRepository.EXPECT().GetGuestTableFreeSeats(gomock.Any(), name).Return(0, nil).Times(1)
+		mockRepository.EXPECT().UpdateGuest(gomock.Any(), &guest).Return(nil).Times(1)
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		updated, err := ms.UpdateGuest(context.Background(), &model.GuestData{Name: name, Accompanying_guests: 1}, AnyVersion)
+		assert.Nil(t, err)
+		assert.EqualValues(t, model.Rejected, updated.ArrivalStatus)
+	})
+}
+
+func Test_DefaultGuestService_GetGuestList(t *testing.T) {
+	t.Run("Return_BadInput_When_Tag_Is_Invalid", func(t *testing.T) {
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+		_, err := dms.GetGuestList(context.Background(), "not a tag")
+		assert.IsType(t, &ex.BadInputError{}, err)
+	})
+
+	t.Run("Filter_By_Lower_Case_Tag", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestList(gomock.Any(), "vip").
+			Return([]model.GuestData{{Name: "Flor", Table: 1}}, nil).
+			Times(1)
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		guests, err := ms.GetGuestList(context.Background(), "VIP")
+		assert.Nil(t, err)
+		assert.Len(t, guests, 1)
+	})
 }
 
 func Test_DefaultGuestService_CreateGuest(t *testing.T) {
@@ -152,10 +288,33 @@ func Test_DefaultGuestService_CreateGuest(t *testing.T) {
 			Table:               1,
 		}
 
-		dms := NewDefaultGuestService(nil, nil)
-		err := dms.CreateGuest(&testCase)
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+		err := dms.CreateGuest(context.Background(), &testCase)
 		assert.Equal(t, err.Error(), ex.NewBadInputError("-4").Error())
 	})
+	t.Run("Return_BadRequest_When_Invalid_Email", func(t *testing.T) {
+		testCase := model.GuestData{
+			Name:                name,
+			Accompanying_guests: 2,
+			Table:               1,
+			GuestProfile:        model.GuestProfile{Email: "Flor <flor@example.com>"},
+		}
+
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+		err := dms.CreateGuest(context.Background(), &testCase)
+		assert.Equal(t, err.Error(), ex.NewBadInputError("Flor <flor@example.com>").Error())
+	})
+	t.Run("Return_CentralOnly_When_Door_Station", func(t *testing.T) {
+		testCase := model.GuestData{
+			Name:                name,
+			Accompanying_guests: 2,
+			Table:               1,
+		}
+
+		dms := NewDefaultGuestService(nil, nil, 0, 0, true, nil, nil, logging.NewNop())
+		err := dms.CreateGuest(context.Background(), &testCase)
+		assert.IsType(t, &ex.CentralOnlyError{}, err)
+	})
 	t.Run("Return_CapacityError_When_Entourage_Exceed_Capacity", func(t *testing.T) {
 		testCase := model.GuestData{
 			Name:                name,
@@ -167,16 +326,37 @@ func Test_DefaultGuestService_CreateGuest(t *testing.T) {
 
 		mockTableService.
 			EXPECT().
-			GetEmptySeatsAtTable(testCase.Table).
+			GetEmptySeatsAtTable(gomock.Any(), testCase.Table).
 			Return(4, nil).
 			Times(1)
 
-		ms := NewDefaultGuestService(nil, mockTableService)
-		err := ms.CreateGuest(&testCase)
+		ms := NewDefaultGuestService(nil, mockTableService, 0, 0, false, nil, nil, logging.NewNop())
+		err := ms.CreateGuest(context.Background(), &testCase)
 
 		assert.Equal(t, err.Error(), ex.NewExceedsCapacityError(4, 1).Error())
 	})
 
+	t.Run("Return_CapacityError_When_Party_Needs_Seats_Held_For_VIPs", func(t *testing.T) {
+		testCase := model.GuestData{
+			Name:                name,
+			Accompanying_guests: 2,
+			Table:               1,
+		}
+
+		mockTableService := NewMockIEventTableService(gomock.NewController(t))
+		mockTableService.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), testCase.Table).
+			Return(4, nil).
+			Times(1)
+
+		// 4 free seats, 2 of them held back for VIPs
+		ms := NewDefaultGuestService(nil, mockTableService, 2, 0, false, nil, nil, logging.NewNop())
+		err := ms.CreateGuest(context.Background(), &testCase)
+
+		assert.Equal(t, err.Error(), ex.NewExceedsCapacityError(2, 1).Error())
+	})
+
 	t.Run("Return_AlreadyExists_When_Duplicate_Name", func(t *testing.T) {
 		testCase := model.GuestData{
 			Name:                name,
@@ -188,7 +368,7 @@ func Test_DefaultGuestService_CreateGuest(t *testing.T) {
 
 		mockTableService.
 			EXPECT().
-			GetEmptySeatsAtTable(testCase.Table).
+			GetEmptySeatsAtTable(gomock.Any(), testCase.Table).
 			Return(10, nil).
 			Times(1)
 
@@ -196,12 +376,12 @@ func Test_DefaultGuestService_CreateGuest(t *testing.T) {
 
 		mockRepository.
 			EXPECT().
-			CreateGuest(&testCase).
+			CreateGuest(gomock.Any(), &testCase, gomock.Any()).
 			Return(ex.NewAlreadyExistsError(name, "name", "guest")).
 			Times(1)
 
-		ms := NewDefaultGuestService(mockRepository, mockTableService)
-		err := ms.CreateGuest(&testCase)
+		ms := NewDefaultGuestService(mockRepository, mockTableService, 0, 0, false, nil, nil, logging.NewNop())
+		err := ms.CreateGuest(context.Background(), &testCase)
 		assert.Equal(t, err.Error(), ex.NewAlreadyExistsError(name, "name", "guest").Error())
 	})
 
@@ -216,7 +396,7 @@ func Test_DefaultGuestService_CreateGuest(t *testing.T) {
 
 		mockTableService.
 			EXPECT().
-			GetEmptySeatsAtTable(testCase.Table).
+			GetEmptySeatsAtTable(gomock.Any(), testCase.Table).
 			Return(10, nil).
 			Times(1)
 
@@ -224,13 +404,254 @@ func Test_DefaultGuestService_CreateGuest(t *testing.T) {
 
 		mockRepository.
 			EXPECT().
-			CreateGuest(&testCase).
+			CreateGuest(gomock.Any(), &testCase, gomock.Any()).
 			Return(nil).
 			Times(1)
 
-		ms := NewDefaultGuestService(mockRepository, mockTableService)
-		err := ms.CreateGuest(&testCase)
+		ms := NewDefaultGuestService(mockRepository, mockTableService, 0, 0, false, nil, nil, logging.NewNop())
+		err := ms.CreateGuest(context.Background(), &testCase)
 		assert.Nil(t, err)
 	})
 
 }
+
+func Test_DefaultGuestService_UpdateGuestProfile(t *testing.T) {
+	name := "Flor"
+
+	t.Run("Return_BadInput_When_Phone_Is_Invalid", func(t *testing.T) {
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		_, err := dms.UpdateGuestProfile(context.Background(), name, &model.GuestProfile{Phone: "call me"}, AnyVersion)
+		assert.Equal(t, err.Error(), ex.NewBadInputError("call me").Error())
+	})
+
+	t.Run("Return_BadInput_When_Email_Is_Too_Long", func(t *testing.T) {
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		email := strings.Repeat("a", 64) + "@" + strings.Repeat("b", 186) + ".com"
+		_, err := dms.UpdateGuestProfile(context.Background(), name, &model.GuestProfile{Email: email}, AnyVersion)
+		assert.Equal(t, err.Error(), ex.NewBadInputError("email longer than 254 characters").Error())
+	})
+
+	t.Run("Return_BadInput_When_Diet_Is_Unknown", func(t *testing.T) {
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		_, err := dms.UpdateGuestProfile(context.Background(), name, &model.GuestProfile{Diet: "carnivore"}, AnyVersion)
+		assert.Equal(t, err.Error(), ex.NewBadInputError("carnivore").Error())
+	})
+
+	t.Run("Return_BadInput_When_Other_Diet_Has_No_Notes", func(t *testing.T) {
+		dms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		_, err := dms.UpdateGuestProfile(context.Background(), name, &model.GuestProfile{Diet: model.DietOther}, AnyVersion)
+		assert.Equal(t, err.Error(), ex.NewBadInputError("diet_notes is required for diet other").Error())
+	})
+
+	t.Run("Return_PreconditionFailed_When_Version_Changed", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuest(gomock.Any(), name).
+			Return(&model.Guest{GuestID: 1, Name: name, Version: 3}, nil).
+			Times(1)
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		_, err := ms.UpdateGuestProfile(context.Background(), name, &model.GuestProfile{}, 2)
+		assert.Equal(t, err.Error(), ex.NewPreconditionFailedError("guest", 2).Error())
+	})
+
+	t.Run("Return_Guest_With_Normalized_Profile", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuest(gomock.Any(), name).
+			Return(&model.Guest{GuestID: 1, Name: name, Version: 3}, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			UpdateGuestProfile(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+		profile := &model.GuestProfile{Email: " flor@example.com ", Phone: "+54 11 5555-0000", Allergies: "peanuts"}
+		guest, err := ms.UpdateGuestProfile(context.Background(), name, profile, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, "flor@example.com", guest.Email)
+		assert.Equal(t, model.DietNone, guest.Diet)
+		assert.Equal(t, "peanuts", guest.Allergies)
+	})
+}
+
+func Test_DefaultGuestService_ArriveGuests(t *testing.T) {
+	t.Run("Return_BadInput_When_Batch_Is_Invalid", func(t *testing.T) {
+		tooMany := make([]model.BatchArrival, MaxArrivalBatch+1)
+		for i := range tooMany {
+			tooMany[i] = model.BatchArrival{GuestID: i + 1}
+		}
+
+		testCases := []struct {
+			name     string
+			arrivals []model.BatchArrival
+			err      error
+		}{
+			{"Empty", nil, ex.NewBadInputError("no arrivals in the batch")},
+			{"Too_Many", tooMany, ex.NewBadInputError("more than 200 arrivals in the batch")},
+			{"Negative_Entourage", []model.BatchArrival{{Name: "Flor", Accompanying_guests: -1}}, ex.NewBadInputError("-1")},
+			{"Without_Guest", []model.BatchArrival{{Name: "Flor"}, {Accompanying_guests: 2}}, ex.NewBadInputError("arrivals[1] has no guest_id or name")},
+			{"Negative_ID", []model.BatchArrival{{GuestID: -3}}, ex.NewBadInputError("arrivals[0] has an invalid guest_id")},
+			{"Repeated_Name", []model.BatchArrival{{Name: "Flor"}, {Name: "Flor"}}, ex.NewBadInputError("guest Flor is listed more than once")},
+			{"Repeated_ID", []model.BatchArrival{{GuestID: 4}, {Name: "Flor"}, {GuestID: 4}}, ex.NewBadInputError("guest 4 is listed more than once")},
+		}
+
+		for _, test := range testCases {
+			t.Run(test.name, func(t *testing.T) {
+				ms := NewDefaultGuestService(nil, nil, 0, 0, false, nil, nil, logging.NewNop())
+
+				_, err := ms.ArriveGuests(context.Background(), test.arrivals)
+				assert.Equal(t, test.err.Error(), err.Error())
+			})
+		}
+	})
+
+	t.Run("Report_Result_Of_Each_Guest_In_Order", func(t *testing.T) {
+		arrivals := []model.BatchArrival{
+			{Name: "Flor", Accompanying_guests: 2},
+			{GuestID: 9, Accompanying_guests: 1},
+			{Name: "Juan", Accompanying_guests: 4},
+		}
+
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ArriveGuests(gomock.Any(), arrivals, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ []model.BatchArrival, admit repository.AdmitFunc) ([]*model.Guest, error) {
+				// Both guests are sat at a table with 3 free seats, Flor takes 2 of them
+				flor := &model.Guest{GuestID: 1, Name: "Flor", Entourage: 0, Version: 1}
+				admit(flor, 2, 3)
+				flor.Version++
+				juan := &model.Guest{GuestID: 2, Name: "Juan", Entourage: 2, Version: 4}
+				admit(juan, 4, 1)
+				juan.Version++
+				return []*model.Guest{flor, nil, juan}, nil
+			}).
+			Times(1)
+
+		feed := NewArrivalFeed()
+		watched, unsubscribe := feed.Subscribe()
+		defer unsubscribe()
+
+		ms := NewDefaultGuestService(mockRepository, nil, 0, 0, false, nil, feed, logging.NewNop())
+
+		report, err := ms.ArriveGuests(context.Background(), arrivals)
+		assert.Nil(t, err)
+		assert.Equal(t, &model.BatchArrivalReport{
+			Results: []model.BatchArrivalResult{
+				{GuestID: 1, Name: "Flor", Status: model.BatchArrived, Accompanying_guests: 2, Version: 2},
+				{GuestID: 9, Status: model.BatchNotFound, Accompanying_guests: 1},
+				{GuestID: 2, Name: "Juan", Status: model.BatchRejected, Accompanying_guests: 4, Version: 5},
+			},
+			Arrived:  1,
+			Rejected: 1,
+			NotFound: 1,
+		}, report)
+
+		assert.Equal(t, "Flor", (<-watched).Name)
+		assert.Equal(t, "Juan", (<-watched).Name)
+	})
+
+	t.Run("Admit_Guests_With_The_Rules_Of_Single_Arrivals", func(t *testing.T) {
+		arrivals := []model.BatchArrival{{Name: "Flor", Accompanying_guests: 1}, {Name: "Vera", Accompanying_guests: 1}, {Name: "Ines", Accompanying_guests: 1}}
+
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ArriveGuests(gomock.Any(), arrivals, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ []model.BatchArrival, admit repository.AdmitFunc) ([]*model.Guest, error) {
+				// 2 free seats, both held back for VIPs
+				regular := &model.Guest{Name: "Flor"}
+				admit(regular, 1, 2)
+				vip := &model.Guest{Name: "Vera", Tags: []string{model.VIPTag}}
+				admit(vip, 1, 2)
+				noShow := &model.Guest{Name: "Ines", Entourage: 1, Tags: []string{model.VIPTag}, ArrivalStatus: model.NoShow}
+				admit(noShow, 1, 1)
+				return []*model.Guest{regular, vip, noShow}, nil
+			}).
+			Times(1)
+
+		ms := NewDefaultGuestService(mockRepository, nil, 2, 0, false, nil, NewArrivalFeed(), logging.NewNop())
+
+		report, err := ms.ArriveGuests(context.Background(), arrivals)
+		assert.Nil(t, err)
+		assert.Equal(t, model.BatchRejected, report.Results[0].Status)
+		assert.Equal(t, model.BatchArrived, report.Results[1].Status)
+		assert.Equal(t, model.BatchRejected, report.Results[2].Status)
+	})
+}
+
+func Test_DefaultGuestService_UndoStatusChange(t *testing.T) {
+	name := "Flor"
+	window := 10 * time.Minute
+
+	t.Run("Return_BadInput_When_Name_Is_Invalid", func(t *testing.T) {
+		ms := NewDefaultGuestService(nil, nil, 0, window, false, nil, nil, logging.NewNop())
+
+		_, err := ms.UndoStatusChange(context.Background(), "Flor Gomez", 2)
+		assert.Equal(t, ex.NewBadInputError("Flor Gomez").Error(), err.Error())
+	})
+
+	t.Run("Return_Error_When_Change_Cant_Be_Undone", func(t *testing.T) {
+		errCapacity := ex.NewExceedsCapacityError(1, 2)
+
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			UndoStatusChange(gomock.Any(), name, 2, window).
+			Return(nil, errCapacity).
+			Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		ms := NewDefaultGuestService(mockRepository, nil, 0, window, false, seatsFreed, nil, logging.NewNop())
+
+		_, err := ms.UndoStatusChange(context.Background(), name, 2)
+		assert.Equal(t, errCapacity, err)
+		assert.Empty(t, seatsFreed.C())
+	})
+
+	t.Run("Notify_Seats_Freed_Only_When_Undo_Gives_Seats_Back", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			before model.ArrivalState
+			after  model.ArrivalState
+			freed  bool
+		}{
+			{"Arrival_Of_Wrong_Guest", model.ArrivalState{ArrivalStatus: model.NotArrived, Accompanying_guests: 2}, model.ArrivalState{ArrivalStatus: model.Arrived, Accompanying_guests: 2}, false},
+			{"Arrival_With_Larger_Entourage", model.ArrivalState{ArrivalStatus: model.Arrived, Accompanying_guests: 1}, model.ArrivalState{ArrivalStatus: model.Arrived, Accompanying_guests: 3}, true},
+			{"Arrival_Of_Guest_Marked_As_No_Show", model.ArrivalState{ArrivalStatus: model.NoShow, Accompanying_guests: 2}, model.ArrivalState{ArrivalStatus: model.Arrived, Accompanying_guests: 2}, true},
+			{"Departure", model.ArrivalState{ArrivalStatus: model.Arrived, Accompanying_guests: 2}, model.ArrivalState{ArrivalStatus: model.Left, Accompanying_guests: 2}, false},
+			{"Rejection", model.ArrivalState{ArrivalStatus: model.NotArrived, Accompanying_guests: 2}, model.ArrivalState{ArrivalStatus: model.Rejected, Accompanying_guests: 5}, false},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				change := &model.StatusChange{ChangeID: 7, GuestID: 1, Name: name, Before: test.before, After: test.after, Version: 4}
+
+				mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+				mockRepository.
+					EXPECT().
+					UndoStatusChange(gomock.Any(), name, AnyVersion, window).
+					Return(change, nil).
+					Times(1)
+
+				seatsFreed := NewSeatsFreedSignal()
+				ms := NewDefaultGuestService(mockRepository, nil, 0, window, false, seatsFreed, nil, logging.NewNop())
+
+				undone, err := ms.UndoStatusChange(context.Background(), name, AnyVersion)
+				assert.NoError(t, err)
+				assert.Equal(t, change, undone)
+				assert.Equal(t, test.freed, len(seatsFreed.C()) == 1)
+			})
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+)
+
+/*
+The `DefaultHealthService` runs the liveness and readiness checks of the application.
+
+Liveness only tells the process is up, so the orchestrator does not restart it because of
+a database outage. Readiness checks the database connection and that the schema applied is
+the version the repositories expect, so traffic is only routed to instances that can serve it.
+*/
+type DefaultHealthService struct {
+	healthRepository repository.IHealthRepository
+}
+
+func NewDefaultHealthService(hRepo repository.IHealthRepository) *DefaultHealthService {
+	return &DefaultHealthService{
+		healthRepository: hRepo,
+	}
+}
+
+func (d *DefaultHealthService) Liveness(ctx context.Context) *model.HealthReport {
+	return &model.HealthReport{Status: model.HealthUp}
+}
+
+/**
+ * Runs the database checks. The report is `up` only if the database answers the ping
+ * and its schema is at `repository.SchemaVersion`. The schema check is skipped when the
+ * database can't be reached.
+ *
+ * @return  pointer to an instance of HealthReport with the result of each check
+ */
+func (d *DefaultHealthService) Readiness(ctx context.Context) *model.HealthReport {
+	ctx, span := tracer.Start(ctx, "DefaultHealthService.Readiness")
+	defer span.End()
+
+	report := &model.HealthReport{
+		Status: model.HealthUp,
+		Checks: make(map[string]model.HealthCheck),
+	}
+
+	if err := d.healthRepository.Ping(ctx); err != nil {
+		report.Status = model.HealthDown
+		report.Checks["database"] = model.HealthCheck{Status: model.HealthDown, Error: err.Error()}
+		report.Checks["schema"] = model.HealthCheck{Status: model.HealthDown, Error: "database unreachable"}
+		return report
+	}
+	report.Checks["database"] = model.HealthCheck{Status: model.HealthUp}
+
+	version, err := d.healthRepository.GetSchemaVersion(ctx)
+	switch {
+	case err != nil:
+		report.Status = model.HealthDown
+		report.Checks["schema"] = model.HealthCheck{Status: model.HealthDown, Error: err.Error()}
+	case version != repository.SchemaVersion:
+		report.Status = model.HealthDown
+		report.Checks["schema"] = model.HealthCheck{
+			Status: model.HealthDown,
+			Error:  fmt.Sprintf("schema is at version %d, expected %d", version, repository.SchemaVersion),
+		}
+	default:
+		report.Checks["schema"] = model.HealthCheck{Status: model.HealthUp}
+	}
+
+	return report
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IHealthService` is an interface that defines the probes used by the orchestrator
+to decide if the application is alive and if it can receive traffic.
+*/
+type IHealthService interface {
+	// Reports that the process is running, without checking any dependency.
+	Liveness(ctx context.Context) *model.HealthReport
+	// Reports whether the database is reachable and its schema has been applied.
+	Readiness(ctx context.Context) *model.HealthReport
+}
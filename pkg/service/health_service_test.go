@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultHealthService_Readiness(t *testing.T) {
+
+	t.Run("Return_Up_When_Database_Ready", func(t *testing.T) {
+		mockRepository := repository.NewMockIHealthRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			Ping(gomock.Any()).
+			Return(nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			GetSchemaVersion(gomock.Any()).
+			Return(repository.SchemaVersion, nil).
+			Times(1)
+
+		hs := NewDefaultHealthService(mockRepository)
+		report := hs.Readiness(context.Background())
+
+		assert.Equal(t, model.HealthUp, report.Status)
+		assert.Equal(t, model.HealthUp, report.Checks["database"].Status)
+		assert.Equal(t, model.HealthUp, report.Checks["schema"].Status)
+	})
+
+	t.Run("Return_Down_When_Database_Unreachable", func(t *testing.T) {
+		mockRepository := repository.NewMockIHealthRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			Ping(gomock.Any()).
+			Return(errors.New("connection refused")).
+			Times(1)
+
+		hs := NewDefaultHealthService(mockRepository)
+		report := hs.Readiness(context.Background())
+
+		assert.Equal(t, model.HealthDown, report.Status)
+		assert.Equal(t, "connection refused", report.Checks["database"].Error)
+		assert.Equal(t, model.HealthDown, report.Checks["schema"].Status)
+	})
+
+	t.Run("Return_Down_When_Schema_Not_Applied", func(t *testing.T) {
+		mockRepository := repository.NewMockIHealthRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			Ping(gomock.Any()).
+			Return(nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			GetSchemaVersion(gomock.Any()).
+			Return(repository.SchemaVersion-1, nil).
+			Times(1)
+
+		hs := NewDefaultHealthService(mockRepository)
+		report := hs.Readiness(context.Background())
+
+		assert.Equal(t, model.HealthDown, report.Status)
+		assert.Equal(t, model.HealthUp, report.Checks["database"].Status)
+		assert.Equal(t, fmt.Sprintf("schema is at version %d, expected %d", repository.SchemaVersion-1, repository.SchemaVersion), report.Checks["schema"].Error)
+	})
+}
@@ -5,6 +5,7 @@
 package service
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/fpetrikovich/go-guestlist/pkg/model"
@@ -34,89 +35,180 @@ func (m *MockIGuestService) EXPECT() *MockIGuestServiceMockRecorder {
 	return m.recorder
 }
 
+// ArriveGuests mocks base method.
+func (m *MockIGuestService) ArriveGuests(ctx context.Context, arrivals []model.BatchArrival) (*model.BatchArrivalReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArriveGuests", ctx, arrivals)
+	ret0, _ := ret[0].(*model.BatchArrivalReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArriveGuests indicates an expected call of ArriveGuests.
+func (mr *MockIGuestServiceMockRecorder) ArriveGuests(ctx, arrivals interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArriveGuests", reflect.TypeOf((*MockIGuestService)(nil).ArriveGuests), ctx, arrivals)
+}
+
 // CreateGuest mocks base method.
-func (m *MockIGuestService) CreateGuest(params *model.GuestData) error {
+func (m *MockIGuestService) CreateGuest(ctx context.Context, params *model.GuestData) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGuest", params)
+	ret := m.ctrl.Call(m, "CreateGuest", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateGuest indicates an expected call of CreateGuest.
-func (mr *MockIGuestServiceMockRecorder) CreateGuest(params interface{}) *gomock.Call {
+func (mr *MockIGuestServiceMockRecorder) CreateGuest(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuest", reflect.TypeOf((*MockIGuestService)(nil).CreateGuest), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuest", reflect.TypeOf((*MockIGuestService)(nil).CreateGuest), ctx, params)
 }
 
 // DeleteGuest mocks base method.
-func (m *MockIGuestService) DeleteGuest(name string) error {
+func (m *MockIGuestService) DeleteGuest(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGuest", name)
+	ret := m.ctrl.Call(m, "DeleteGuest", ctx, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteGuest indicates an expected call of DeleteGuest.
-func (mr *MockIGuestServiceMockRecorder) DeleteGuest(name interface{}) *gomock.Call {
+func (mr *MockIGuestServiceMockRecorder) DeleteGuest(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuest", reflect.TypeOf((*MockIGuestService)(nil).DeleteGuest), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuest", reflect.TypeOf((*MockIGuestService)(nil).DeleteGuest), ctx, name)
 }
 
 // GetArrivedGuests mocks base method.
-func (m *MockIGuestService) GetArrivedGuests() ([]model.GuestArrival, error) {
+func (m *MockIGuestService) GetArrivedGuests(ctx context.Context, tag string) ([]model.GuestArrival, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetArrivedGuests")
+	ret := m.ctrl.Call(m, "GetArrivedGuests", ctx, tag)
 	ret0, _ := ret[0].([]model.GuestArrival)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetArrivedGuests indicates an expected call of GetArrivedGuests.
-func (mr *MockIGuestServiceMockRecorder) GetArrivedGuests() *gomock.Call {
+func (mr *MockIGuestServiceMockRecorder) GetArrivedGuests(ctx, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArrivedGuests", reflect.TypeOf((*MockIGuestService)(nil).GetArrivedGuests))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArrivedGuests", reflect.TypeOf((*MockIGuestService)(nil).GetArrivedGuests), ctx, tag)
 }
 
 // GetGuest mocks base method.
-func (m *MockIGuestService) GetGuest(name string) (*model.Guest, error) {
+func (m *MockIGuestService) GetGuest(ctx context.Context, name string) (*model.Guest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGuest", name)
+	ret := m.ctrl.Call(m, "GetGuest", ctx, name)
 	ret0, _ := ret[0].(*model.Guest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGuest indicates an expected call of GetGuest.
-func (mr *MockIGuestServiceMockRecorder) GetGuest(name interface{}) *gomock.Call {
+func (mr *MockIGuestServiceMockRecorder) GetGuest(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuest", reflect.TypeOf((*MockIGuestService)(nil).GetGuest), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuest", reflect.TypeOf((*MockIGuestService)(nil).GetGuest), ctx, name)
 }
 
 // GetGuestList mocks base method.
-func (m *MockIGuestService) GetGuestList() ([]model.GuestData, error) {
+func (m *MockIGuestService) GetGuestList(ctx context.Context, tag string) ([]model.GuestData, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGuestList")
+	ret := m.ctrl.Call(m, "GetGuestList", ctx, tag)
 	ret0, _ := ret[0].([]model.GuestData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGuestList indicates an expected call of GetGuestList.
-func (mr *MockIGuestServiceMockRecorder) GetGuestList() *gomock.Call {
+func (mr *MockIGuestServiceMockRecorder) GetGuestList(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestList", reflect.TypeOf((*MockIGuestService)(nil).GetGuestList), ctx, tag)
+}
+
+// GetGuestsAtTables mocks base method.
+func (m *MockIGuestService) GetGuestsAtTables(ctx context.Context, tableIDs []int) (map[int][]model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestsAtTables", ctx, tableIDs)
+	ret0, _ := ret[0].(map[int][]model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestsAtTables indicates an expected call of GetGuestsAtTables.
+func (mr *MockIGuestServiceMockRecorder) GetGuestsAtTables(ctx, tableIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestsAtTables", reflect.TypeOf((*MockIGuestService)(nil).GetGuestsAtTables), ctx, tableIDs)
+}
+
+// GetGuestsByName mocks base method.
+func (m *MockIGuestService) GetGuestsByName(ctx context.Context, names []string) ([]model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestsByName", ctx, names)
+	ret0, _ := ret[0].([]model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestsByName indicates an expected call of GetGuestsByName.
+func (mr *MockIGuestServiceMockRecorder) GetGuestsByName(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestsByName", reflect.TypeOf((*MockIGuestService)(nil).GetGuestsByName), ctx, names)
+}
+
+// GetSeating mocks base method.
+func (m *MockIGuestService) GetSeating(ctx context.Context, guestIDs []int) ([]model.Seating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeating", ctx, guestIDs)
+	ret0, _ := ret[0].([]model.Seating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeating indicates an expected call of GetSeating.
+func (mr *MockIGuestServiceMockRecorder) GetSeating(ctx, guestIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeating", reflect.TypeOf((*MockIGuestService)(nil).GetSeating), ctx, guestIDs)
+}
+
+// UndoStatusChange mocks base method.
+func (m *MockIGuestService) UndoStatusChange(ctx context.Context, name string, version int) (*model.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoStatusChange", ctx, name, version)
+	ret0, _ := ret[0].(*model.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoStatusChange indicates an expected call of UndoStatusChange.
+func (mr *MockIGuestServiceMockRecorder) UndoStatusChange(ctx, name, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestList", reflect.TypeOf((*MockIGuestService)(nil).GetGuestList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoStatusChange", reflect.TypeOf((*MockIGuestService)(nil).UndoStatusChange), ctx, name, version)
 }
 
 // UpdateGuest mocks base method.
-func (m *MockIGuestService) UpdateGuest(params *model.GuestData) error {
+func (m *MockIGuestService) UpdateGuest(ctx context.Context, params *model.GuestData, version int) (*model.Guest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGuest", params)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateGuest", ctx, params, version)
+	ret0, _ := ret[0].(*model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateGuest indicates an expected call of UpdateGuest.
-func (mr *MockIGuestServiceMockRecorder) UpdateGuest(params interface{}) *gomock.Call {
+func (mr *MockIGuestServiceMockRecorder) UpdateGuest(ctx, params, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuest", reflect.TypeOf((*MockIGuestService)(nil).UpdateGuest), ctx, params, version)
+}
+
+// UpdateGuestProfile mocks base method.
+func (m *MockIGuestService) UpdateGuestProfile(ctx context.Context, name string, profile *model.GuestProfile, version int) (*model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestProfile", ctx, name, profile, version)
+	ret0, _ := ret[0].(*model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestProfile indicates an expected call of UpdateGuestProfile.
+func (mr *MockIGuestServiceMockRecorder) UpdateGuestProfile(ctx, name, profile, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuest", reflect.TypeOf((*MockIGuestService)(nil).UpdateGuest), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestProfile", reflect.TypeOf((*MockIGuestService)(nil).UpdateGuestProfile), ctx, name, profile, version)
 }
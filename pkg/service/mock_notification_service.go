@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/notification_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockINotificationService is a mock of INotificationService interface.
+type MockINotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationServiceMockRecorder
+}
+
+// MockINotificationServiceMockRecorder is the mock recorder for MockINotificationService.
+type MockINotificationServiceMockRecorder struct {
+	mock *MockINotificationService
+}
+
+// NewMockINotificationService creates a new mock instance.
+func NewMockINotificationService(ctrl *gomock.Controller) *MockINotificationService {
+	mock := &MockINotificationService{ctrl: ctrl}
+	mock.recorder = &MockINotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationService) EXPECT() *MockINotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// Preview mocks base method.
+func (m *MockINotificationService) Preview(ctx context.Context, campaign *model.Campaign) (*model.CampaignResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", ctx, campaign)
+	ret0, _ := ret[0].(*model.CampaignResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockINotificationServiceMockRecorder) Preview(ctx, campaign interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockINotificationService)(nil).Preview), ctx, campaign)
+}
+
+// StartCampaign mocks base method.
+func (m *MockINotificationService) StartCampaign(ctx context.Context, campaign *model.Campaign) (*model.CampaignResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCampaign", ctx, campaign)
+	ret0, _ := ret[0].(*model.CampaignResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartCampaign indicates an expected call of StartCampaign.
+func (mr *MockINotificationServiceMockRecorder) StartCampaign(ctx, campaign interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCampaign", reflect.TypeOf((*MockINotificationService)(nil).StartCampaign), ctx, campaign)
+}
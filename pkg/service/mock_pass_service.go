@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/pass_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIPassService is a mock of IPassService interface.
+type MockIPassService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPassServiceMockRecorder
+}
+
+// MockIPassServiceMockRecorder is the mock recorder for MockIPassService.
+type MockIPassServiceMockRecorder struct {
+	mock *MockIPassService
+}
+
+// NewMockIPassService creates a new mock instance.
+func NewMockIPassService(ctrl *gomock.Controller) *MockIPassService {
+	mock := &MockIPassService{ctrl: ctrl}
+	mock.recorder = &MockIPassServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPassService) EXPECT() *MockIPassServiceMockRecorder {
+	return m.recorder
+}
+
+// GetGuestPass mocks base method.
+func (m *MockIPassService) GetGuestPass(ctx context.Context, guestID int) (*model.GuestPass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestPass", ctx, guestID)
+	ret0, _ := ret[0].(*model.GuestPass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestPass indicates an expected call of GetGuestPass.
+func (mr *MockIPassServiceMockRecorder) GetGuestPass(ctx, guestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestPass", reflect.TypeOf((*MockIPassService)(nil).GetGuestPass), ctx, guestID)
+}
+
+// GetPass mocks base method.
+func (m *MockIPassService) GetPass(ctx context.Context, token string) (*model.GuestPass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPass", ctx, token)
+	ret0, _ := ret[0].(*model.GuestPass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPass indicates an expected call of GetPass.
+func (mr *MockIPassServiceMockRecorder) GetPass(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPass", reflect.TypeOf((*MockIPassService)(nil).GetPass), ctx, token)
+}
+
+// Scan mocks base method.
+func (m *MockIPassService) Scan(ctx context.Context, scan *model.PassScan) (*model.Guest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, scan)
+	ret0, _ := ret[0].(*model.Guest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockIPassServiceMockRecorder) Scan(ctx, scan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockIPassService)(nil).Scan), ctx, scan)
+}
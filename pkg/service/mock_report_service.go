@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/report_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReportService is a mock of IReportService interface.
+type MockIReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReportServiceMockRecorder
+}
+
+// MockIReportServiceMockRecorder is the mock recorder for MockIReportService.
+type MockIReportServiceMockRecorder struct {
+	mock *MockIReportService
+}
+
+// NewMockIReportService creates a new mock instance.
+func NewMockIReportService(ctrl *gomock.Controller) *MockIReportService {
+	mock := &MockIReportService{ctrl: ctrl}
+	mock.recorder = &MockIReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReportService) EXPECT() *MockIReportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetArrivalTimeline mocks base method.
+func (m *MockIReportService) GetArrivalTimeline(ctx context.Context, interval time.Duration) (*model.ArrivalTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArrivalTimeline", ctx, interval)
+	ret0, _ := ret[0].(*model.ArrivalTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArrivalTimeline indicates an expected call of GetArrivalTimeline.
+func (mr *MockIReportServiceMockRecorder) GetArrivalTimeline(ctx, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArrivalTimeline", reflect.TypeOf((*MockIReportService)(nil).GetArrivalTimeline), ctx, interval)
+}
+
+// GetAttendanceReport mocks base method.
+func (m *MockIReportService) GetAttendanceReport(ctx context.Context) (*model.AttendanceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceReport", ctx)
+	ret0, _ := ret[0].(*model.AttendanceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceReport indicates an expected call of GetAttendanceReport.
+func (mr *MockIReportServiceMockRecorder) GetAttendanceReport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceReport", reflect.TypeOf((*MockIReportService)(nil).GetAttendanceReport), ctx)
+}
+
+// GetCateringReport mocks base method.
+func (m *MockIReportService) GetCateringReport(ctx context.Context) (*model.CateringReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCateringReport", ctx)
+	ret0, _ := ret[0].(*model.CateringReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCateringReport indicates an expected call of GetCateringReport.
+func (mr *MockIReportServiceMockRecorder) GetCateringReport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCateringReport", reflect.TypeOf((*MockIReportService)(nil).GetCateringReport), ctx)
+}
+
+// GetTableUtilization mocks base method.
+func (m *MockIReportService) GetTableUtilization(ctx context.Context) (*model.TableUtilizationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableUtilization", ctx)
+	ret0, _ := ret[0].(*model.TableUtilizationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableUtilization indicates an expected call of GetTableUtilization.
+func (mr *MockIReportServiceMockRecorder) GetTableUtilization(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableUtilization", reflect.TypeOf((*MockIReportService)(nil).GetTableUtilization), ctx)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/rsvp_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIRSVPService is a mock of IRSVPService interface.
+type MockIRSVPService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRSVPServiceMockRecorder
+}
+
+// MockIRSVPServiceMockRecorder is the mock recorder for MockIRSVPService.
+type MockIRSVPServiceMockRecorder struct {
+	mock *MockIRSVPService
+}
+
+// NewMockIRSVPService creates a new mock instance.
+func NewMockIRSVPService(ctrl *gomock.Controller) *MockIRSVPService {
+	mock := &MockIRSVPService{ctrl: ctrl}
+	mock.recorder = &MockIRSVPServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRSVPService) EXPECT() *MockIRSVPServiceMockRecorder {
+	return m.recorder
+}
+
+// GetInvitation mocks base method.
+func (m *MockIRSVPService) GetInvitation(ctx context.Context, token string) (*model.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitation", ctx, token)
+	ret0, _ := ret[0].(*model.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitation indicates an expected call of GetInvitation.
+func (mr *MockIRSVPServiceMockRecorder) GetInvitation(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitation", reflect.TypeOf((*MockIRSVPService)(nil).GetInvitation), ctx, token)
+}
+
+// Respond mocks base method.
+func (m *MockIRSVPService) Respond(ctx context.Context, token string, response *model.RSVPResponse) (*model.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", ctx, token, response)
+	ret0, _ := ret[0].(*model.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockIRSVPServiceMockRecorder) Respond(ctx, token, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockIRSVPService)(nil).Respond), ctx, token, response)
+}
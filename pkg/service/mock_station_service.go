@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/station_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIStationService is a mock of IStationService interface.
+type MockIStationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStationServiceMockRecorder
+}
+
+// MockIStationServiceMockRecorder is the mock recorder for MockIStationService.
+type MockIStationServiceMockRecorder struct {
+	mock *MockIStationService
+}
+
+// NewMockIStationService creates a new mock instance.
+func NewMockIStationService(ctrl *gomock.Controller) *MockIStationService {
+	mock := &MockIStationService{ctrl: ctrl}
+	mock.recorder = &MockIStationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStationService) EXPECT() *MockIStationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateStation mocks base method.
+func (m *MockIStationService) CreateStation(ctx context.Context, station *model.Station) (*model.Station, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStation", ctx, station)
+	ret0, _ := ret[0].(*model.Station)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStation indicates an expected call of CreateStation.
+func (mr *MockIStationServiceMockRecorder) CreateStation(ctx, station interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStation", reflect.TypeOf((*MockIStationService)(nil).CreateStation), ctx, station)
+}
+
+// GetActivity mocks base method.
+func (m *MockIStationService) GetActivity(ctx context.Context, id string, before, limit int) ([]model.StationActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, id, before, limit)
+	ret0, _ := ret[0].([]model.StationActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockIStationServiceMockRecorder) GetActivity(ctx, id, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockIStationService)(nil).GetActivity), ctx, id, before, limit)
+}
+
+// GetStations mocks base method.
+func (m *MockIStationService) GetStations(ctx context.Context) ([]model.StationThroughput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStations", ctx)
+	ret0, _ := ret[0].([]model.StationThroughput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStations indicates an expected call of GetStations.
+func (mr *MockIStationServiceMockRecorder) GetStations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStations", reflect.TypeOf((*MockIStationService)(nil).GetStations), ctx)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/sync_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISyncService is a mock of ISyncService interface.
+type MockISyncService struct {
+	ctrl     *gomock.Controller
+	recorder *MockISyncServiceMockRecorder
+}
+
+// MockISyncServiceMockRecorder is the mock recorder for MockISyncService.
+type MockISyncServiceMockRecorder struct {
+	mock *MockISyncService
+}
+
+// NewMockISyncService creates a new mock instance.
+func NewMockISyncService(ctrl *gomock.Controller) *MockISyncService {
+	mock := &MockISyncService{ctrl: ctrl}
+	mock.recorder = &MockISyncServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISyncService) EXPECT() *MockISyncServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyOperations mocks base method.
+func (m *MockISyncService) ApplyOperations(ctx context.Context, station string, operations []model.DoorOperation) (*model.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyOperations", ctx, station, operations)
+	ret0, _ := ret[0].(*model.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyOperations indicates an expected call of ApplyOperations.
+func (mr *MockISyncServiceMockRecorder) ApplyOperations(ctx, station, operations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperations", reflect.TypeOf((*MockISyncService)(nil).ApplyOperations), ctx, station, operations)
+}
+
+// GetConflicts mocks base method.
+func (m *MockISyncService) GetConflicts(ctx context.Context, station string) ([]model.SyncConflict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConflicts", ctx, station)
+	ret0, _ := ret[0].([]model.SyncConflict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConflicts indicates an expected call of GetConflicts.
+func (mr *MockISyncServiceMockRecorder) GetConflicts(ctx, station interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConflicts", reflect.TypeOf((*MockISyncService)(nil).GetConflicts), ctx, station)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockISyncService) GetSnapshot(ctx context.Context) (*model.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx)
+	ret0, _ := ret[0].(*model.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockISyncServiceMockRecorder) GetSnapshot(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockISyncService)(nil).GetSnapshot), ctx)
+}
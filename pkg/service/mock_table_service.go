@@ -5,6 +5,7 @@
 package service
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/fpetrikovich/go-guestlist/pkg/model"
@@ -35,90 +36,135 @@ func (m *MockIEventTableService) EXPECT() *MockIEventTableServiceMockRecorder {
 }
 
 // CreateTable mocks base method.
-func (m *MockIEventTableService) CreateTable(table *model.EventTable) (*model.EventTable, error) {
+func (m *MockIEventTableService) CreateTable(ctx context.Context, table *model.EventTable) (*model.EventTable, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTable", table)
+	ret := m.ctrl.Call(m, "CreateTable", ctx, table)
 	ret0, _ := ret[0].(*model.EventTable)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTable indicates an expected call of CreateTable.
-func (mr *MockIEventTableServiceMockRecorder) CreateTable(table interface{}) *gomock.Call {
+func (mr *MockIEventTableServiceMockRecorder) CreateTable(ctx, table interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockIEventTableService)(nil).CreateTable), table)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockIEventTableService)(nil).CreateTable), ctx, table)
 }
 
 // DeleteTable mocks base method.
-func (m *MockIEventTableService) DeleteTable(id int) error {
+func (m *MockIEventTableService) DeleteTable(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTable", id)
+	ret := m.ctrl.Call(m, "DeleteTable", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTable indicates an expected call of DeleteTable.
-func (mr *MockIEventTableServiceMockRecorder) DeleteTable(id interface{}) *gomock.Call {
+func (mr *MockIEventTableServiceMockRecorder) DeleteTable(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockIEventTableService)(nil).DeleteTable), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockIEventTableService)(nil).DeleteTable), ctx, id)
 }
 
 // GetEmptySeats mocks base method.
-func (m *MockIEventTableService) GetEmptySeats() (int, error) {
+func (m *MockIEventTableService) GetEmptySeats(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEmptySeats")
+	ret := m.ctrl.Call(m, "GetEmptySeats", ctx)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEmptySeats indicates an expected call of GetEmptySeats.
-func (mr *MockIEventTableServiceMockRecorder) GetEmptySeats() *gomock.Call {
+func (mr *MockIEventTableServiceMockRecorder) GetEmptySeats(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeats", reflect.TypeOf((*MockIEventTableService)(nil).GetEmptySeats))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeats", reflect.TypeOf((*MockIEventTableService)(nil).GetEmptySeats), ctx)
 }
 
 // GetEmptySeatsAtTable mocks base method.
-func (m *MockIEventTableService) GetEmptySeatsAtTable(id int) (int, error) {
+func (m *MockIEventTableService) GetEmptySeatsAtTable(ctx context.Context, id int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEmptySeatsAtTable", id)
+	ret := m.ctrl.Call(m, "GetEmptySeatsAtTable", ctx, id)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEmptySeatsAtTable indicates an expected call of GetEmptySeatsAtTable.
-func (mr *MockIEventTableServiceMockRecorder) GetEmptySeatsAtTable(id interface{}) *gomock.Call {
+func (mr *MockIEventTableServiceMockRecorder) GetEmptySeatsAtTable(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeatsAtTable", reflect.TypeOf((*MockIEventTableService)(nil).GetEmptySeatsAtTable), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeatsAtTable", reflect.TypeOf((*MockIEventTableService)(nil).GetEmptySeatsAtTable), ctx, id)
+}
+
+// GetEmptySeatsByTable mocks base method.
+func (m *MockIEventTableService) GetEmptySeatsByTable(ctx context.Context, ids []int) (map[int]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmptySeatsByTable", ctx, ids)
+	ret0, _ := ret[0].(map[int]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmptySeatsByTable indicates an expected call of GetEmptySeatsByTable.
+func (mr *MockIEventTableServiceMockRecorder) GetEmptySeatsByTable(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmptySeatsByTable", reflect.TypeOf((*MockIEventTableService)(nil).GetEmptySeatsByTable), ctx, ids)
 }
 
 // GetTable mocks base method.
-func (m *MockIEventTableService) GetTable(id int) (*model.EventTable, error) {
+func (m *MockIEventTableService) GetTable(ctx context.Context, id int) (*model.EventTable, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTable", id)
+	ret := m.ctrl.Call(m, "GetTable", ctx, id)
 	ret0, _ := ret[0].(*model.EventTable)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTable indicates an expected call of GetTable.
-func (mr *MockIEventTableServiceMockRecorder) GetTable(id interface{}) *gomock.Call {
+func (mr *MockIEventTableServiceMockRecorder) GetTable(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTable", reflect.TypeOf((*MockIEventTableService)(nil).GetTable), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTable", reflect.TypeOf((*MockIEventTableService)(nil).GetTable), ctx, id)
 }
 
 // GetTables mocks base method.
-func (m *MockIEventTableService) GetTables() ([]model.EventTable, error) {
+func (m *MockIEventTableService) GetTables(ctx context.Context) ([]model.EventTable, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTables")
+	ret := m.ctrl.Call(m, "GetTables", ctx)
 	ret0, _ := ret[0].([]model.EventTable)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTables indicates an expected call of GetTables.
-func (mr *MockIEventTableServiceMockRecorder) GetTables() *gomock.Call {
+func (mr *MockIEventTableServiceMockRecorder) GetTables(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTables", reflect.TypeOf((*MockIEventTableService)(nil).GetTables), ctx)
+}
+
+// GetTablesByID mocks base method.
+func (m *MockIEventTableService) GetTablesByID(ctx context.Context, ids []int) ([]model.EventTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTablesByID", ctx, ids)
+	ret0, _ := ret[0].([]model.EventTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTablesByID indicates an expected call of GetTablesByID.
+func (mr *MockIEventTableServiceMockRecorder) GetTablesByID(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTablesByID", reflect.TypeOf((*MockIEventTableService)(nil).GetTablesByID), ctx, ids)
+}
+
+// UpdateTable mocks base method.
+func (m *MockIEventTableService) UpdateTable(ctx context.Context, id, capacity, version int) (*model.EventTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTable", ctx, id, capacity, version)
+	ret0, _ := ret[0].(*model.EventTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTable indicates an expected call of UpdateTable.
+func (mr *MockIEventTableServiceMockRecorder) UpdateTable(ctx, id, capacity, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTables", reflect.TypeOf((*MockIEventTableService)(nil).GetTables))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTable", reflect.TypeOf((*MockIEventTableService)(nil).UpdateTable), ctx, id, capacity, version)
 }
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/tag_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITagService is a mock of ITagService interface.
+type MockITagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITagServiceMockRecorder
+}
+
+// MockITagServiceMockRecorder is the mock recorder for MockITagService.
+type MockITagServiceMockRecorder struct {
+	mock *MockITagService
+}
+
+// NewMockITagService creates a new mock instance.
+func NewMockITagService(ctrl *gomock.Controller) *MockITagService {
+	mock := &MockITagService{ctrl: ctrl}
+	mock.recorder = &MockITagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITagService) EXPECT() *MockITagServiceMockRecorder {
+	return m.recorder
+}
+
+// AttachTag mocks base method.
+func (m *MockITagService) AttachTag(ctx context.Context, guestName, tagName string) ([]model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTag", ctx, guestName, tagName)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTag indicates an expected call of AttachTag.
+func (mr *MockITagServiceMockRecorder) AttachTag(ctx, guestName, tagName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockITagService)(nil).AttachTag), ctx, guestName, tagName)
+}
+
+// CreateTag mocks base method.
+func (m *MockITagService) CreateTag(ctx context.Context, params *model.TagData) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, params)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockITagServiceMockRecorder) CreateTag(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockITagService)(nil).CreateTag), ctx, params)
+}
+
+// DeleteTag mocks base method.
+func (m *MockITagService) DeleteTag(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockITagServiceMockRecorder) DeleteTag(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockITagService)(nil).DeleteTag), ctx, name)
+}
+
+// DetachTag mocks base method.
+func (m *MockITagService) DetachTag(ctx context.Context, guestName, tagName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", ctx, guestName, tagName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockITagServiceMockRecorder) DetachTag(ctx, guestName, tagName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockITagService)(nil).DetachTag), ctx, guestName, tagName)
+}
+
+// GetGuestTags mocks base method.
+func (m *MockITagService) GetGuestTags(ctx context.Context, guestName string) ([]model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestTags", ctx, guestName)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestTags indicates an expected call of GetGuestTags.
+func (mr *MockITagServiceMockRecorder) GetGuestTags(ctx, guestName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestTags", reflect.TypeOf((*MockITagService)(nil).GetGuestTags), ctx, guestName)
+}
+
+// GetTag mocks base method.
+func (m *MockITagService) GetTag(ctx context.Context, name string) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag", ctx, name)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockITagServiceMockRecorder) GetTag(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockITagService)(nil).GetTag), ctx, name)
+}
+
+// GetTags mocks base method.
+func (m *MockITagService) GetTags(ctx context.Context) ([]model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockITagServiceMockRecorder) GetTags(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockITagService)(nil).GetTags), ctx)
+}
+
+// UpdateTag mocks base method.
+func (m *MockITagService) UpdateTag(ctx context.Context, name, description string, version int) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, name, description, version)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockITagServiceMockRecorder) UpdateTag(ctx, name, description, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockITagService)(nil).UpdateTag), ctx, name, description, version)
+}
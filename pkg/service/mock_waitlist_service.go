@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/waitlist_service_interface.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/fpetrikovich/go-guestlist/pkg/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIWaitlistService is a mock of IWaitlistService interface.
+type MockIWaitlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWaitlistServiceMockRecorder
+}
+
+// MockIWaitlistServiceMockRecorder is the mock recorder for MockIWaitlistService.
+type MockIWaitlistServiceMockRecorder struct {
+	mock *MockIWaitlistService
+}
+
+// NewMockIWaitlistService creates a new mock instance.
+func NewMockIWaitlistService(ctrl *gomock.Controller) *MockIWaitlistService {
+	mock := &MockIWaitlistService{ctrl: ctrl}
+	mock.recorder = &MockIWaitlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWaitlistService) EXPECT() *MockIWaitlistServiceMockRecorder {
+	return m.recorder
+}
+
+// AnswerOffer mocks base method.
+func (m *MockIWaitlistService) AnswerOffer(ctx context.Context, token string, accept bool) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerOffer", ctx, token, accept)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnswerOffer indicates an expected call of AnswerOffer.
+func (mr *MockIWaitlistServiceMockRecorder) AnswerOffer(ctx, token, accept interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerOffer", reflect.TypeOf((*MockIWaitlistService)(nil).AnswerOffer), ctx, token, accept)
+}
+
+// Cancel mocks base method.
+func (m *MockIWaitlistService) Cancel(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockIWaitlistServiceMockRecorder) Cancel(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockIWaitlistService)(nil).Cancel), ctx, id)
+}
+
+// Enqueue mocks base method.
+func (m *MockIWaitlistService) Enqueue(ctx context.Context, params *model.WaitlistRequest) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, params)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockIWaitlistServiceMockRecorder) Enqueue(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockIWaitlistService)(nil).Enqueue), ctx, params)
+}
+
+// GetOffer mocks base method.
+func (m *MockIWaitlistService) GetOffer(ctx context.Context, token string) (*model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOffer", ctx, token)
+	ret0, _ := ret[0].(*model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOffer indicates an expected call of GetOffer.
+func (mr *MockIWaitlistServiceMockRecorder) GetOffer(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffer", reflect.TypeOf((*MockIWaitlistService)(nil).GetOffer), ctx, token)
+}
+
+// GetWaitlist mocks base method.
+func (m *MockIWaitlistService) GetWaitlist(ctx context.Context) ([]model.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlist", ctx)
+	ret0, _ := ret[0].([]model.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlist indicates an expected call of GetWaitlist.
+func (mr *MockIWaitlistServiceMockRecorder) GetWaitlist(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlist", reflect.TypeOf((*MockIWaitlistService)(nil).GetWaitlist), ctx)
+}
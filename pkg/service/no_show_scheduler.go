@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+The `NoShowScheduler` marks the guests that never turned up as no-shows in the background.
+
+Once the cutoff of the schedule has passed, every poll marks the guests that still haven't
+arrived, so their seats stop counting as taken. Guests added or answering their invitation
+within the last interval are left for a later poll, so a party that just accepted a waitlist
+offer isn't marked before it had a chance to turn up and its seats offered again. Each
+guest marked is logged, and `seatsFreed` is notified so the waitlist can offer their seats.
+The time is read from `clock`, so tests can move past the cutoff without waiting.
+*/
+type NoShowScheduler struct {
+	guestRepository repository.IGuestRepository
+	schedule        model.EventSchedule
+	clock           Clock
+	interval        time.Duration
+	seatsFreed      *SeatsFreedSignal
+	logger          *slog.Logger
+}
+
+func NewNoShowScheduler(gRepo repository.IGuestRepository, schedule model.EventSchedule, clock Clock, interval time.Duration, seatsFreed *SeatsFreedSignal, logger *slog.Logger) *NoShowScheduler {
+	return &NoShowScheduler{
+		guestRepository: gRepo,
+		schedule:        schedule,
+		clock:           clock,
+		interval:        interval,
+		seatsFreed:      seatsFreed,
+		logger:          logger,
+	}
+}
+
+/*
+`Run` marks the no-shows every interval until ctx is done. It returns right away when
+the schedule has no cutoff.
+*/
+func (s *NoShowScheduler) Run(ctx context.Context) {
+	if s.schedule.NoShowCutoff.IsZero() {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.MarkNoShows(ctx); err != nil && ctx.Err() == nil {
+				s.logger.ErrorContext(ctx, "Failed to mark no-shows.", "error", err)
+			}
+		}
+	}
+}
+
+/**
+ * Marks the guests that haven't arrived by the cutoff as no-shows, releasing their
+ * seats, but those added or answering within the last interval. Does nothing before the cutoff.
+ *
+ * @return  number of guests marked
+ */
+func (s *NoShowScheduler) MarkNoShows(ctx context.Context) (int, error) {
+	if !s.schedule.CutoffPassed(s.clock.Now()) {
+		return 0, nil
+	}
+
+	ctx, span := tracer.Start(ctx, "NoShowScheduler.MarkNoShows")
+	defer span.End()
+
+	// guests are marked once they held their seats for a whole interval
+	guests, err := s.guestRepository.MarkNoShows(ctx, s.clock.Now().Add(-s.interval))
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	for _, guest := range guests {
+		s.logger.InfoContext(ctx, "Marked guest as no-show.",
+			logging.GuestName(guest.Name),
+			"table_id", guest.Table,
+			"released_seats", guest.Accompanying_guests+1,
+		)
+	}
+
+	span.SetAttributes(attribute.Int("guest.no_shows", len(guests)))
+
+	if len(guests) > 0 {
+		s.seatsFreed.Notify()
+	}
+
+	return len(guests), nil
+}
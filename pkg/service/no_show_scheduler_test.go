@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// A Clock stopped at a fixed time.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func Test_NoShowScheduler_MarkNoShows(t *testing.T) {
+	cutoff := time.Date(2024, time.December, 20, 22, 0, 0, 0, time.UTC)
+	schedule := model.EventSchedule{DoorsOpen: cutoff.Add(-2 * time.Hour), NoShowCutoff: cutoff}
+
+	t.Run("Do_Nothing_Before_Cutoff", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+
+		seatsFreed := NewSeatsFreedSignal()
+		s := NewNoShowScheduler(mockRepository, schedule, fixedClock(cutoff.Add(-time.Second)), time.Minute, seatsFreed, logging.NewNop())
+
+		marked, err := s.MarkNoShows(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, marked)
+		assert.Len(t, seatsFreed.C(), 0)
+	})
+
+	t.Run("Do_Nothing_Without_Cutoff", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+
+		s := NewNoShowScheduler(mockRepository, model.EventSchedule{}, fixedClock(cutoff), time.Minute, nil, logging.NewNop())
+
+		marked, err := s.MarkNoShows(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, marked)
+	})
+
+	t.Run("Mark_And_Free_Seats_After_Cutoff", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			MarkNoShows(gomock.Any(), cutoff.Add(-time.Minute)).
+			Return([]model.GuestData{{Name: "Flor", Table: 1, Accompanying_guests: 2}, {Name: "Nico", Table: 3}}, nil).
+			Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		s := NewNoShowScheduler(mockRepository, schedule, fixedClock(cutoff), time.Minute, seatsFreed, logging.NewNop())
+
+		marked, err := s.MarkNoShows(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, marked)
+		assert.Len(t, seatsFreed.C(), 1)
+	})
+
+	t.Run("Dont_Notify_When_Everyone_Arrived", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			MarkNoShows(gomock.Any(), cutoff.Add(time.Hour-time.Minute)).
+			Return(nil, nil).
+			Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		s := NewNoShowScheduler(mockRepository, schedule, fixedClock(cutoff.Add(time.Hour)), time.Minute, seatsFreed, logging.NewNop())
+
+		marked, err := s.MarkNoShows(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, marked)
+		assert.Len(t, seatsFreed.C(), 0)
+	})
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+The `DefaultNotificationService` renders notification campaigns for a segment of guests.
+
+Campaigns are not sent right away: the rendered emails are added to the outbox and
+delivered by the `OutboxDispatcher`, which retries them while the SMTP server is down.
+Guests without an email address are skipped and counted in the result.
+*/
+type DefaultNotificationService struct {
+	notificationRepository repository.INotificationRepository
+	renderer               *notification.Renderer
+	eventName              string
+	publicBaseURL          string
+	logger                 *slog.Logger
+}
+
+func NewDefaultNotificationService(nRepo repository.INotificationRepository, renderer *notification.Renderer, eventName string, publicBaseURL string, logger *slog.Logger) *DefaultNotificationService {
+	return &DefaultNotificationService{
+		notificationRepository: nRepo,
+		renderer:               renderer,
+		eventName:              eventName,
+		publicBaseURL:          strings.TrimSuffix(publicBaseURL, "/"),
+		logger:                 logger,
+	}
+}
+
+func (d *DefaultNotificationService) Preview(ctx context.Context, campaign *model.Campaign) (*model.CampaignResult, error) {
+	ctx, span := tracer.Start(ctx, "DefaultNotificationService.Preview")
+	defer span.End()
+
+	notifications, skipped, err := d.render(ctx, campaign)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	return &model.CampaignResult{
+		Kind:     campaign.Kind,
+		Queued:   len(notifications),
+		Skipped:  skipped,
+		Messages: notifications,
+	}, nil
+}
+
+/**
+ * Renders the notification of the campaign for every guest of its segment with an
+ * email address and adds them all to the outbox.
+ *
+ * @param  campaign  pointer to Campaign with the kind of notification and the segment
+ * @return           pointer to a CampaignResult with the number of queued and skipped guests
+ */
+func (d *DefaultNotificationService) StartCampaign(ctx context.Context, campaign *model.Campaign) (*model.CampaignResult, error) {
+	ctx, span := tracer.Start(ctx, "DefaultNotificationService.StartCampaign")
+	defer span.End()
+
+	notifications, skipped, err := d.render(ctx, campaign)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("notification.kind", string(campaign.Kind)),
+		attribute.Int("notification.queued", len(notifications)),
+	)
+
+	if len(notifications) > 0 {
+		err = d.notificationRepository.EnqueueNotifications(ctx, notifications)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+	}
+
+	d.logger.InfoContext(ctx, "Queued notification campaign.",
+		"kind", campaign.Kind,
+		"queued", len(notifications),
+		"skipped", skipped,
+	)
+
+	return &model.CampaignResult{
+		Kind:    campaign.Kind,
+		Queued:  len(notifications),
+		Skipped: skipped,
+	}, nil
+}
+
+// `render` validates the campaign and renders its notification for each recipient of the segment.
+func (d *DefaultNotificationService) render(ctx context.Context, campaign *model.Campaign) ([]model.Notification, int, error) {
+	if err := d.validateCampaign(campaign); err != nil {
+		return nil, 0, err
+	}
+
+	recipients, err := d.notificationRepository.GetRecipients(ctx, campaign.Segment)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []model.Notification
+	skipped := 0
+
+	for _, r := range recipients {
+		if r.Email == "" {
+			skipped++
+			continue
+		}
+
+		data := &notification.TemplateData{
+			EventName:           d.eventName,
+			Name:                r.Name,
+			Table:               r.Table,
+			Accompanying_guests: r.Accompanying_guests,
+			RSVPStatus:          r.RSVPStatus,
+		}
+		if r.InvitationToken != "" {
+			data.RSVPLink = d.publicBaseURL + "/v2/rsvp/" + r.InvitationToken
+		}
+
+		message, err := d.renderer.Render(campaign.Kind, data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		notifications = append(notifications, model.Notification{
+			GuestID:   r.GuestID,
+			Recipient: r.Email,
+			Kind:      campaign.Kind,
+			Subject:   message.Subject,
+			TextBody:  message.TextBody,
+			HTMLBody:  message.HTMLBody,
+			Status:    model.NotificationPending,
+		})
+	}
+
+	return notifications, skipped, nil
+}
+
+func (d *DefaultNotificationService) validateCampaign(campaign *model.Campaign) error {
+	// waitlist offers are sent to a single party when seats free up, never to a segment of guests
+	if !d.renderer.Supports(campaign.Kind) || campaign.Kind == model.WaitlistOfferNotification {
+		return e.NewBadInputError(string(campaign.Kind))
+	}
+
+	switch campaign.Segment.ArrivalStatus {
+	case "", model.NotArrived, model.Arrived, model.Rejected, model.Left, model.Allocate, model.NoShow:
+	default:
+		return e.NewBadInputError(string(campaign.Segment.ArrivalStatus))
+	}
+
+	switch campaign.Segment.RSVPStatus {
+	case "", model.Invited, model.Accepted, model.Declined, model.Tentative:
+	default:
+		return e.NewBadInputError(string(campaign.Segment.RSVPStatus))
+	}
+
+	return e.ValidatePositiveInput(campaign.Segment.Table)
+}
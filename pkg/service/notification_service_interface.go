@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `INotificationService` is an interface that defines the methods used to send
+notification campaigns, such as invitations or RSVP reminders, to a segment of guests.
+*/
+type INotificationService interface {
+	// Renders the notifications of a campaign without queueing them.
+	Preview(ctx context.Context, campaign *model.Campaign) (*model.CampaignResult, error)
+	// Renders the notifications of a campaign and adds them to the outbox.
+	StartCampaign(ctx context.Context, campaign *model.Campaign) (*model.CampaignResult, error)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultNotificationService_StartCampaign(t *testing.T) {
+	renderer, _ := notification.NewRenderer()
+	segment := model.Segment{ArrivalStatus: model.NotArrived}
+	recipients := []model.Recipient{
+		{GuestID: 1, Name: "Flor", Email: "flor@example.com", Table: 2, RSVPStatus: model.Invited, InvitationToken: "token"},
+		{GuestID: 2, Name: "Juan", Table: 2, RSVPStatus: model.Invited},
+	}
+
+	t.Run("Return_BadInput_When_Kind_Is_Unknown", func(t *testing.T) {
+		ms := NewDefaultNotificationService(nil, renderer, "Party", "http://localhost:3000", logging.NewNop())
+
+		_, err := ms.StartCampaign(context.Background(), &model.Campaign{Kind: "farewell"})
+		assert.Equal(t, err.Error(), ex.NewBadInputError("farewell").Error())
+	})
+
+	t.Run("Return_BadInput_When_Segment_Status_Is_Unknown", func(t *testing.T) {
+		ms := NewDefaultNotificationService(nil, renderer, "Party", "http://localhost:3000", logging.NewNop())
+
+		campaign := &model.Campaign{Kind: model.ReminderNotification, Segment: model.Segment{RSVPStatus: "maybe"}}
+		_, err := ms.StartCampaign(context.Background(), campaign)
+		assert.Equal(t, err.Error(), ex.NewBadInputError("maybe").Error())
+	})
+
+	t.Run("Queue_Guests_With_Email_And_Skip_The_Rest", func(t *testing.T) {
+		mockRepository := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetRecipients(gomock.Any(), segment).
+			Return(recipients, nil).
+			Times(1)
+
+		var queued []model.Notification
+		mockRepository.
+			EXPECT().
+			EnqueueNotifications(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notifications []model.Notification) error {
+				queued = notifications
+				return nil
+			}).
+			Times(1)
+
+		ms := NewDefaultNotificationService(mockRepository, renderer, "Party", "http://localhost:3000/", logging.NewNop())
+
+		result, err := ms.StartCampaign(context.Background(), &model.Campaign{Kind: model.InvitationNotification, Segment: segment})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, result.Queued)
+		assert.Equal(t, 1, result.Skipped)
+		assert.Empty(t, result.Messages)
+
+		assert.Len(t, queued, 1)
+		assert.Equal(t, "flor@example.com", queued[0].Recipient)
+		assert.Contains(t, queued[0].TextBody, "http://localhost:3000/v2/rsvp/token")
+	})
+
+	t.Run("Return_Error_When_Queueing_Fails", func(t *testing.T) {
+		mockRepository := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetRecipients(gomock.Any(), segment).
+			Return(recipients, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			EnqueueNotifications(gomock.Any(), gomock.Any()).
+			Return(errors.New("connection lost")).
+			Times(1)
+
+		ms := NewDefaultNotificationService(mockRepository, renderer, "Party", "http://localhost:3000", logging.NewNop())
+
+		_, err := ms.StartCampaign(context.Background(), &model.Campaign{Kind: model.InvitationNotification, Segment: segment})
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_DefaultNotificationService_Preview(t *testing.T) {
+	renderer, _ := notification.NewRenderer()
+
+	t.Run("Return_Rendered_Messages_Without_Queueing", func(t *testing.T) {
+		mockRepository := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetRecipients(gomock.Any(), model.Segment{Table: 2}).
+			Return([]model.Recipient{{GuestID: 1, Name: "Flor", Email: "flor@example.com", Table: 2, Accompanying_guests: 3}}, nil).
+			Times(1)
+
+		ms := NewDefaultNotificationService(mockRepository, renderer, "Party", "http://localhost:3000", logging.NewNop())
+
+		result, err := ms.Preview(context.Background(), &model.Campaign{Kind: model.SeatAssignmentNotification, Segment: model.Segment{Table: 2}})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, result.Queued)
+		assert.Len(t, result.Messages, 1)
+		assert.Contains(t, result.Messages[0].TextBody, "table 2")
+	})
+}
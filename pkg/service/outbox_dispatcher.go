@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Settings of the delivery of the outbox.
+const (
+	// Notifications claimed on each poll.
+	outboxBatchSize = 20
+	// Time given to the SMTP server to accept a single email.
+	outboxSendTimeout = 10 * time.Second
+	// Time a claimed batch is hidden from other instances, enough to send the whole batch.
+	outboxClaimLease = 5 * time.Minute
+	// Wait before the first retry, doubled on each failed attempt up to outboxMaxRetryDelay.
+	outboxRetryDelay    = 30 * time.Second
+	outboxMaxRetryDelay = time.Hour
+)
+
+/*
+The `OutboxDispatcher` delivers the notifications of the outbox in the background.
+
+Every poll it claims the pending notifications that are due and sends them one by one.
+Failed deliveries are retried with an exponential backoff, and given up once they have
+been attempted `maxAttempts` times.
+*/
+type OutboxDispatcher struct {
+	notificationRepository repository.INotificationRepository
+	sender                 notification.ISender
+	interval               time.Duration
+	maxAttempts            int
+	logger                 *slog.Logger
+}
+
+func NewOutboxDispatcher(nRepo repository.INotificationRepository, sender notification.ISender, interval time.Duration, maxAttempts int, logger *slog.Logger) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		notificationRepository: nRepo,
+		sender:                 sender,
+		interval:               interval,
+		maxAttempts:            maxAttempts,
+		logger:                 logger,
+	}
+}
+
+/*
+`Run` polls the outbox every interval until ctx is done. Emails being sent when ctx
+is done are retried later, since their claim runs out.
+*/
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchDue(ctx); err != nil && ctx.Err() == nil {
+				d.logger.ErrorContext(ctx, "Failed to dispatch outbox.", "error", err)
+			}
+		}
+	}
+}
+
+/**
+ * Sends a batch of due notifications, recording the outcome of each one.
+ *
+ * @return  number of notifications delivered
+ */
+func (d *OutboxDispatcher) DispatchDue(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "OutboxDispatcher.DispatchDue")
+	defer span.End()
+
+	notifications, err := d.notificationRepository.ClaimDueNotifications(ctx, outboxBatchSize, outboxClaimLease)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	sent := 0
+	for i := range notifications {
+		if ctx.Err() != nil {
+			break
+		}
+		if d.dispatch(ctx, &notifications[i]) {
+			sent++
+		}
+	}
+
+	span.SetAttributes(attribute.Int("notification.claimed", len(notifications)), attribute.Int("notification.sent", sent))
+
+	return sent, nil
+}
+
+// `dispatch` sends a single notification and records the result, returning whether it was delivered.
+func (d *OutboxDispatcher) dispatch(ctx context.Context, n *model.Notification) bool {
+	sendCtx, cancel := context.WithTimeout(ctx, outboxSendTimeout)
+	err := d.sender.Send(sendCtx, &notification.Email{
+		To: n.Recipient,
+		Message: notification.Message{
+			Subject:  n.Subject,
+			TextBody: n.TextBody,
+			HTMLBody: n.HTMLBody,
+		},
+	})
+	cancel()
+
+	attempt := n.Attempts + 1
+	logAttrs := []any{"notification_id", n.NotificationID, "guest_id", n.GuestID, "kind", n.Kind, "attempt", attempt}
+
+	var recordErr error
+	switch {
+	case err == nil:
+		d.logger.InfoContext(ctx, "Sent notification.", logAttrs...)
+		recordErr = d.notificationRepository.MarkNotificationSent(ctx, n.NotificationID)
+	case attempt >= d.maxAttempts:
+		d.logger.ErrorContext(ctx, "Giving up on notification.", append(logAttrs, "error", err)...)
+		recordErr = d.notificationRepository.FailNotification(ctx, n.NotificationID, err.Error())
+	default:
+		retryIn := RetryDelay(attempt)
+		d.logger.WarnContext(ctx, "Failed to send notification, will retry.", append(logAttrs, "error", err, "retry_in", retryIn)...)
+		recordErr = d.notificationRepository.RetryNotification(ctx, n.NotificationID, err.Error(), retryIn)
+	}
+
+	if recordErr != nil {
+		d.logger.ErrorContext(ctx, "Failed to record notification delivery.", append(logAttrs, "error", recordErr)...)
+	}
+	return err == nil
+}
+
+/*
+`RetryDelay` returns how long to wait before retrying a notification that failed
+`attempt` times: 30s after the first failure, doubling up to an hour.
+*/
+func RetryDelay(attempt int) time.Duration {
+	delay := outboxRetryDelay
+	for i := 1; i < attempt && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxRetryDelay {
+		delay = outboxMaxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OutboxDispatcher_DispatchDue(t *testing.T) {
+	pending := func(id int, attempts int) model.Notification {
+		return model.Notification{NotificationID: id, GuestID: id, Recipient: "guest@example.com", Kind: model.InvitationNotification, Attempts: attempts}
+	}
+
+	t.Run("Mark_Sent_When_Delivered", func(t *testing.T) {
+		mockRepository := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ClaimDueNotifications(gomock.Any(), outboxBatchSize, outboxClaimLease).
+			Return([]model.Notification{pending(1, 0)}, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			MarkNotificationSent(gomock.Any(), 1).
+			Return(nil).
+			Times(1)
+
+		mockSender := notification.NewMockISender(gomock.NewController(t))
+		mockSender.
+			EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		d := NewOutboxDispatcher(mockRepository, mockSender, time.Second, 3, logging.NewNop())
+
+		sent, err := d.DispatchDue(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("Retry_With_Backoff_When_Delivery_Fails", func(t *testing.T) {
+		mockRepository := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ClaimDueNotifications(gomock.Any(), outboxBatchSize, outboxClaimLease).
+			Return([]model.Notification{pending(1, 1)}, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			RetryNotification(gomock.Any(), 1, "connection refused", time.Minute).
+			Return(nil).
+			Times(1)
+
+		mockSender := notification.NewMockISender(gomock.NewController(t))
+		mockSender.
+			EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Return(errors.New("connection refused")).
+			Times(1)
+
+		d := NewOutboxDispatcher(mockRepository, mockSender, time.Second, 3, logging.NewNop())
+
+		sent, err := d.DispatchDue(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("Give_Up_When_Attempts_Are_Exhausted", func(t *testing.T) {
+		mockRepository := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ClaimDueNotifications(gomock.Any(), outboxBatchSize, outboxClaimLease).
+			Return([]model.Notification{pending(1, 2)}, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			FailNotification(gomock.Any(), 1, "mailbox unavailable").
+			Return(nil).
+			Times(1)
+
+		mockSender := notification.NewMockISender(gomock.NewController(t))
+		mockSender.
+			EXPECT().
+			Send(gomock.Any(), gomock.Any()).
+			Return(errors.New("mailbox unavailable")).
+			Times(1)
+
+		d := NewOutboxDispatcher(mockRepository, mockSender, time.Second, 3, logging.NewNop())
+
+		_, err := d.DispatchDue(context.Background())
+		assert.Nil(t, err)
+	})
+}
+
+func Test_RetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, RetryDelay(1))
+	assert.Equal(t, time.Minute, RetryDelay(2))
+	assert.Equal(t, 2*time.Minute, RetryDelay(3))
+	assert.Equal(t, time.Hour, RetryDelay(20))
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/pass"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+The `DefaultPassService` issues the QR-code check-in passes of the guests and checks
+guests in when their pass is scanned, so door staff don't have to type names.
+
+Passes are given to guests through their invitation link, and to the staff by guest id, and carry a token signed
+by the `pass.Signer`, forged passes are rejected. The token holds the version of the pass,
+which goes up every time the guest arrives, so a replayed pass is rejected even after the
+arrival is undone. The arrival itself is handled by the guest service.
+*/
+type DefaultPassService struct {
+	guestRepository repository.IGuestRepository
+	rsvpRepository  repository.IRSVPRepository
+	guestService    IGuestService
+	signer          *pass.Signer
+	logger          *slog.Logger
+}
+
+func NewDefaultPassService(gRepo repository.IGuestRepository, rRepo repository.IRSVPRepository, gService IGuestService, signer *pass.Signer, logger *slog.Logger) *DefaultPassService {
+	return &DefaultPassService{
+		guestRepository: gRepo,
+		rsvpRepository:  rRepo,
+		guestService:    gService,
+		signer:          signer,
+		logger:          logger,
+	}
+}
+
+/**
+ * Builds the check-in pass of the guest holding the invitation token, signed with the
+ * current version of their pass.
+ *
+ * @param  token  token of the invitation link of the guest
+ * @return        pointer to the GuestPass
+ */
+func (d *DefaultPassService) GetPass(ctx context.Context, token string) (*model.GuestPass, error) {
+	ctx, span := tracer.Start(ctx, "DefaultPassService.GetPass")
+	defer span.End()
+
+	invitation, err := d.rsvpRepository.GetInvitation(ctx, token)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	guest, err := d.guestRepository.GetGuestByID(ctx, invitation.GuestID)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	return d.sign(guest), nil
+}
+
+/**
+ * Builds the check-in pass of the guest with the id, signed with the current version
+ * of their pass, so the staff can hand it to guests without their invitation link.
+ *
+ * @param  guestID  id of the guest
+ * @return          pointer to the GuestPass
+ */
+func (d *DefaultPassService) GetGuestPass(ctx context.Context, guestID int) (*model.GuestPass, error) {
+	ctx, span := tracer.Start(ctx, "DefaultPassService.GetGuestPass")
+	defer span.End()
+
+	guest, err := d.guestRepository.GetGuestByID(ctx, guestID)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	return d.sign(guest), nil
+}
+
+func (d *DefaultPassService) sign(guest *model.Guest) *model.GuestPass {
+	return &model.GuestPass{
+		GuestID: guest.GuestID,
+		Name:    guest.Name,
+		Token:   d.signer.Sign(guest.GuestID, guest.PassVersion),
+	}
+}
+
+/**
+ * Checks in the guest of a scanned pass. Returns an InvalidPass error if the token
+ * was forged, and a PassAlreadyUsed error if the guest arrived with the pass before
+ * or is no longer waiting to arrive.
+ * The guest is updated at the version read here, so two scans of the same pass at
+ * the same time can't both check the guest in.
+ *
+ * @param  scan  pointer to PassScan with the token and the entourage
+ * @return       pointer to the updated Guest
+ */
+func (d *DefaultPassService) Scan(ctx context.Context, scan *model.PassScan) (*model.Guest, error) {
+	ctx, span := tracer.Start(ctx, "DefaultPassService.Scan")
+	defer span.End()
+
+	guestID, passVersion, err := d.signer.Verify(scan.Token)
+	if err != nil {
+		d.logger.WarnContext(ctx, "Rejected forged pass.")
+		return nil, tracing.RecordError(span, e.NewInvalidPassError())
+	}
+
+	guest, err := d.guestRepository.GetGuestByID(ctx, guestID)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	// guests marked as no-shows can still turn up late, but a pass they arrived with is used up
+	if passVersion != guest.PassVersion || (guest.ArrivalStatus != model.NotArrived && guest.ArrivalStatus != model.NoShow) {
+		d.logger.WarnContext(ctx, "Rejected replayed pass.", "guest_id", guest.GuestID, logging.GuestName(guest.Name), "arrival_status", guest.ArrivalStatus)
+		return nil, tracing.RecordError(span, e.NewPassAlreadyUsedError(string(guest.ArrivalStatus)))
+	}
+
+	// without a count, the guest arrives with the expected entourage
+	entourage := guest.Entourage
+	if scan.Accompanying_guests != nil {
+		entourage = *scan.Accompanying_guests
+	}
+
+	updated, err := d.guestService.UpdateGuest(ctx, &model.GuestData{Name: guest.Name, Accompanying_guests: entourage}, guest.Version)
+
+	return updated, tracing.RecordError(span, err)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IPassService` is an interface that defines the methods used to issue check-in
+passes to guests and to check guests in by scanning them at the door.
+*/
+type IPassService interface {
+	// Builds the signed check-in pass of the guest holding the invitation token.
+	GetPass(ctx context.Context, token string) (*model.GuestPass, error)
+	// Builds the signed check-in pass of the guest with the id, for the staff.
+	GetGuestPass(ctx context.Context, guestID int) (*model.GuestPass, error)
+	// Verifies a scanned pass and sets its guest as arrived.
+	Scan(ctx context.Context, scan *model.PassScan) (*model.Guest, error)
+}
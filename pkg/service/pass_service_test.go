@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/pass"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultPassService_GetPass(t *testing.T) {
+	signer := pass.NewSigner([]byte("secret"))
+
+	t.Run("Return_Pass_Signed_With_Its_Version", func(t *testing.T) {
+		mockRSVPRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRSVPRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), "abc").
+			Return(&model.Invitation{GuestID: 7, Name: "Flor"}, nil).
+			Times(1)
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 7).
+			Return(&model.Guest{GuestID: 7, Name: "Flor", PassVersion: 2}, nil).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, mockRSVPRepository, nil, signer, logging.NewNop())
+
+		result, err := ms.GetPass(context.Background(), "abc")
+		assert.Nil(t, err)
+		assert.Equal(t, &model.GuestPass{GuestID: 7, Name: "Flor", Token: signer.Sign(7, 2)}, result)
+	})
+
+	t.Run("Return_NotFound_When_Invitation_Does_Not_Exist", func(t *testing.T) {
+		mockRSVPRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRSVPRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), "xyz").
+			Return(nil, ex.NewNotFoundError("xyz", "token", "invitation")).
+			Times(1)
+
+		ms := NewDefaultPassService(nil, mockRSVPRepository, nil, signer, logging.NewNop())
+
+		result, err := ms.GetPass(context.Background(), "xyz")
+		assert.Nil(t, result)
+		assert.IsType(t, &ex.NotFoundError{}, err)
+	})
+}
+
+func Test_DefaultPassService_GetGuestPass(t *testing.T) {
+	signer := pass.NewSigner([]byte("secret"))
+
+	t.Run("Return_Pass_Signed_With_Its_Version", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 7).
+			Return(&model.Guest{GuestID: 7, Name: "Flor", PassVersion: 3}, nil).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, nil, nil, signer, logging.NewNop())
+
+		result, err := ms.GetGuestPass(context.Background(), 7)
+		assert.Nil(t, err)
+		assert.Equal(t, &model.GuestPass{GuestID: 7, Name: "Flor", Token: signer.Sign(7, 3)}, result)
+	})
+
+	t.Run("Return_NotFound_When_Guest_Does_Not_Exist", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 8).
+			Return(nil, ex.NewNotFoundError("8", "id", "guest")).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, nil, nil, signer, logging.NewNop())
+
+		result, err := ms.GetGuestPass(context.Background(), 8)
+		assert.Nil(t, result)
+		assert.IsType(t, &ex.NotFoundError{}, err)
+	})
+}
+
+func Test_DefaultPassService_Scan(t *testing.T) {
+	signer := pass.NewSigner([]byte("secret"))
+	guest := func(status model.GuestStatus) *model.Guest {
+		return &model.Guest{
+			GuestID:       7,
+			Name:          "Flor",
+			Entourage:     2,
+			ArrivalStatus: status,
+			Version:       4,
+			PassVersion:   1,
+		}
+	}
+
+	t.Run("Return_InvalidPass_When_Token_Is_Forged", func(t *testing.T) {
+		ms := NewDefaultPassService(nil, nil, nil, signer, logging.NewNop())
+
+		forged := pass.NewSigner([]byte("other")).Sign(7, 1)
+		_, err := ms.Scan(context.Background(), &model.PassScan{Token: forged})
+		assert.Equal(t, err.Error(), ex.NewInvalidPassError().Error())
+	})
+
+	t.Run("Return_PassAlreadyUsed_When_Guest_Arrived", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 7).
+			Return(guest(model.Arrived), nil).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, nil, nil, signer, logging.NewNop())
+
+		_, err := ms.Scan(context.Background(), &model.PassScan{Token: signer.Sign(7, 1)})
+		assert.Equal(t, err.Error(), ex.NewPassAlreadyUsedError("arrived").Error())
+	})
+
+	t.Run("Return_PassAlreadyUsed_When_Arrival_Was_Undone", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 7).
+			Return(guest(model.NotArrived), nil).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, nil, nil, signer, logging.NewNop())
+
+		// the pass of version 0 was used before the arrival was undone
+		_, err := ms.Scan(context.Background(), &model.PassScan{Token: signer.Sign(7, 0)})
+		assert.IsType(t, &ex.PassAlreadyUsedError{}, err)
+	})
+
+	t.Run("Return_Guest_When_Pass_Is_Valid", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 7).
+			Return(guest(model.NotArrived), nil).
+			Times(1)
+
+		arrived := guest(model.Arrived)
+		mockGuestService := NewMockIGuestService(gomock.NewController(t))
+		// no count scanned, the expected entourage arrives at the version read
+		mockGuestService.
+			EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: "Flor", Accompanying_guests: 2}, 4).
+			Return(arrived, nil).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, nil, mockGuestService, signer, logging.NewNop())
+
+		result, err := ms.Scan(context.Background(), &model.PassScan{Token: signer.Sign(7, 1)})
+		assert.Nil(t, err)
+		assert.Equal(t, arrived, result)
+	})
+
+	t.Run("Return_Guest_With_Scanned_Entourage", func(t *testing.T) {
+		mockRepository := repository.NewMockIGuestRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetGuestByID(gomock.Any(), 7).
+			Return(guest(model.NotArrived), nil).
+			Times(1)
+
+		mockGuestService := NewMockIGuestService(gomock.NewController(t))
+		mockGuestService.
+			EXPECT().
+			UpdateGuest(gomock.Any(), &model.GuestData{Name: "Flor", Accompanying_guests: 5}, 4).
+			Return(guest(model.Rejected), nil).
+			Times(1)
+
+		ms := NewDefaultPassService(mockRepository, nil, mockGuestService, signer, logging.NewNop())
+
+		entourage := 5
+		result, err := ms.Scan(context.Background(), &model.PassScan{Token: signer.Sign(7, 1), Accompanying_guests: &entourage})
+		assert.Nil(t, err)
+		assert.EqualValues(t, model.Rejected, result.ArrivalStatus)
+	})
+}
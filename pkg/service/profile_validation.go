@@ -0,0 +1,70 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+// Longest values accepted for the free text fields of a guest profile, matching the `guest` table.
+const (
+	maxProfileFieldLength = 255
+	maxProfileNotesLength = 2000
+)
+
+// Digits with an optional leading plus, and the spaces, dashes, dots and parentheses people type in phone numbers.
+var phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ().-]{5,30}$`)
+
+/*
+`validateProfile` checks the fields of a guest profile, returning a BadInput error for
+the first invalid one. Surrounding spaces are trimmed, and a missing diet is set to none.
+*/
+func validateProfile(profile *model.GuestProfile) error {
+	profile.Email = strings.TrimSpace(profile.Email)
+	profile.Phone = strings.TrimSpace(profile.Phone)
+	profile.DietNotes = strings.TrimSpace(profile.DietNotes)
+	profile.Allergies = strings.TrimSpace(profile.Allergies)
+	profile.AccessibilityNeeds = strings.TrimSpace(profile.AccessibilityNeeds)
+	profile.Notes = strings.TrimSpace(profile.Notes)
+
+	if err := e.ValidateEmailInput(profile.Email); err != nil {
+		return err
+	}
+
+	if profile.Phone != "" && !phonePattern.MatchString(profile.Phone) {
+		return e.NewBadInputError(profile.Phone)
+	}
+
+	if profile.Diet == "" {
+		profile.Diet = model.DietNone
+	}
+	if !isDietType(profile.Diet) {
+		return e.NewBadInputError(string(profile.Diet))
+	}
+	// the free text is what catering reads for other diets
+	if profile.Diet == model.DietOther && profile.DietNotes == "" {
+		return e.NewBadInputError("diet_notes is required for diet other")
+	}
+
+	for _, field := range []string{profile.DietNotes, profile.Allergies, profile.AccessibilityNeeds} {
+		if len(field) > maxProfileFieldLength {
+			return e.NewBadInputError("text longer than 255 characters")
+		}
+	}
+	if len(profile.Notes) > maxProfileNotesLength {
+		return e.NewBadInputError("notes longer than 2000 characters")
+	}
+
+	return nil
+}
+
+func isDietType(diet model.DietType) bool {
+	for _, d := range model.DietTypes {
+		if d == diet {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Bounds of the intervals the arrivals can be bucketed by.
+const (
+	minArrivalInterval = time.Minute
+	maxArrivalInterval = 24 * time.Hour
+)
+
+// Layout of the times stored by MySQL, as read without parsing.
+const mysqlTimeLayout = "2006-01-02 15:04:05"
+
+/*
+The `DefaultReportService` aggregates guest data into reports.
+*/
+type DefaultReportService struct {
+	reportRepository repository.IReportRepository
+}
+
+func NewDefaultReportService(rRepo repository.IReportRepository) *DefaultReportService {
+	return &DefaultReportService{
+		reportRepository: rRepo,
+	}
+}
+
+/**
+ * Counts the meals to serve at each table, per diet. Each guest is served a meal
+ * for their diet, and their entourage, whose diets are unknown, one meal each with
+ * no dietary restriction. Diet notes and allergies are listed per table so
+ * catering knows who they are for.
+ *
+ * @return  pointer to an instance of CateringReport
+ */
+func (d *DefaultReportService) GetCateringReport(ctx context.Context) (*model.CateringReport, error) {
+	ctx, span := tracer.Start(ctx, "DefaultReportService.GetCateringReport")
+	defer span.End()
+
+	entries, err := d.reportRepository.GetCateringEntries(ctx)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	report := &model.CateringReport{
+		Tables: []model.TableCatering{},
+		Meals:  newMealCounts(),
+	}
+
+	var table *model.TableCatering
+	for _, entry := range entries {
+		// entries come ordered by table
+		if table == nil || table.Table != entry.Table {
+			report.Tables = append(report.Tables, model.TableCatering{
+				Table:     entry.Table,
+				Meals:     newMealCounts(),
+				DietNotes: []model.GuestNote{},
+				Allergies: []model.GuestNote{},
+			})
+			table = &report.Tables[len(report.Tables)-1]
+		}
+
+		diet := entry.Diet
+		if diet == "" {
+			diet = model.DietNone
+		}
+
+		table.Meals[diet]++
+		table.Meals[model.DietNone] += entry.Accompanying_guests
+		table.TotalMeals += entry.Accompanying_guests + 1
+
+		if entry.DietNotes != "" {
+			table.DietNotes = append(table.DietNotes, model.GuestNote{Name: entry.Name, Note: entry.DietNotes})
+		}
+		if entry.Allergies != "" {
+			table.Allergies = append(table.Allergies, model.GuestNote{Name: entry.Name, Note: entry.Allergies})
+		}
+
+		report.Meals[diet]++
+		report.Meals[model.DietNone] += entry.Accompanying_guests
+		report.TotalMeals += entry.Accompanying_guests + 1
+	}
+
+	return report, nil
+}
+
+/**
+ * Buckets the guests that turned up by their arrival time. Buckets are aligned to the
+ * interval and go from the first arrival to the last, so intervals without arrivals
+ * are listed with zero guests.
+ * Returns a BadInput error if the interval is shorter than a minute or longer than a day.
+ *
+ * @param  interval  length of each bucket
+ * @return           pointer to an instance of ArrivalTimeline
+ */
+func (d *DefaultReportService) GetArrivalTimeline(ctx context.Context, interval time.Duration) (*model.ArrivalTimeline, error) {
+	ctx, span := tracer.Start(ctx, "DefaultReportService.GetArrivalTimeline")
+	defer span.End()
+
+	if interval < minArrivalInterval || interval > maxArrivalInterval {
+		return nil, tracing.RecordError(span, e.NewBadInputError(fmt.Sprintf("interval must be between %s and %s", minArrivalInterval, maxArrivalInterval)))
+	}
+
+	entries, err := d.reportRepository.GetArrivals(ctx)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	timeline := &model.ArrivalTimeline{
+		Interval: interval.String(),
+		Buckets:  []model.ArrivalBucket{},
+	}
+
+	var first time.Time
+	for _, entry := range entries {
+		arrivedAt, err := time.Parse(mysqlTimeLayout, entry.ArrivedAt)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+
+		// entries come ordered by arrival, so buckets are added until the arrival fits
+		start := arrivedAt.Truncate(interval)
+		if len(timeline.Buckets) == 0 {
+			first = start
+		}
+		for i := len(timeline.Buckets); !first.Add(time.Duration(i) * interval).After(start); i++ {
+			bucketStart := first.Add(time.Duration(i) * interval)
+			timeline.Buckets = append(timeline.Buckets, model.ArrivalBucket{
+				Start: bucketStart.Format(mysqlTimeLayout),
+				End:   bucketStart.Add(interval).Format(mysqlTimeLayout),
+			})
+		}
+		bucket := &timeline.Buckets[len(timeline.Buckets)-1]
+
+		if entry.ArrivalStatus == model.Rejected {
+			bucket.Rejected++
+			timeline.Rejected++
+			continue
+		}
+		bucket.Guests++
+		bucket.People += entry.Accompanying_guests + 1
+		timeline.Guests++
+		timeline.People += entry.Accompanying_guests + 1
+	}
+
+	return timeline, nil
+}
+
+/**
+ * Compares the capacity of each table with the seats reserved and occupied in it.
+ * The utilization is the share of the capacity occupied by guests that arrived.
+ *
+ * @return  pointer to an instance of TableUtilizationReport
+ */
+func (d *DefaultReportService) GetTableUtilization(ctx context.Context) (*model.TableUtilizationReport, error) {
+	ctx, span := tracer.Start(ctx, "DefaultReportService.GetTableUtilization")
+	defer span.End()
+
+	tables, err := d.reportRepository.GetTableUsage(ctx)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	report := &model.TableUtilizationReport{Tables: []model.TableUtilization{}}
+
+	for _, table := range tables {
+		table.Utilization = ratio(table.OccupiedSeats, table.Capacity)
+		report.Tables = append(report.Tables, table)
+
+		report.Capacity += table.Capacity
+		report.ReservedSeats += table.ReservedSeats
+		report.OccupiedSeats += table.OccupiedSeats
+	}
+	report.Utilization = ratio(report.OccupiedSeats, report.Capacity)
+
+	return report, nil
+}
+
+/**
+ * Measures how many of the guests expected turned up. The rejection rate is taken over
+ * the guests that came to the door, and the no-show rate over those and the no-shows,
+ * since guests that haven't arrived may still come. The entourage averages only count
+ * the guests that came to the door, as only they brought an actual entourage.
+ *
+ * @return  pointer to an instance of AttendanceReport
+ */
+func (d *DefaultReportService) GetAttendanceReport(ctx context.Context) (*model.AttendanceReport, error) {
+	ctx, span := tracer.Start(ctx, "DefaultReportService.GetAttendanceReport")
+	defer span.End()
+
+	entries, err := d.reportRepository.GetAttendance(ctx)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	report := &model.AttendanceReport{}
+	var entourage, expectedEntourage int
+
+	for _, entry := range entries {
+		report.Guests += entry.Guests
+
+		switch entry.ArrivalStatus {
+		case model.NotArrived:
+			report.NotArrived += entry.Guests
+			continue
+		case model.NoShow:
+			report.NoShows += entry.Guests
+			continue
+		case model.Arrived:
+			report.Arrived += entry.Guests
+		case model.Left:
+			report.Left += entry.Guests
+		case model.Rejected:
+			report.Rejected += entry.Guests
+		default:
+			continue
+		}
+
+		report.TurnedUp += entry.Guests
+		entourage += entry.Entourage
+		expectedEntourage += entry.ExpectedEntourage
+	}
+
+	report.RejectionRate = ratio(report.Rejected, report.TurnedUp)
+	report.NoShowRate = ratio(report.NoShows, report.TurnedUp+report.NoShows)
+	report.AverageEntourage = ratio(entourage, report.TurnedUp)
+	report.AverageExpectedEntourage = ratio(expectedEntourage, report.TurnedUp)
+	report.AverageEntourageDeviation = ratio(entourage-expectedEntourage, report.TurnedUp)
+
+	return report, nil
+}
+
+// `ratio` divides part by total rounded to 4 decimals, or returns 0 when there is no total.
+func ratio(part int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 10000
+}
+
+// `newMealCounts` starts every diet at zero, so reports always list the same diets.
+func newMealCounts() map[model.DietType]int {
+	counts := make(map[model.DietType]int, len(model.DietTypes))
+	for _, diet := range model.DietTypes {
+		counts[diet] = 0
+	}
+	return counts
+}
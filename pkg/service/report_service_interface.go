@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IReportService` is an interface that defines the reports built for the people
+organizing the event.
+*/
+type IReportService interface {
+	// Builds the meal counts per table for catering.
+	GetCateringReport(ctx context.Context) (*model.CateringReport, error)
+	// Buckets the guests that turned up by their arrival time.
+	GetArrivalTimeline(ctx context.Context, interval time.Duration) (*model.ArrivalTimeline, error)
+	// Compares the capacity of each table with the seats reserved and occupied.
+	GetTableUtilization(ctx context.Context) (*model.TableUtilizationReport, error)
+	// Measures the rejection and no-show rates and how the entourages deviated from what was expected.
+	GetAttendanceReport(ctx context.Context) (*model.AttendanceReport, error)
+}
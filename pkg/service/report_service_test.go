@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultReportService_GetCateringReport(t *testing.T) {
+
+	t.Run("Count_Meals_Per_Table_And_Diet", func(t *testing.T) {
+		mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetCateringEntries(gomock.Any()).
+			Return([]model.CateringEntry{
+				{Table: 1, Name: "Flor", Accompanying_guests: 2, Diet: model.DietVegan, Allergies: "peanuts"},
+				{Table: 1, Name: "Juan", Diet: model.DietNone},
+				{Table: 2, Name: "Ana", Accompanying_guests: 1, Diet: model.DietOther, DietNotes: "low sodium"},
+			}, nil).
+			Times(1)
+
+		rs := NewDefaultReportService(mockRepository)
+
+		report, err := rs.GetCateringReport(context.Background())
+		assert.Nil(t, err)
+
+		assert.Len(t, report.Tables, 2)
+		assert.Equal(t, 1, report.Tables[0].Table)
+		assert.Equal(t, 1, report.Tables[0].Meals[model.DietVegan])
+		assert.Equal(t, 3, report.Tables[0].Meals[model.DietNone])
+		assert.Equal(t, 4, report.Tables[0].TotalMeals)
+		assert.Equal(t, []model.GuestNote{{Name: "Flor", Note: "peanuts"}}, report.Tables[0].Allergies)
+
+		assert.Equal(t, 1, report.Tables[1].Meals[model.DietOther])
+		assert.Equal(t, []model.GuestNote{{Name: "Ana", Note: "low sodium"}}, report.Tables[1].DietNotes)
+
+		assert.Equal(t, 6, report.TotalMeals)
+		assert.Equal(t, 4, report.Meals[model.DietNone])
+		assert.Equal(t, 0, report.Meals[model.DietHalal])
+	})
+
+	t.Run("Return_Empty_Report_When_No_Guests", func(t *testing.T) {
+		mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetCateringEntries(gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+
+		rs := NewDefaultReportService(mockRepository)
+
+		report, err := rs.GetCateringReport(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, report.Tables)
+		assert.Equal(t, 0, report.TotalMeals)
+	})
+
+	t.Run("Return_Error_When_Repository_Fails", func(t *testing.T) {
+		mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetCateringEntries(gomock.Any()).
+			Return(nil, errors.New("connection lost")).
+			Times(1)
+
+		rs := NewDefaultReportService(mockRepository)
+
+		_, err := rs.GetCateringReport(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_DefaultReportService_GetArrivalTimeline(t *testing.T) {
+
+	t.Run("Return_BadInput_When_Interval_Out_Of_Bounds", func(t *testing.T) {
+		rs := NewDefaultReportService(nil)
+
+		for _, interval := range []time.Duration{30 * time.Second, 25 * time.Hour} {
+			_, err := rs.GetArrivalTimeline(context.Background(), interval)
+			assert.IsType(t, &ex.BadInputError{}, err, interval)
+		}
+	})
+
+	t.Run("Bucket_Arrivals_Including_Empty_Intervals", func(t *testing.T) {
+		mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetArrivals(gomock.Any()).
+			Return([]model.ArrivalEntry{
+				{ArrivedAt: "2024-12-20 21:03:10", ArrivalStatus: model.Arrived, Accompanying_guests: 2},
+				{ArrivedAt: "2024-12-20 21:14:59", ArrivalStatus: model.Left},
+				{ArrivedAt: "2024-12-20 21:14:59", ArrivalStatus: model.Rejected, Accompanying_guests: 5},
+				{ArrivedAt: "2024-12-20 21:45:00", ArrivalStatus: model.Arrived, Accompanying_guests: 1},
+			}, nil).
+			Times(1)
+
+		rs := NewDefaultReportService(mockRepository)
+
+		timeline, err := rs.GetArrivalTimeline(context.Background(), 15*time.Minute)
+		assert.Nil(t, err)
+
+		assert.Equal(t, "15m0s", timeline.Interval)
+		assert.Equal(t, []model.ArrivalBucket{
+			{Start: "2024-12-20 21:00:00", End: "2024-12-20 21:15:00", Guests: 2, People: 4, Rejected: 1},
+			{Start: "2024-12-20 21:15:00", End: "2024-12-20 21:30:00"},
+			{Start: "2024-12-20 21:30:00", End: "2024-12-20 21:45:00"},
+			{Start: "2024-12-20 21:45:00", End: "2024-12-20 22:00:00", Guests: 1, People: 2},
+		}, timeline.Buckets)
+		assert.Equal(t, 3, timeline.Guests)
+		assert.Equal(t, 6, timeline.People)
+		assert.Equal(t, 1, timeline.Rejected)
+	})
+
+	t.Run("Return_Empty_Timeline_When_No_Arrivals", func(t *testing.T) {
+		mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+		mockRepository.EXPECT().GetArrivals(gomock.Any()).Return(nil, nil).Times(1)
+
+		rs := NewDefaultReportService(mockRepository)
+
+		timeline, err := rs.GetArrivalTimeline(context.Background(), time.Hour)
+		assert.Nil(t, err)
+		assert.NotNil(t, timeline.Buckets)
+		assert.Empty(t, timeline.Buckets)
+	})
+}
+
+func Test_DefaultReportService_GetTableUtilization(t *testing.T) {
+	mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+	mockRepository.
+		EXPECT().
+		GetTableUsage(gomock.Any()).
+		Return([]model.TableUtilization{
+			{Table: 1, Capacity: 10, ReservedSeats: 8, OccupiedSeats: 5},
+			{Table: 2, Capacity: 6, ReservedSeats: 6, OccupiedSeats: 6},
+			{Table: 3, Capacity: 0},
+		}, nil).
+		Times(1)
+
+	rs := NewDefaultReportService(mockRepository)
+
+	report, err := rs.GetTableUtilization(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 0.5, report.Tables[0].Utilization)
+	assert.Equal(t, 1.0, report.Tables[1].Utilization)
+	assert.Equal(t, 0.0, report.Tables[2].Utilization)
+	assert.Equal(t, 16, report.Capacity)
+	assert.Equal(t, 14, report.ReservedSeats)
+	assert.Equal(t, 11, report.OccupiedSeats)
+	assert.Equal(t, 0.6875, report.Utilization)
+}
+
+func Test_DefaultReportService_GetAttendanceReport(t *testing.T) {
+	mockRepository := repository.NewMockIReportRepository(gomock.NewController(t))
+	mockRepository.
+		EXPECT().
+		GetAttendance(gomock.Any()).
+		Return([]model.AttendanceEntry{
+			{ArrivalStatus: model.NotArrived, Guests: 2, Entourage: 4},
+			{ArrivalStatus: model.Arrived, Guests: 4, Entourage: 9, ExpectedEntourage: 6},
+			{ArrivalStatus: model.Left, Guests: 1, Entourage: 0, ExpectedEntourage: 1},
+			{ArrivalStatus: model.Rejected, Guests: 1, Entourage: 6, ExpectedEntourage: 2},
+			{ArrivalStatus: model.NoShow, Guests: 2, Entourage: 3},
+		}, nil).
+		Times(1)
+
+	rs := NewDefaultReportService(mockRepository)
+
+	report, err := rs.GetAttendanceReport(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 10, report.Guests)
+	assert.Equal(t, 6, report.TurnedUp)
+	assert.Equal(t, 2, report.NoShows)
+	assert.Equal(t, 0.1667, report.RejectionRate)
+	assert.Equal(t, 0.25, report.NoShowRate)
+	assert.Equal(t, 1.5, report.AverageExpectedEntourage)
+	assert.Equal(t, 2.5, report.AverageEntourage)
+	assert.Equal(t, 1.0, report.AverageEntourageDeviation)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Number of random bytes in an invitation token, hex encoded to 32 characters.
+const invitationTokenBytes = 16
+
+/*
+The `DefaultRSVPService` lets guests answer their invitation before the event.
+
+Guests that accept or tentatively accept keep their seats, so the seats they ask
+for are rechecked against the free seats of their table, leaving out the last
+`vipReserveSeats` unless the guest is tagged as VIP. Declined guests release
+their seats, which are no longer counted as used by the table, and signal them as
+freed so the waitlist can offer them right away.
+*/
+type DefaultRSVPService struct {
+	rsvpRepository  repository.IRSVPRepository
+	tableService    IEventTableService
+	vipReserveSeats int
+	seatsFreed      *SeatsFreedSignal
+	logger          *slog.Logger
+}
+
+func NewDefaultRSVPService(rRepo repository.IRSVPRepository, tService IEventTableService, vipReserveSeats int, seatsFreed *SeatsFreedSignal, logger *slog.Logger) *DefaultRSVPService {
+	return &DefaultRSVPService{
+		rsvpRepository:  rRepo,
+		tableService:    tService,
+		vipReserveSeats: vipReserveSeats,
+		seatsFreed:      seatsFreed,
+		logger:          logger,
+	}
+}
+
+/*
+`NewInvitationToken` generates a random token for the invitation link of a guest.
+*/
+func NewInvitationToken() (string, error) {
+	b := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (d *DefaultRSVPService) GetInvitation(ctx context.Context, token string) (*model.Invitation, error) {
+	ctx, span := tracer.Start(ctx, "DefaultRSVPService.GetInvitation")
+	defer span.End()
+
+	invitation, err := d.rsvpRepository.GetInvitation(ctx, token)
+	return invitation, tracing.RecordError(span, err)
+}
+
+/**
+ * Stores the answer of a guest to their invitation along with the entourage they
+ * will actually bring. Answers are only accepted before the guest arrives.
+ * When accepting, the table must have room for the seats the guest doesn't hold
+ * yet outside the seats held back for VIPs, otherwise an ExceedsCapacity error is returned.
+ *
+ * @param  token     token of the invitation link
+ * @param  response  pointer to RSVPResponse with the answer and entourage
+ * @return           pointer to the updated Invitation
+ */
+func (d *DefaultRSVPService) Respond(ctx context.Context, token string, response *model.RSVPResponse) (*model.Invitation, error) {
+	ctx, span := tracer.Start(ctx, "DefaultRSVPService.Respond")
+	defer span.End()
+
+	// Check answer is one a guest can give
+	switch response.Status {
+	case model.Accepted, model.Declined, model.Tentative:
+	default:
+		return nil, tracing.RecordError(span, e.NewBadInputError(string(response.Status)))
+	}
+
+	// Check entourage is a valid number
+	err := e.ValidatePositiveInput(response.Accompanying_guests)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	invitation, err := d.rsvpRepository.GetInvitation(ctx, token)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	if invitation.ArrivalStatus != model.NotArrived {
+		return nil, tracing.RecordError(span, e.NewArrivalStatusError("Guest can't answer the invitation after arriving"))
+	}
+
+	if response.Status != model.Declined {
+		// seats already held by the guest are counted as used by the table
+		held := invitation.Accompanying_guests + 1
+		if invitation.RSVPStatus == model.Declined {
+			held = 0
+		}
+		needed := (response.Accompanying_guests + 1) - held
+
+		free, err := d.tableService.GetEmptySeatsAtTable(ctx, invitation.Table)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		free = seatsOutsideReserve(free, d.vipReserveSeats, invitation.VIP)
+
+		// No room at table
+		if free < needed {
+			return nil, tracing.RecordError(span, e.NewExceedsCapacityError(free, needed-free))
+		}
+	}
+
+	// a decline, or a smaller entourage, gives seats back to the table
+	freesSeats := invitation.RSVPStatus != model.Declined &&
+		(response.Status == model.Declined || response.Accompanying_guests < invitation.Accompanying_guests)
+
+	invitation.RSVPStatus = response.Status
+	invitation.Accompanying_guests = response.Accompanying_guests
+
+	span.SetAttributes(attribute.String("guest.rsvp_status", string(invitation.RSVPStatus)))
+
+	d.logger.InfoContext(ctx, "Updating guest RSVP.",
+		"guest_id", invitation.GuestID,
+		logging.GuestName(invitation.Name),
+		"rsvp_status", invitation.RSVPStatus,
+		"entourage", invitation.Accompanying_guests,
+	)
+
+	err = d.rsvpRepository.UpdateRSVP(ctx, invitation)
+	if err != nil {
+		return invitation, tracing.RecordError(span, err)
+	}
+
+	if freesSeats {
+		d.seatsFreed.Notify()
+	}
+
+	return invitation, nil
+}
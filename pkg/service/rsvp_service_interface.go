@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IRSVPService` is an interface that defines the public flow a guest follows
+to answer their invitation through the link that carries their invitation token.
+*/
+type IRSVPService interface {
+	// Retrieves the invitation of the guest with the given token.
+	GetInvitation(ctx context.Context, token string) (*model.Invitation, error)
+	// Accepts, declines or tentatively accepts the invitation with the given token.
+	Respond(ctx context.Context, token string, response *model.RSVPResponse) (*model.Invitation, error)
+}
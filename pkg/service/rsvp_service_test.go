@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultRSVPService_Respond(t *testing.T) {
+	token := "0123456789abcdef0123456789abcdef"
+
+	invitation := func(status model.RSVPStatus) *model.Invitation {
+		return &model.Invitation{
+			GuestID:             1,
+			Name:                "Flor",
+			Table:               2,
+			Accompanying_guests: 2,
+			ArrivalStatus:       model.NotArrived,
+			RSVPStatus:          status,
+			Version:             1,
+		}
+	}
+
+	t.Run("Return_BadInput_When_Status_Is_Invited", func(t *testing.T) {
+		ms := NewDefaultRSVPService(nil, nil, 0, nil, logging.NewNop())
+
+		_, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Invited})
+		assert.Equal(t, err.Error(), ex.NewBadInputError("invited").Error())
+	})
+
+	t.Run("Return_NotFound_When_Token_Is_Unknown", func(t *testing.T) {
+		errNotFound := ex.NewNotFoundError(token, "token", "invitation")
+
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(&model.Invitation{}, errNotFound).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, nil, 0, nil, logging.NewNop())
+
+		_, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Accepted})
+		assert.Equal(t, err.Error(), errNotFound.Error())
+	})
+
+	t.Run("Return_ArrivalStatus_When_Guest_Arrived", func(t *testing.T) {
+		arrived := invitation(model.Accepted)
+		arrived.ArrivalStatus = model.Arrived
+
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(arrived, nil).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, nil, 0, nil, logging.NewNop())
+
+		_, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Declined})
+		assert.Equal(t, err.Error(), ex.NewArrivalStatusError("Guest can't answer the invitation after arriving").Error())
+	})
+
+	t.Run("Return_CapacityError_When_Entourage_Grows_Beyond_Free_Seats", func(t *testing.T) {
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(invitation(model.Invited), nil).
+			Times(1)
+
+		mockTableService := NewMockIEventTableService(gomock.NewController(t))
+		mockTableService.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), 2).
+			Return(1, nil).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, mockTableService, 0, nil, logging.NewNop())
+
+		// 2 more guests than expected with a single free seat
+		_, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Accepted, Accompanying_guests: 4})
+		assert.Equal(t, err.Error(), ex.NewExceedsCapacityError(1, 1).Error())
+	})
+
+	t.Run("Return_CapacityError_When_Declined_Guest_Accepts_Without_Room", func(t *testing.T) {
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(invitation(model.Declined), nil).
+			Times(1)
+
+		mockTableService := NewMockIEventTableService(gomock.NewController(t))
+		mockTableService.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), 2).
+			Return(2, nil).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, mockTableService, 0, nil, logging.NewNop())
+
+		// declined guests hold no seats, so the guest and entourage need 3
+		_, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Accepted, Accompanying_guests: 2})
+		assert.Equal(t, err.Error(), ex.NewExceedsCapacityError(2, 1).Error())
+	})
+
+	t.Run("Return_CapacityError_When_Entourage_Needs_Seats_Held_For_VIPs", func(t *testing.T) {
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(invitation(model.Invited), nil).
+			Times(1)
+
+		mockTableService := NewMockIEventTableService(gomock.NewController(t))
+		mockTableService.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), 2).
+			Return(3, nil).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, mockTableService, 2, nil, logging.NewNop())
+
+		// 2 more guests than expected with 3 free seats, 2 of them held back for VIPs
+		_, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Accepted, Accompanying_guests: 4})
+		assert.Equal(t, err.Error(), ex.NewExceedsCapacityError(1, 1).Error())
+	})
+
+	t.Run("Return_Success_When_VIP_Takes_Seats_Held_For_VIPs", func(t *testing.T) {
+		vip := invitation(model.Invited)
+		vip.VIP = true
+
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(vip, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			UpdateRSVP(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		mockTableService := NewMockIEventTableService(gomock.NewController(t))
+		mockTableService.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), 2).
+			Return(2, nil).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, mockTableService, 2, nil, logging.NewNop())
+
+		result, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Accepted, Accompanying_guests: 4})
+		assert.Nil(t, err)
+		assert.Equal(t, model.Accepted, result.RSVPStatus)
+	})
+
+	t.Run("Return_Success_When_Declining_Without_Capacity_Check", func(t *testing.T) {
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(invitation(model.Accepted), nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			UpdateRSVP(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		ms := NewDefaultRSVPService(mockRepository, nil, 0, seatsFreed, logging.NewNop())
+
+		result, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Declined})
+		assert.Nil(t, err)
+		assert.Equal(t, model.Declined, result.RSVPStatus)
+		assert.Equal(t, 0, result.Accompanying_guests)
+		assert.Len(t, seatsFreed.C(), 1)
+	})
+
+	t.Run("Return_Success_When_Entourage_Fits", func(t *testing.T) {
+		mockRepository := repository.NewMockIRSVPRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetInvitation(gomock.Any(), token).
+			Return(invitation(model.Invited), nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			UpdateRSVP(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		mockTableService := NewMockIEventTableService(gomock.NewController(t))
+		mockTableService.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), 2).
+			Return(1, nil).
+			Times(1)
+
+		ms := NewDefaultRSVPService(mockRepository, mockTableService, 0, nil, logging.NewNop())
+
+		result, err := ms.Respond(context.Background(), token, &model.RSVPResponse{Status: model.Tentative, Accompanying_guests: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, model.Tentative, result.RSVPStatus)
+		assert.Equal(t, 3, result.Accompanying_guests)
+	})
+}
@@ -0,0 +1,32 @@
+package service
+
+/*
+The `SeatsFreedSignal` tells the waitlist that seats may have freed up, so the parties
+waiting can be offered them without polling the tables.
+
+Notifying never blocks: signals sent while one is already pending are merged into it.
+A nil signal is valid and ignores notifications.
+*/
+type SeatsFreedSignal struct {
+	c chan struct{}
+}
+
+func NewSeatsFreedSignal() *SeatsFreedSignal {
+	return &SeatsFreedSignal{c: make(chan struct{}, 1)}
+}
+
+// Notify tells the listener seats may have freed up.
+func (s *SeatsFreedSignal) Notify() {
+	if s == nil {
+		return
+	}
+	select {
+	case s.c <- struct{}{}:
+	default:
+	}
+}
+
+// C returns the channel that receives a value after seats may have freed up.
+func (s *SeatsFreedSignal) C() <-chan struct{} {
+	return s.c
+}
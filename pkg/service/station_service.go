@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Lower case letters, digits, dashes and underscores, matching the length of the `station` table.
+var stationIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Longest name accepted for a station, matching the `station` table.
+const maxStationNameLength = 100
+
+// Number of changes of the activity of a station returned by default, and at most.
+const (
+	DefaultActivityLimit = 50
+	MaxActivityLimit     = 200
+)
+
+/*
+The `DefaultStationService` manages the stations at the entrances of the event.
+
+The changes of the arrival of the guests are recorded with the station and staff member of the
+request that made them, so a station has to be registered before changes are made at it.
+*/
+type DefaultStationService struct {
+	stationRepository repository.IStationRepository
+	logger            *slog.Logger
+}
+
+func NewDefaultStationService(sRepo repository.IStationRepository, logger *slog.Logger) *DefaultStationService {
+	return &DefaultStationService{
+		stationRepository: sRepo,
+		logger:            logger,
+	}
+}
+
+/**
+ * Retrieves every station with the guests that went through it, and the guests let in
+ * per hour between its first and last arrival. Stations that let guests in for less than
+ * an hour are averaged over an hour, so a handful of arrivals doesn't look like a rush.
+ *
+ * @return  array of StationThroughput, ordered by id
+ */
+func (d *DefaultStationService) GetStations(ctx context.Context) ([]model.StationThroughput, error) {
+	ctx, span := tracer.Start(ctx, "DefaultStationService.GetStations")
+	defer span.End()
+
+	stations, err := d.stationRepository.GetThroughput(ctx)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	for i := range stations {
+		station := &stations[i]
+		if station.FirstArrivalAt == nil || station.LastArrivalAt == nil {
+			continue
+		}
+		first, err := time.Parse(mysqlTimeLayout, *station.FirstArrivalAt)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		last, err := time.Parse(mysqlTimeLayout, *station.LastArrivalAt)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		hours := math.Max(last.Sub(first).Hours(), 1)
+		station.ArrivalsPerHour = math.Round(float64(station.Arrivals)/hours*100) / 100
+	}
+
+	return stations, nil
+}
+
+/**
+ * Registers a new station. The id must be made of lower case letters, digits, dashes and
+ * underscores, and the name defaults to the id.
+ * If a station with the same id exists, returns an AlreadyExists error.
+ *
+ * @param  station  pointer to Station with the id and name of the station
+ * @return          pointer to the created Station
+ */
+func (d *DefaultStationService) CreateStation(ctx context.Context, station *model.Station) (*model.Station, error) {
+	ctx, span := tracer.Start(ctx, "DefaultStationService.CreateStation")
+	defer span.End()
+
+	if err := validateStationID(station.StationID); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	station.Name = strings.TrimSpace(station.Name)
+	if station.Name == "" {
+		station.Name = station.StationID
+	}
+	if len(station.Name) > maxStationNameLength {
+		return nil, tracing.RecordError(span, e.NewBadInputError(fmt.Sprintf("name longer than %d characters", maxStationNameLength)))
+	}
+
+	d.logger.InfoContext(ctx, "Registering station.", "station", station.StationID)
+
+	created, err := d.stationRepository.CreateStation(ctx, station)
+	return created, tracing.RecordError(span, err)
+}
+
+/**
+ * Retrieves the latest guests let in, turned away or out at a station, newest first.
+ * Returns a NotFound error if the station isn't registered.
+ *
+ * @param  id      id of the station
+ * @param  before  only changes with a lower id, to page through older changes, or 0 for the newest
+ * @param  limit   maximum number of changes, between 1 and MaxActivityLimit
+ * @return         array of StationActivity
+ */
+func (d *DefaultStationService) GetActivity(ctx context.Context, id string, before int, limit int) ([]model.StationActivity, error) {
+	ctx, span := tracer.Start(ctx, "DefaultStationService.GetActivity")
+	defer span.End()
+
+	if limit < 1 || limit > MaxActivityLimit {
+		return nil, tracing.RecordError(span, e.NewBadInputError(fmt.Sprintf("limit must be between 1 and %d", MaxActivityLimit)))
+	}
+	if before < 0 {
+		return nil, tracing.RecordError(span, e.NewBadInputError(fmt.Sprint(before)))
+	}
+
+	if _, err := d.stationRepository.GetStation(ctx, id); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	activity, err := d.stationRepository.GetActivity(ctx, id, before, limit)
+	return activity, tracing.RecordError(span, err)
+}
+
+func validateStationID(id string) error {
+	if !stationIDPattern.MatchString(id) {
+		return e.NewBadInputError("station " + id)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IStationService` is an interface that defines methods for managing the stations at the entrances
+of the event, and following the guests that go through each of them.
+*/
+type IStationService interface {
+	// Retrieves every station with the guests that went through it.
+	GetStations(ctx context.Context) ([]model.StationThroughput, error)
+	// Registers a new station.
+	CreateStation(ctx context.Context, station *model.Station) (*model.Station, error)
+	// Retrieves the latest guests let in, turned away or out at a station, older than `before` when it isn't 0.
+	GetActivity(ctx context.Context, id string, before int, limit int) ([]model.StationActivity, error)
+}
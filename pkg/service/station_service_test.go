@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StationService_GetStations(t *testing.T) {
+	first, last := "2024-12-20 21:00:00", "2024-12-20 23:00:00"
+	soon := "2024-12-20 21:10:00"
+
+	mockRepository := repository.NewMockIStationRepository(gomock.NewController(t))
+	mockRepository.
+		EXPECT().
+		GetThroughput(gomock.Any()).
+		Return([]model.StationThroughput{
+			{Station: model.Station{StationID: "north-door"}, Arrivals: 30, FirstArrivalAt: &first, LastArrivalAt: &last},
+			{Station: model.Station{StationID: "south-door"}, Arrivals: 3, FirstArrivalAt: &first, LastArrivalAt: &soon},
+			{Station: model.Station{StationID: "vip-door"}},
+		}, nil).
+		Times(1)
+
+	s := NewDefaultStationService(mockRepository, logging.NewNop())
+
+	stations, err := s.GetStations(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 15.0, stations[0].ArrivalsPerHour)
+	// less than an hour of arrivals is averaged over an hour
+	assert.Equal(t, 3.0, stations[1].ArrivalsPerHour)
+	assert.Equal(t, 0.0, stations[2].ArrivalsPerHour)
+}
+
+func Test_StationService_CreateStation(t *testing.T) {
+	t.Run("Default_Name_To_ID", func(t *testing.T) {
+		mockRepository := repository.NewMockIStationRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			CreateStation(gomock.Any(), &model.Station{StationID: "north-door", Name: "north-door"}).
+			Return(&model.Station{StationID: "north-door", Name: "north-door", CreatedAt: "2024-12-20 18:00:00"}, nil).
+			Times(1)
+
+		s := NewDefaultStationService(mockRepository, logging.NewNop())
+
+		station, err := s.CreateStation(context.Background(), &model.Station{StationID: "north-door", Name: " "})
+		assert.Nil(t, err)
+		assert.Equal(t, "north-door", station.Name)
+	})
+
+	t.Run("Reject_Invalid_ID", func(t *testing.T) {
+		mockRepository := repository.NewMockIStationRepository(gomock.NewController(t))
+
+		s := NewDefaultStationService(mockRepository, logging.NewNop())
+
+		station, err := s.CreateStation(context.Background(), &model.Station{StationID: "North Door"})
+		assert.Nil(t, station)
+		assert.IsType(t, &e.BadInputError{}, err)
+	})
+}
+
+func Test_StationService_GetActivity(t *testing.T) {
+	t.Run("Return_Activity_Of_Station", func(t *testing.T) {
+		activity := []model.StationActivity{{ChangeID: 9, Name: "Flor", ArrivalStatus: model.Arrived, Staff: "Juli"}}
+		mockRepository := repository.NewMockIStationRepository(gomock.NewController(t))
+		gomock.InOrder(
+			mockRepository.EXPECT().GetStation(gomock.Any(), "north-door").Return(&model.Station{StationID: "north-door"}, nil),
+			mockRepository.EXPECT().GetActivity(gomock.Any(), "north-door", 12, 20).Return(activity, nil),
+		)
+
+		s := NewDefaultStationService(mockRepository, logging.NewNop())
+
+		returned, err := s.GetActivity(context.Background(), "north-door", 12, 20)
+		assert.Nil(t, err)
+		assert.Equal(t, activity, returned)
+	})
+
+	t.Run("Fail_When_Station_Not_Found", func(t *testing.T) {
+		mockRepository := repository.NewMockIStationRepository(gomock.NewController(t))
+		mockRepository.EXPECT().GetStation(gomock.Any(), "west-door").Return(nil, e.NewNotFoundError("west-door", "id", "station")).Times(1)
+		mockRepository.EXPECT().GetActivity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		s := NewDefaultStationService(mockRepository, logging.NewNop())
+
+		returned, err := s.GetActivity(context.Background(), "west-door", 0, DefaultActivityLimit)
+		assert.Nil(t, returned)
+		assert.IsType(t, &e.NotFoundError{}, err)
+	})
+
+	t.Run("Reject_Invalid_Limit", func(t *testing.T) {
+		mockRepository := repository.NewMockIStationRepository(gomock.NewController(t))
+
+		s := NewDefaultStationService(mockRepository, logging.NewNop())
+
+		returned, err := s.GetActivity(context.Background(), "north-door", 0, MaxActivityLimit+1)
+		assert.Nil(t, returned)
+		assert.IsType(t, &e.BadInputError{}, err)
+	})
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Maximum number of operations synced by a single call to ApplyOperations.
+const MaxSyncBatch = 200
+
+// Layout of the times recorded by the door stations, as MySQL prints them.
+const recordedAtLayout = "2006-01-02 15:04:05"
+
+// Longest staff member accepted for an operation, matching the `guest_status_change` table.
+const maxStaffLength = 100
+
+// Operation ids are the UUIDs MySQL generates at the door station, as stored in `sync_operation`.
+var validOperationID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+/*
+The `DefaultSyncService` applies the check-ins recorded by the door stations while they were offline.
+
+The door stations already let the guests in or out, so their operations are applied even when the
+central instance would have decided otherwise, and the disagreements are reported as conflicts for
+review. The rules only look at the guest and the operation, so an operation always resolves the same
+way given the same state:
+  - An arrival of a guest that already arrived, like at another door, keeps the earliest arrival time
+    and the largest entourage, so the guest ends up the same whichever door syncs first.
+  - An arrival that doesn't fit in the free seats of the table is applied anyway and reported as overbooked.
+  - A departure of a guest that already left is a duplicate, and one of a guest that never arrived is reported.
+  - An operation of a guest that doesn't exist is reported.
+
+When guests leave, `seatsFreed` is notified so the waitlist can offer their seats.
+*/
+type DefaultSyncService struct {
+	syncRepository repository.ISyncRepository
+	seatsFreed     *SeatsFreedSignal
+	logger         *slog.Logger
+}
+
+func NewDefaultSyncService(sRepo repository.ISyncRepository, seatsFreed *SeatsFreedSignal, logger *slog.Logger) *DefaultSyncService {
+	return &DefaultSyncService{
+		syncRepository: sRepo,
+		seatsFreed:     seatsFreed,
+		logger:         logger,
+	}
+}
+
+/**
+ * Applies the operations of a door station in a single transaction, in the order given, resolving
+ * their conflicts with the rules of the service. Operations the door station synced before keep
+ * the outcome they had. The operations are validated first: the batch can't be empty or larger
+ * than MaxSyncBatch, and every operation needs a UUID as its id, a guest, a known kind, a valid
+ * entourage and the time it was recorded. The station must be a registered station, otherwise a
+ * BadInput error is returned.
+ *
+ * @param  station     door station that recorded the operations
+ * @param  operations  operations of the door station, in the order they were recorded
+ * @return             pointer to the SyncReport with the outcome of each operation
+ */
+func (d *DefaultSyncService) ApplyOperations(ctx context.Context, station string, operations []model.DoorOperation) (*model.SyncReport, error) {
+	ctx, span := tracer.Start(ctx, "DefaultSyncService.ApplyOperations")
+	defer span.End()
+
+	if err := validateOperations(station, operations); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	results, err := d.syncRepository.ApplyOperations(ctx, station, operations, resolveOperation)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	report := &model.SyncReport{Results: results}
+	freed := false
+	for i, result := range results {
+		switch result.Result {
+		case model.SyncApplied:
+			report.Applied++
+			freed = freed || operations[i].Kind == model.LeaveOperation
+		case model.SyncDuplicate:
+			report.Duplicates++
+		case model.SyncConflicted:
+			report.Conflicts++
+			d.logger.WarnContext(ctx, "Door operation conflicted.",
+				"station", station,
+				"operation_id", result.OperationID,
+				logging.GuestName(operations[i].Name),
+				"conflict", result.Conflict,
+			)
+		}
+	}
+
+	span.SetAttributes(
+		attribute.String("sync.station", station),
+		attribute.Int("sync.size", len(operations)),
+		attribute.Int("sync.conflicts", report.Conflicts),
+	)
+
+	d.logger.InfoContext(ctx, "Synced door operations.",
+		"station", station,
+		"size", len(operations),
+		"applied", report.Applied,
+		"duplicates", report.Duplicates,
+		"conflicts", report.Conflicts,
+	)
+
+	if freed {
+		d.seatsFreed.Notify()
+	}
+
+	return report, nil
+}
+
+func validateOperations(station string, operations []model.DoorOperation) error {
+	if err := validateStationID(station); err != nil {
+		return err
+	}
+	if len(operations) == 0 {
+		return e.NewBadInputError("no operations to sync")
+	}
+	if len(operations) > MaxSyncBatch {
+		return e.NewBadInputError(fmt.Sprintf("more than %d operations to sync", MaxSyncBatch))
+	}
+
+	for i, operation := range operations {
+		if err := e.ValidatePositiveInput(operation.Accompanying_guests); err != nil {
+			return err
+		}
+		if !validOperationID.MatchString(operation.OperationID) {
+			return e.NewBadInputError(fmt.Sprintf("operations[%d] has an invalid operation_id", i))
+		}
+		if operation.Name == "" {
+			return e.NewBadInputError(fmt.Sprintf("operations[%d] has no name", i))
+		}
+		if len(operation.Staff) > maxStaffLength {
+			return e.NewBadInputError(fmt.Sprintf("operations[%d] has a staff longer than %d characters", i, maxStaffLength))
+		}
+		if operation.Kind != model.ArriveOperation && operation.Kind != model.LeaveOperation {
+			return e.NewBadInputError(fmt.Sprintf("operations[%d] has an unknown kind %q", i, operation.Kind))
+		}
+		if _, err := time.Parse(recordedAtLayout, operation.RecordedAt); err != nil {
+			return e.NewBadInputError(fmt.Sprintf("operations[%d] has an invalid recorded_at", i))
+		}
+	}
+	return nil
+}
+
+/*
+`resolveOperation` applies an operation of a door station to the guest with the rules of DefaultSyncService,
+given the free seats left at their table, and returns its outcome.
+*/
+func resolveOperation(guest *model.Guest, operation model.DoorOperation, freeSeats int) model.SyncResult {
+	result := model.SyncResult{OperationID: operation.OperationID, Result: model.SyncApplied}
+	conflict := func(kind model.ConflictKind, detail string) model.SyncResult {
+		result.Result = model.SyncConflicted
+		result.Conflict = kind
+		result.Detail = detail
+		return result
+	}
+
+	if guest == nil {
+		return conflict(model.GuestNotFoundConflict, fmt.Sprintf("There is no guest named %s", operation.Name))
+	}
+
+	if operation.Kind == model.LeaveOperation {
+		switch guest.ArrivalStatus {
+		case model.Arrived:
+			guest.ArrivalStatus = model.Left
+			return result
+		case model.Left:
+			result.Result = model.SyncDuplicate
+			return result
+		default:
+			return conflict(model.NotArrivedConflict, fmt.Sprintf("Guest left but is %s at the central instance", guest.ArrivalStatus))
+		}
+	}
+
+	// arrived at another door too: both doors agree the guest is in, only the details differ
+	if guest.ArrivalStatus == model.Arrived {
+		detail := fmt.Sprintf("Guest already arrived at %s with %d accompanying guests, the door let them in at %s with %d",
+			guest.ArrivalTime(), guest.Entourage, operation.RecordedAt, operation.Accompanying_guests)
+		if operation.RecordedAt < guest.ArrivalTime() {
+			guest.ArrivedAt = operation.RecordedAt
+		}
+		if operation.Accompanying_guests > guest.Entourage {
+			guest.Entourage = operation.Accompanying_guests
+		}
+		return conflict(model.DuplicateArrivalConflict, detail)
+	}
+
+	// seats the guest needs beyond those they already hold
+	needed := operation.Accompanying_guests + 1
+	if holdsSeats(guest.ArrivalStatus) && !(guest.ArrivalStatus == model.NotArrived && guest.RSVPStatus == model.Declined) {
+		needed -= guest.Entourage + 1
+	}
+
+	guest.ArrivalStatus = model.Arrived
+	guest.Entourage = operation.Accompanying_guests
+	guest.ArrivedAt = operation.RecordedAt
+
+	if needed > freeSeats {
+		return conflict(model.TableOverbookedConflict, fmt.Sprintf("Guest arrived with %d accompanying guests but their table only had %d free seats for %d more people",
+			operation.Accompanying_guests, max(freeSeats, 0), needed))
+	}
+	return result
+}
+
+/**
+ * Retrieves the operations of the door stations that conflicted with the central instance,
+ * in the order they were synced.
+ *
+ * @param  station  door station to filter by, or empty for all of them
+ * @return          array of SyncConflict
+ */
+func (d *DefaultSyncService) GetConflicts(ctx context.Context, station string) ([]model.SyncConflict, error) {
+	ctx, span := tracer.Start(ctx, "DefaultSyncService.GetConflicts")
+	defer span.End()
+
+	conflicts, err := d.syncRepository.GetConflicts(ctx, station)
+	return conflicts, tracing.RecordError(span, err)
+}
+
+/**
+ * Retrieves the tables and guests of the event as of a single point in time.
+ *
+ * @return  pointer to the Snapshot
+ */
+func (d *DefaultSyncService) GetSnapshot(ctx context.Context) (*model.Snapshot, error) {
+	ctx, span := tracer.Start(ctx, "DefaultSyncService.GetSnapshot")
+	defer span.End()
+
+	snapshot, err := d.syncRepository.GetSnapshot(ctx)
+	return snapshot, tracing.RecordError(span, err)
+}
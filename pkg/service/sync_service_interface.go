@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `ISyncService` is an interface that defines how the central instance syncs with the door stations,
+applying the check-ins they recorded and serving them the state they work from while offline.
+*/
+type ISyncService interface {
+	// Applies the operations of a door station, resolving their conflicts with the central instance.
+	ApplyOperations(ctx context.Context, station string, operations []model.DoorOperation) (*model.SyncReport, error)
+	// Retrieves the operations that conflicted for review, only those of the door station when one is given.
+	GetConflicts(ctx context.Context, station string) ([]model.SyncConflict, error)
+	// Retrieves the tables and guests the door stations copy.
+	GetSnapshot(ctx context.Context) (*model.Snapshot, error)
+}
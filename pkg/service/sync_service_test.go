@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SyncService_ApplyOperations(t *testing.T) {
+	arrive := model.DoorOperation{OperationID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Name: "Flor", Kind: model.ArriveOperation, Accompanying_guests: 2, RecordedAt: "2024-12-20 21:05:00"}
+	leave := model.DoorOperation{OperationID: "6ba7b811-9dad-11d1-80b4-00c04fd430c8", Name: "Nico", Kind: model.LeaveOperation, RecordedAt: "2024-12-20 23:40:00"}
+
+	t.Run("Reject_Invalid_Operations", func(t *testing.T) {
+		noID, changeID, noName, unknownKind, badTime := arrive, arrive, arrive, arrive, arrive
+		noID.OperationID = ""
+		changeID.OperationID = "12"
+		noName.Name = ""
+		unknownKind.Kind = "wave"
+		badTime.RecordedAt = "21:05"
+
+		for name, tc := range map[string]struct {
+			station    string
+			operations []model.DoorOperation
+		}{
+			"No_Station":   {"", []model.DoorOperation{arrive}},
+			"Empty_Batch":  {"north-door", nil},
+			"No_ID":        {"north-door", []model.DoorOperation{noID}},
+			"Change_ID":    {"north-door", []model.DoorOperation{changeID}},
+			"No_Name":      {"north-door", []model.DoorOperation{noName}},
+			"Unknown_Kind": {"north-door", []model.DoorOperation{unknownKind}},
+			"Bad_Time":     {"north-door", []model.DoorOperation{badTime}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				mockRepository := repository.NewMockISyncRepository(gomock.NewController(t))
+
+				s := NewDefaultSyncService(mockRepository, nil, logging.NewNop())
+
+				report, err := s.ApplyOperations(context.Background(), tc.station, tc.operations)
+				assert.Nil(t, report)
+				assert.IsType(t, &e.BadInputError{}, err)
+			})
+		}
+	})
+
+	t.Run("Report_Results_And_Free_Seats", func(t *testing.T) {
+		results := []model.SyncResult{
+			{OperationID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Result: model.SyncConflicted, Conflict: model.TableOverbookedConflict},
+			{OperationID: "6ba7b811-9dad-11d1-80b4-00c04fd430c8", Result: model.SyncApplied},
+		}
+		mockRepository := repository.NewMockISyncRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ApplyOperations(gomock.Any(), "north-door", []model.DoorOperation{arrive, leave}, gomock.Any()).
+			Return(results, nil).
+			Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		s := NewDefaultSyncService(mockRepository, seatsFreed, logging.NewNop())
+
+		report, err := s.ApplyOperations(context.Background(), "north-door", []model.DoorOperation{arrive, leave})
+		assert.Nil(t, err)
+		assert.Equal(t, &model.SyncReport{Results: results, Applied: 1, Conflicts: 1}, report)
+		assert.Len(t, seatsFreed.C(), 1)
+	})
+
+	t.Run("Fail_When_Repository_Errors", func(t *testing.T) {
+		mockRepository := repository.NewMockISyncRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			ApplyOperations(gomock.Any(), "north-door", gomock.Any(), gomock.Any()).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		s := NewDefaultSyncService(mockRepository, NewSeatsFreedSignal(), logging.NewNop())
+
+		report, err := s.ApplyOperations(context.Background(), "north-door", []model.DoorOperation{arrive})
+		assert.Nil(t, report)
+		assert.Equal(t, assert.AnError, err)
+	})
+}
+
+func Test_ResolveOperation(t *testing.T) {
+	arrive := model.DoorOperation{OperationID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Name: "Flor", Kind: model.ArriveOperation, Accompanying_guests: 2, RecordedAt: "2024-12-20 21:05:00"}
+	leave := model.DoorOperation{OperationID: "6ba7b811-9dad-11d1-80b4-00c04fd430c8", Name: "Flor", Kind: model.LeaveOperation, RecordedAt: "2024-12-20 23:40:00"}
+
+	t.Run("Guest_Not_Found", func(t *testing.T) {
+		result := resolveOperation(nil, arrive, 10)
+		assert.Equal(t, model.SyncConflicted, result.Result)
+		assert.Equal(t, model.GuestNotFoundConflict, result.Conflict)
+	})
+
+	t.Run("Arrive", func(t *testing.T) {
+		guest := &model.Guest{Name: "Flor", Entourage: 1, ArrivalStatus: model.NotArrived}
+
+		// the guest already holds two seats, so only one more is needed
+		result := resolveOperation(guest, arrive, 1)
+		assert.Equal(t, model.SyncResult{OperationID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Result: model.SyncApplied}, result)
+		assert.EqualValues(t, model.Arrived, guest.ArrivalStatus)
+		assert.Equal(t, 2, guest.Entourage)
+		assert.Equal(t, "2024-12-20 21:05:00", guest.ArrivalTime())
+	})
+
+	t.Run("Arrive_Overbooked_Is_Applied", func(t *testing.T) {
+		guest := &model.Guest{Name: "Flor", ArrivalStatus: model.Rejected}
+
+		result := resolveOperation(guest, arrive, 2)
+		assert.Equal(t, model.SyncConflicted, result.Result)
+		assert.Equal(t, model.TableOverbookedConflict, result.Conflict)
+		assert.EqualValues(t, model.Arrived, guest.ArrivalStatus)
+		assert.Equal(t, 2, guest.Entourage)
+	})
+
+	t.Run("Arrive_At_Two_Doors_Keeps_Earliest_And_Largest", func(t *testing.T) {
+		guest := &model.Guest{Name: "Flor", Entourage: 1, ArrivalStatus: model.Arrived, ArrivedAt: []byte("2024-12-20 21:10:00")}
+
+		result := resolveOperation(guest, arrive, 0)
+		assert.Equal(t, model.SyncConflicted, result.Result)
+		assert.Equal(t, model.DuplicateArrivalConflict, result.Conflict)
+		assert.Equal(t, "2024-12-20 21:05:00", guest.ArrivalTime())
+		assert.Equal(t, 2, guest.Entourage)
+
+		// the same arrival synced by the other door ends up the same
+		other := arrive
+		other.RecordedAt = "2024-12-20 21:10:00"
+		other.Accompanying_guests = 1
+		resolveOperation(guest, other, 0)
+		assert.Equal(t, "2024-12-20 21:05:00", guest.ArrivalTime())
+		assert.Equal(t, 2, guest.Entourage)
+	})
+
+	t.Run("Leave", func(t *testing.T) {
+		guest := &model.Guest{Name: "Flor", ArrivalStatus: model.Arrived}
+
+		result := resolveOperation(guest, leave, 0)
+		assert.Equal(t, model.SyncApplied, result.Result)
+		assert.EqualValues(t, model.Left, guest.ArrivalStatus)
+	})
+
+	t.Run("Leave_Twice_Is_Duplicate", func(t *testing.T) {
+		guest := &model.Guest{Name: "Flor", ArrivalStatus: model.Left}
+
+		result := resolveOperation(guest, leave, 0)
+		assert.Equal(t, model.SyncDuplicate, result.Result)
+	})
+
+	t.Run("Leave_Without_Arriving", func(t *testing.T) {
+		guest := &model.Guest{Name: "Flor", ArrivalStatus: model.NotArrived}
+
+		result := resolveOperation(guest, leave, 0)
+		assert.Equal(t, model.NotArrivedConflict, result.Conflict)
+		assert.EqualValues(t, model.NotArrived, guest.ArrivalStatus)
+	})
+}
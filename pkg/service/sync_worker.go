@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/central"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+The `SyncWorker` keeps a door station in sync with the central instance in the background.
+
+The door station checks guests in against its own database, so it keeps working while the
+central instance can't be reached. Every poll sends the operations of the door station that
+weren't synced yet, oldest first, and then replaces its copy of the tables and guests with the
+snapshot of the central instance, so the door sees who arrived at the other doors. While the
+central instance is unreachable the operations stay in the operation log, and are sent on the
+first poll after it is reachable again.
+*/
+type SyncWorker struct {
+	syncRepository repository.ISyncRepository
+	central        central.IClient
+	station        string
+	interval       time.Duration
+	logger         *slog.Logger
+}
+
+func NewSyncWorker(sRepo repository.ISyncRepository, client central.IClient, station string, interval time.Duration, logger *slog.Logger) *SyncWorker {
+	return &SyncWorker{
+		syncRepository: sRepo,
+		central:        client,
+		station:        station,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+/*
+`Run` syncs right away and then every interval until ctx is done.
+*/
+func (w *SyncWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Sync(ctx); err != nil && ctx.Err() == nil {
+			w.logger.WarnContext(ctx, "Failed to sync with the central instance, check-ins stay in the operation log.", "station", w.station, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+/**
+ * Sends the operations not synced yet to the central instance, in batches of MaxSyncBatch,
+ * marking them as synced once it answers. Then replaces the tables and guests with the
+ * snapshot of the central instance. Nothing is replaced until every operation was sent.
+ *
+ * @return  number of operations synced
+ */
+func (w *SyncWorker) Sync(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "SyncWorker.Sync")
+	defer span.End()
+
+	synced := 0
+	for {
+		operations, err := w.syncRepository.GetPendingOperations(ctx, MaxSyncBatch)
+		if err != nil {
+			return synced, tracing.RecordError(span, err)
+		}
+		if len(operations) == 0 {
+			break
+		}
+
+		report, err := w.central.PushOperations(ctx, w.station, operations)
+		if err != nil {
+			return synced, tracing.RecordError(span, err)
+		}
+
+		ids := make([]string, len(operations))
+		for i, operation := range operations {
+			ids[i] = operation.OperationID
+		}
+		if err := w.syncRepository.MarkOperationsSynced(ctx, ids); err != nil {
+			return synced, tracing.RecordError(span, err)
+		}
+		synced += len(operations)
+
+		w.logger.InfoContext(ctx, "Sent door operations to the central instance.",
+			"station", w.station,
+			"operations", len(operations),
+			"applied", report.Applied,
+			"duplicates", report.Duplicates,
+			"conflicts", report.Conflicts,
+		)
+	}
+
+	snapshot, err := w.central.GetSnapshot(ctx)
+	if err != nil {
+		return synced, tracing.RecordError(span, err)
+	}
+
+	skipped, err := w.syncRepository.ApplySnapshot(ctx, snapshot)
+	if err != nil {
+		return synced, tracing.RecordError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.Int("sync.operations", synced),
+		attribute.Int("sync.guests", len(snapshot.Guests)),
+	)
+
+	w.logger.DebugContext(ctx, "Copied the snapshot of the central instance.",
+		"station", w.station,
+		"tables", len(snapshot.Tables),
+		"guests", len(snapshot.Guests),
+		"guests_pending", skipped,
+	)
+
+	return synced, nil
+}
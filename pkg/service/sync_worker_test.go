@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/central"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SyncWorker_Sync(t *testing.T) {
+	operations := []model.DoorOperation{
+		{OperationID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Name: "Flor", Kind: model.ArriveOperation, Accompanying_guests: 2, RecordedAt: "2024-12-20 21:05:00"},
+		{OperationID: "6ba7b811-9dad-11d1-80b4-00c04fd430c8", Name: "Nico", Kind: model.LeaveOperation, RecordedAt: "2024-12-20 23:40:00"},
+	}
+	snapshot := &model.Snapshot{Tables: []model.EventTable{{TableID: 1, Capacity: 10}}}
+
+	t.Run("Push_Operations_Then_Copy_Snapshot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepository := repository.NewMockISyncRepository(ctrl)
+		mockClient := central.NewMockIClient(ctrl)
+		gomock.InOrder(
+			mockRepository.EXPECT().GetPendingOperations(gomock.Any(), MaxSyncBatch).Return(operations, nil),
+			mockClient.EXPECT().PushOperations(gomock.Any(), "north-door", operations).Return(&model.SyncReport{Applied: 2}, nil),
+			mockRepository.EXPECT().MarkOperationsSynced(gomock.Any(), []string{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6ba7b811-9dad-11d1-80b4-00c04fd430c8"}).Return(nil),
+			mockRepository.EXPECT().GetPendingOperations(gomock.Any(), MaxSyncBatch).Return(nil, nil),
+			mockClient.EXPECT().GetSnapshot(gomock.Any()).Return(snapshot, nil),
+			mockRepository.EXPECT().ApplySnapshot(gomock.Any(), snapshot).Return(0, nil),
+		)
+
+		w := NewSyncWorker(mockRepository, mockClient, "north-door", time.Minute, logging.NewNop())
+
+		synced, err := w.Sync(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, synced)
+	})
+
+	t.Run("Keep_Operations_While_Offline", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepository := repository.NewMockISyncRepository(ctrl)
+		mockClient := central.NewMockIClient(ctrl)
+		mockRepository.EXPECT().GetPendingOperations(gomock.Any(), MaxSyncBatch).Return(operations, nil).Times(1)
+		mockClient.EXPECT().PushOperations(gomock.Any(), "north-door", operations).Return(nil, assert.AnError).Times(1)
+		mockRepository.EXPECT().MarkOperationsSynced(gomock.Any(), gomock.Any()).Times(0)
+		mockRepository.EXPECT().ApplySnapshot(gomock.Any(), gomock.Any()).Times(0)
+
+		w := NewSyncWorker(mockRepository, mockClient, "north-door", time.Minute, logging.NewNop())
+
+		synced, err := w.Sync(context.Background())
+		assert.Equal(t, assert.AnError, err)
+		assert.Equal(t, 0, synced)
+	})
+}
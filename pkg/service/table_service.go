@@ -1,8 +1,13 @@
 package service
 
 import (
+	"context"
+	"fmt"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
 	"github.com/fpetrikovich/go-guestlist/pkg/model"
 	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
 )
 
 /*
@@ -14,37 +19,143 @@ The code provides functions for retrieving information about event tables
 also for creating and deleting event tables (e.g. `CreateTable(*model.EventTable)`, `DeleteTable(id int)`).
 
 The functions interact with the IEventTableRepository to perform the desired operations.
+Creating a table or raising its capacity notifies `seatsFreed`, so the waitlist can offer the new seats.
 */
 type DefaultEventTableService struct {
 	tableRepository repository.IEventTableRepository
+	seatsFreed      *SeatsFreedSignal
 }
 
-func NewDefaultEventTableService(tRepo repository.IEventTableRepository) *DefaultEventTableService {
+func NewDefaultEventTableService(tRepo repository.IEventTableRepository, seatsFreed *SeatsFreedSignal) *DefaultEventTableService {
 	return &DefaultEventTableService{
 		tableRepository: tRepo,
+		seatsFreed:      seatsFreed,
 	}
 }
 
-func (d *DefaultEventTableService) GetTables() ([]model.EventTable, error) {
-	return d.tableRepository.GetTables()
+func (d *DefaultEventTableService) GetTables(ctx context.Context) ([]model.EventTable, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.GetTables")
+	defer span.End()
+
+	tables, err := d.tableRepository.GetTables(ctx)
+	return tables, tracing.RecordError(span, err)
+}
+
+func (d *DefaultEventTableService) GetTable(ctx context.Context, id int) (*model.EventTable, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.GetTable")
+	defer span.End()
+
+	table, err := d.tableRepository.GetTable(ctx, id)
+	return table, tracing.RecordError(span, err)
+}
+
+func (d *DefaultEventTableService) GetTablesByID(ctx context.Context, ids []int) ([]model.EventTable, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.GetTablesByID")
+	defer span.End()
+
+	tables, err := d.tableRepository.GetTablesByID(ctx, ids)
+	return tables, tracing.RecordError(span, err)
+}
+
+func (d *DefaultEventTableService) CreateTable(ctx context.Context, table *model.EventTable) (*model.EventTable, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.CreateTable")
+	defer span.End()
+
+	table, err := d.tableRepository.CreateTable(ctx, table)
+	if err != nil {
+		return table, tracing.RecordError(span, err)
+	}
+
+	d.seatsFreed.Notify()
+
+	return table, nil
 }
 
-func (d *DefaultEventTableService) GetTable(id int) (*model.EventTable, error) {
-	return d.tableRepository.GetTable(id)
+/**
+ * Changes the capacity of a table. Fails with a PreconditionFailed error if the table is no
+ * longer at the expected version, and with a BadInput error if the new capacity can't seat
+ * the guests already assigned to the table.
+ *
+ * @param  id        id of the event table to update
+ * @param  capacity  new capacity of the table
+ * @param  version   version the client read the table at, or AnyVersion
+ * @return           pointer to the updated EventTable
+ */
+func (d *DefaultEventTableService) UpdateTable(ctx context.Context, id int, capacity int, version int) (*model.EventTable, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.UpdateTable")
+	defer span.End()
+
+	// Check capacity is a valid number
+	err := e.ValidatePositiveInput(capacity)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	table, err := d.tableRepository.GetTable(ctx, id)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	if version != AnyVersion && table.Version != version {
+		return nil, tracing.RecordError(span, e.NewPreconditionFailedError("table", version))
+	}
+
+	// The new capacity must still fit the guests seated at the table
+	free, err := d.tableRepository.GetEmptySeatsAtTable(ctx, id)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	if used := table.Capacity - free; capacity < used {
+		return nil, tracing.RecordError(span, e.NewBadInputError(fmt.Sprintf("capacity %d is below the %d seats in use", capacity, used)))
+	}
+
+	enlarged := capacity > table.Capacity
+	table.Capacity = capacity
+	err = d.tableRepository.UpdateTable(ctx, table)
+	if err != nil {
+		return table, tracing.RecordError(span, err)
+	}
+
+	if enlarged {
+		d.seatsFreed.Notify()
+	}
+
+	return table, nil
 }
 
-func (d *DefaultEventTableService) CreateTable(table *model.EventTable) (*model.EventTable, error) {
-	return d.tableRepository.CreateTable(table)
+/**
+ * Deletes a table of the event. Returns a TableNotEmpty error while guests sat at it still
+ * hold their seats, they have to be moved to another table first.
+ *
+ * @param  id  id of the table to delete
+ */
+func (d *DefaultEventTableService) DeleteTable(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.DeleteTable")
+	defer span.End()
+
+	return tracing.RecordError(span, d.tableRepository.DeleteTable(ctx, id))
 }
 
-func (d *DefaultEventTableService) DeleteTable(id int) error {
-	return nil
+func (d *DefaultEventTableService) GetEmptySeatsAtTable(ctx context.Context, id int) (int, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.GetEmptySeatsAtTable")
+	defer span.End()
+
+	free, err := d.tableRepository.GetEmptySeatsAtTable(ctx, id)
+	return free, tracing.RecordError(span, err)
 }
 
-func (d *DefaultEventTableService) GetEmptySeatsAtTable(id int) (int, error) {
-	return d.tableRepository.GetEmptySeatsAtTable(id)
+func (d *DefaultEventTableService) GetEmptySeatsByTable(ctx context.Context, ids []int) (map[int]int, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.GetEmptySeatsByTable")
+	defer span.End()
+
+	free, err := d.tableRepository.GetEmptySeatsByTable(ctx, ids)
+	return free, tracing.RecordError(span, err)
 }
 
-func (d *DefaultEventTableService) GetEmptySeats() (int, error) {
-	return d.tableRepository.GetEmptySeats()
+func (d *DefaultEventTableService) GetEmptySeats(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "DefaultEventTableService.GetEmptySeats")
+	defer span.End()
+
+	free, err := d.tableRepository.GetEmptySeats(ctx)
+	return free, tracing.RecordError(span, err)
 }
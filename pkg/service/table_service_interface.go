@@ -1,6 +1,10 @@
 package service
 
-import "github.com/fpetrikovich/go-guestlist/pkg/model"
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
 
 /*
 The `IEventTableService` is an interface that defines methods for managing event table data.
@@ -10,15 +14,21 @@ adhering to the same interface.
 */
 type IEventTableService interface {
 	// Retrieves a list of all event tables represented by `[]model.EventTable`.
-	GetTables() ([]model.EventTable, error)
+	GetTables(ctx context.Context) ([]model.EventTable, error)
 	// Retrieves a single event table by id represented by a pointer to `model.EventTable`.
-	GetTable(id int) (*model.EventTable, error)
+	GetTable(ctx context.Context, id int) (*model.EventTable, error)
+	// Retrieves the event tables with any of the given ids in a single lookup, leaving out the ids without a table.
+	GetTablesByID(ctx context.Context, ids []int) ([]model.EventTable, error)
 	// Creates a new event table with parameters represented by `model.EventTable`.
-	CreateTable(table *model.EventTable) (*model.EventTable, error)
+	CreateTable(ctx context.Context, table *model.EventTable) (*model.EventTable, error)
+	// Changes the capacity of an event table, if it is still at the expected version.
+	UpdateTable(ctx context.Context, id int, capacity int, version int) (*model.EventTable, error)
 	// Deletes an event table by id.
-	DeleteTable(id int) error
+	DeleteTable(ctx context.Context, id int) error
 	// Retrieves the number of empty seats at a specific event table.
-	GetEmptySeatsAtTable(id int) (int, error)
+	GetEmptySeatsAtTable(ctx context.Context, id int) (int, error)
+	// Retrieves the number of empty seats at each of the given event tables in a single lookup.
+	GetEmptySeatsByTable(ctx context.Context, ids []int) (map[int]int, error)
 	// Retrieves the total number of empty seats across all event tables.
-	GetEmptySeats() (int, error)
+	GetEmptySeats(ctx context.Context) (int, error)
 }
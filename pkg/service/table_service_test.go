@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultEventTableService_UpdateTable(t *testing.T) {
+	tableID := 1
+
+	t.Run("Return_PreconditionFailed_When_Version_Changed", func(t *testing.T) {
+		mockRepository := repository.NewMockIEventTableRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetTable(gomock.Any(), tableID).
+			Return(&model.EventTable{TableID: tableID, Capacity: 10, Version: 4}, nil).
+			Times(1)
+
+		ts := NewDefaultEventTableService(mockRepository, nil)
+		_, err := ts.UpdateTable(context.Background(), tableID, 12, 3)
+
+		assert.Equal(t, err.Error(), ex.NewPreconditionFailedError("table", 3).Error())
+	})
+
+	t.Run("Return_BadInput_When_Capacity_Below_Seats_In_Use", func(t *testing.T) {
+		mockRepository := repository.NewMockIEventTableRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetTable(gomock.Any(), tableID).
+			Return(&model.EventTable{TableID: tableID, Capacity: 10, Version: 1}, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), tableID).
+			Return(4, nil).
+			Times(1)
+
+		ts := NewDefaultEventTableService(mockRepository, nil)
+		_, err := ts.UpdateTable(context.Background(), tableID, 5, 1)
+
+		assert.Equal(t, err.Error(), ex.NewBadInputError("capacity 5 is below the 6 seats in use").Error())
+	})
+
+	t.Run("Return_Table_When_Updated", func(t *testing.T) {
+		table := &model.EventTable{TableID: tableID, Capacity: 10, Version: 1}
+
+		mockRepository := repository.NewMockIEventTableRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetTable(gomock.Any(), tableID).
+			Return(table, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			GetEmptySeatsAtTable(gomock.Any(), tableID).
+			Return(4, nil).
+			Times(1)
+		mockRepository.
+			EXPECT().
+			UpdateTable(gomock.Any(), table).
+			Return(nil).
+			Times(1)
+
+		ts := NewDefaultEventTableService(mockRepository, nil)
+		updated, err := ts.UpdateTable(context.Background(), tableID, 6, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 6, updated.Capacity)
+	})
+
+	t.Run("Notify_Seats_Freed_Only_When_Enlarged", func(t *testing.T) {
+		for capacity, notified := range map[int]bool{12: true, 8: false} {
+			table := &model.EventTable{TableID: tableID, Capacity: 10, Version: 1}
+
+			mockRepository := repository.NewMockIEventTableRepository(gomock.NewController(t))
+			mockRepository.EXPECT().GetTable(gomock.Any(), tableID).Return(table, nil).Times(1)
+			mockRepository.EXPECT().GetEmptySeatsAtTable(gomock.Any(), tableID).Return(4, nil).Times(1)
+			mockRepository.EXPECT().UpdateTable(gomock.Any(), table).Return(nil).Times(1)
+
+			seatsFreed := NewSeatsFreedSignal()
+			ts := NewDefaultEventTableService(mockRepository, seatsFreed)
+			_, err := ts.UpdateTable(context.Background(), tableID, capacity, 1)
+
+			assert.Nil(t, err)
+			assert.Equal(t, notified, len(seatsFreed.C()) == 1, capacity)
+		}
+	})
+}
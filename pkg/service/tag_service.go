@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+// Lower case letters, digits, dashes and underscores, matching the length of the `tag` table.
+var tagNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// Longest description accepted for a tag, matching the `tag` table.
+const maxTagDescriptionLength = 255
+
+/*
+The `DefaultTagService` manages the tags and the guests they are attached to.
+
+Tag names are case insensitive: they are stored and looked up in lower case.
+*/
+type DefaultTagService struct {
+	tagRepository repository.ITagRepository
+	logger        *slog.Logger
+}
+
+func NewDefaultTagService(tRepo repository.ITagRepository, logger *slog.Logger) *DefaultTagService {
+	return &DefaultTagService{
+		tagRepository: tRepo,
+		logger:        logger,
+	}
+}
+
+func (d *DefaultTagService) GetTags(ctx context.Context) ([]model.Tag, error) {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.GetTags")
+	defer span.End()
+
+	tags, err := d.tagRepository.GetTags(ctx)
+	return tags, tracing.RecordError(span, err)
+}
+
+func (d *DefaultTagService) GetTag(ctx context.Context, name string) (*model.Tag, error) {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.GetTag")
+	defer span.End()
+
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	tag, err := d.tagRepository.GetTag(ctx, name)
+	return tag, tracing.RecordError(span, err)
+}
+
+/**
+ * Creates a new tag. The name is stored in lower case, and must be made of letters,
+ * digits, dashes and underscores.
+ * If a tag with the same name exists, returns an AlreadyExists error.
+ *
+ * @param  params  pointer to TagData
+ * @return         pointer to the created Tag
+ */
+func (d *DefaultTagService) CreateTag(ctx context.Context, params *model.TagData) (*model.Tag, error) {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.CreateTag")
+	defer span.End()
+
+	name, err := normalizeTagName(params.Name)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	params.Name = name
+
+	params.Description, err = validateTagDescription(params.Description)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	tag, err := d.tagRepository.CreateTag(ctx, params)
+	return tag, tracing.RecordError(span, err)
+}
+
+/**
+ * Changes the description of a tag.
+ * If the tag is no longer at the expected version, returns a PreconditionFailed error.
+ *
+ * @param  name         name of the tag
+ * @param  description  new description of the tag
+ * @param  version      version the client read the tag at, or AnyVersion
+ * @return              pointer to the updated Tag
+ */
+func (d *DefaultTagService) UpdateTag(ctx context.Context, name string, description string, version int) (*model.Tag, error) {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.UpdateTag")
+	defer span.End()
+
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	description, err = validateTagDescription(description)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	tag, err := d.tagRepository.GetTag(ctx, name)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	// someone else changed the tag since the client read it
+	if version != AnyVersion && tag.Version != version {
+		return nil, tracing.RecordError(span, e.NewPreconditionFailedError("tag", version))
+	}
+
+	tag.Description = description
+	err = d.tagRepository.UpdateTag(ctx, tag)
+
+	return tag, tracing.RecordError(span, err)
+}
+
+func (d *DefaultTagService) DeleteTag(ctx context.Context, name string) error {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.DeleteTag")
+	defer span.End()
+
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	d.logger.InfoContext(ctx, "Deleting tag.", "tag", name)
+
+	return tracing.RecordError(span, d.tagRepository.DeleteTag(ctx, name))
+}
+
+func (d *DefaultTagService) GetGuestTags(ctx context.Context, guestName string) ([]model.Tag, error) {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.GetGuestTags")
+	defer span.End()
+
+	tags, err := d.tagRepository.GetGuestTags(ctx, guestName)
+	return tags, tracing.RecordError(span, err)
+}
+
+/**
+ * Attaches a tag to a guest. Attaching a tag the guest already has changes nothing.
+ * Returns a NotFound error if the guest or the tag do not exist.
+ *
+ * @param  guestName  name of the guest
+ * @param  tagName    name of the tag
+ * @return            the tags of the guest after attaching the tag
+ */
+func (d *DefaultTagService) AttachTag(ctx context.Context, guestName string, tagName string) ([]model.Tag, error) {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.AttachTag")
+	defer span.End()
+
+	tagName, err := normalizeTagName(tagName)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	d.logger.InfoContext(ctx, "Attaching tag to guest.", logging.GuestName(guestName), "tag", tagName)
+
+	err = d.tagRepository.AttachTag(ctx, guestName, tagName)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	tags, err := d.tagRepository.GetGuestTags(ctx, guestName)
+	return tags, tracing.RecordError(span, err)
+}
+
+/**
+ * Detaches a tag from a guest. Returns a NotFound error if the guest or the tag
+ * do not exist, or the guest doesn't have the tag.
+ *
+ * @param  guestName  name of the guest
+ * @param  tagName    name of the tag
+ */
+func (d *DefaultTagService) DetachTag(ctx context.Context, guestName string, tagName string) error {
+	ctx, span := tracer.Start(ctx, "DefaultTagService.DetachTag")
+	defer span.End()
+
+	tagName, err := normalizeTagName(tagName)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	d.logger.InfoContext(ctx, "Detaching tag from guest.", logging.GuestName(guestName), "tag", tagName)
+
+	return tracing.RecordError(span, d.tagRepository.DetachTag(ctx, guestName, tagName))
+}
+
+/*
+`normalizeTagName` returns the name of a tag in lower case, or a BadInput error
+if it isn't made of letters, digits, dashes and underscores.
+*/
+func normalizeTagName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !tagNamePattern.MatchString(name) {
+		return "", e.NewBadInputError("tag " + name)
+	}
+	return name, nil
+}
+
+// Same as normalizeTagName, but an empty name is valid and means no filter.
+func normalizeTagFilter(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	return normalizeTagName(name)
+}
+
+func validateTagDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if len(description) > maxTagDescriptionLength {
+		return "", e.NewBadInputError("description longer than 255 characters")
+	}
+	return description, nil
+}
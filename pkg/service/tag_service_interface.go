@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `ITagService` is an interface that defines methods for managing tags,
+such as VIP, press or staff, and attaching them to guests.
+*/
+type ITagService interface {
+	// Retrieves all the tags.
+	GetTags(ctx context.Context) ([]model.Tag, error)
+	// Retrieves a single tag by name.
+	GetTag(ctx context.Context, name string) (*model.Tag, error)
+	// Creates a new tag with parameters represented by `model.TagData`.
+	CreateTag(ctx context.Context, params *model.TagData) (*model.Tag, error)
+	// Changes the description of a tag, if it is still at the expected version.
+	UpdateTag(ctx context.Context, name string, description string, version int) (*model.Tag, error)
+	// Deletes a tag by name, detaching it from every guest.
+	DeleteTag(ctx context.Context, name string) error
+	// Retrieves the tags attached to a guest.
+	GetGuestTags(ctx context.Context, guestName string) ([]model.Tag, error)
+	// Attaches a tag to a guest, returning the tags the guest ends up with.
+	AttachTag(ctx context.Context, guestName string, tagName string) ([]model.Tag, error)
+	// Detaches a tag from a guest.
+	DetachTag(ctx context.Context, guestName string, tagName string) error
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultTagService_CreateTag(t *testing.T) {
+	t.Run("Return_BadInput_When_Name_Is_Invalid", func(t *testing.T) {
+		ds := NewDefaultTagService(nil, logging.NewNop())
+
+		for _, name := range []string{"", "two words", "-leading", strings.Repeat("a", 33)} {
+			_, err := ds.CreateTag(context.Background(), &model.TagData{Name: name})
+			assert.IsType(t, &ex.BadInputError{}, err, name)
+		}
+	})
+
+	t.Run("Return_BadInput_When_Description_Is_Too_Long", func(t *testing.T) {
+		ds := NewDefaultTagService(nil, logging.NewNop())
+
+		_, err := ds.CreateTag(context.Background(), &model.TagData{Name: "sponsor", Description: strings.Repeat("a", 256)})
+		assert.IsType(t, &ex.BadInputError{}, err)
+	})
+
+	t.Run("Create_Tag_With_Lower_Case_Name", func(t *testing.T) {
+		mockRepository := repository.NewMockITagRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			CreateTag(gomock.Any(), &model.TagData{Name: "sponsor", Description: "Pays for the drinks"}).
+			Return(&model.Tag{TagID: 5, Name: "sponsor", Description: "Pays for the drinks", Version: 1}, nil).
+			Times(1)
+
+		ds := NewDefaultTagService(mockRepository, logging.NewNop())
+
+		tag, err := ds.CreateTag(context.Background(), &model.TagData{Name: " Sponsor ", Description: "Pays for the drinks "})
+		assert.Nil(t, err)
+		assert.Equal(t, 5, tag.TagID)
+	})
+}
+
+func Test_DefaultTagService_UpdateTag(t *testing.T) {
+	t.Run("Return_PreconditionFailed_When_Version_Changed", func(t *testing.T) {
+		mockRepository := repository.NewMockITagRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			GetTag(gomock.Any(), "vip").
+			Return(&model.Tag{TagID: 1, Name: "vip", Version: 3}, nil).
+			Times(1)
+
+		ds := NewDefaultTagService(mockRepository, logging.NewNop())
+
+		_, err := ds.UpdateTag(context.Background(), "vip", "Very important", 2)
+		assert.Equal(t, ex.NewPreconditionFailedError("tag", 2).Error(), err.Error())
+	})
+
+	t.Run("Update_Description_When_Version_Matches", func(t *testing.T) {
+		tag := model.Tag{TagID: 1, Name: "vip", Version: 3}
+
+		mockRepository := repository.NewMockITagRepository(gomock.NewController(t))
+		mockRepository.EXPECT().GetTag(gomock.Any(), "vip").Return(&tag, nil).Times(1)
+		mockRepository.EXPECT().UpdateTag(gomock.Any(), &tag).Return(nil).Times(1)
+
+		ds := NewDefaultTagService(mockRepository, logging.NewNop())
+
+		updated, err := ds.UpdateTag(context.Background(), "VIP", "Very important", 3)
+		assert.Nil(t, err)
+		assert.Equal(t, "Very important", updated.Description)
+	})
+}
+
+func Test_DefaultTagService_AttachTag(t *testing.T) {
+	t.Run("Return_NotFound_When_Tag_Doesnt_Exist", func(t *testing.T) {
+		errNotFound := ex.NewNotFoundError("sponsor", "name", "tag")
+
+		mockRepository := repository.NewMockITagRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			AttachTag(gomock.Any(), "Flor", "sponsor").
+			Return(errNotFound).
+			Times(1)
+
+		ds := NewDefaultTagService(mockRepository, logging.NewNop())
+
+		_, err := ds.AttachTag(context.Background(), "Flor", "sponsor")
+		assert.Equal(t, errNotFound, err)
+	})
+
+	t.Run("Return_Guest_Tags_When_Attached", func(t *testing.T) {
+		mockRepository := repository.NewMockITagRepository(gomock.NewController(t))
+		gomock.InOrder(
+			mockRepository.EXPECT().AttachTag(gomock.Any(), "Flor", "vip").Return(nil),
+			mockRepository.EXPECT().GetGuestTags(gomock.Any(), "Flor").Return([]model.Tag{{Name: "press"}, {Name: "vip"}}, nil),
+		)
+
+		ds := NewDefaultTagService(mockRepository, logging.NewNop())
+
+		tags, err := ds.AttachTag(context.Background(), "Flor", "VIP")
+		assert.Nil(t, err)
+		assert.Len(t, tags, 2)
+	})
+}
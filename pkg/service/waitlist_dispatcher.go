@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+The `WaitlistDispatcher` offers free seats to the parties on the waitlist in the background.
+
+It runs whenever `seatsFreed` is notified, and every interval to expire the offers that
+weren't answered in time. Parties are visited by priority and then by age, and each one
+is offered the table with the fewest free seats that fits the whole party, so a large
+party that doesn't fit doesn't hold back the smaller ones behind it. Parties join as guests
+without tags, so the last `vipReserveSeats` free seats of each table are never offered. Each offer is logged,
+and emailed to the party through the outbox when they left an address.
+*/
+type WaitlistDispatcher struct {
+	waitlistRepository     repository.IWaitlistRepository
+	notificationRepository repository.INotificationRepository
+	renderer               *notification.Renderer
+	eventName              string
+	publicBaseURL          string
+	offerTTL               time.Duration
+	vipReserveSeats        int
+	seatsFreed             *SeatsFreedSignal
+	interval               time.Duration
+	logger                 *slog.Logger
+}
+
+func NewWaitlistDispatcher(wRepo repository.IWaitlistRepository, nRepo repository.INotificationRepository, renderer *notification.Renderer,
+	eventName string, publicBaseURL string, offerTTL time.Duration, vipReserveSeats int, seatsFreed *SeatsFreedSignal, interval time.Duration, logger *slog.Logger) *WaitlistDispatcher {
+	return &WaitlistDispatcher{
+		waitlistRepository:     wRepo,
+		notificationRepository: nRepo,
+		renderer:               renderer,
+		eventName:              eventName,
+		publicBaseURL:          strings.TrimSuffix(publicBaseURL, "/"),
+		offerTTL:               offerTTL,
+		vipReserveSeats:        vipReserveSeats,
+		seatsFreed:             seatsFreed,
+		interval:               interval,
+		logger:                 logger,
+	}
+}
+
+/*
+`Run` offers free seats every time seats are freed and every interval, until ctx is done.
+*/
+func (d *WaitlistDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.seatsFreed.C():
+		case <-ticker.C:
+		}
+		if _, err := d.OfferFreedSeats(ctx); err != nil && ctx.Err() == nil {
+			d.logger.ErrorContext(ctx, "Failed to offer seats to the waitlist.", "error", err)
+		}
+	}
+}
+
+/**
+ * Expires the offers past their expiry, then offers the free seats to the waiting
+ * parties that fit. Seats held by offers not answered yet, or held back for VIPs, are not offered.
+ *
+ * @return  number of offers made
+ */
+func (d *WaitlistDispatcher) OfferFreedSeats(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "WaitlistDispatcher.OfferFreedSeats")
+	defer span.End()
+
+	expired, err := d.waitlistRepository.ExpireOffers(ctx)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+	if expired > 0 {
+		d.logger.InfoContext(ctx, "Expired unanswered waitlist offers.", "count", expired)
+	}
+
+	seats, err := d.waitlistRepository.GetOfferableSeats(ctx)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+	for table, free := range seats {
+		seats[table] = seatsOutsideReserve(free, d.vipReserveSeats, false)
+	}
+
+	entries, err := d.waitlistRepository.GetWaitlist(ctx)
+	if err != nil {
+		return 0, tracing.RecordError(span, err)
+	}
+
+	offered := 0
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Status != model.WaitlistWaiting {
+			continue
+		}
+
+		table, ok := bestFittingTable(seats, entry.PartySize)
+		if !ok {
+			continue
+		}
+
+		token, err := NewInvitationToken()
+		if err != nil {
+			return offered, tracing.RecordError(span, err)
+		}
+
+		err = d.waitlistRepository.OfferEntry(ctx, entry, table, token, d.offerTTL)
+		var changed *e.PreconditionFailedError
+		if errors.As(err, &changed) {
+			// the party was cancelled or offered seats by someone else in the meantime
+			continue
+		}
+		if err != nil {
+			return offered, tracing.RecordError(span, err)
+		}
+
+		seats[table] -= entry.PartySize
+		offered++
+
+		d.logger.InfoContext(ctx, "Offered freed seats to waitlisted party.",
+			"entry_id", entry.EntryID,
+			logging.GuestName(entry.Name),
+			"table", table,
+			"party_size", entry.PartySize,
+			"offer_expires_at", entry.OfferExpiresAt,
+		)
+
+		if err := d.notify(ctx, entry); err != nil {
+			d.logger.ErrorContext(ctx, "Failed to queue waitlist offer email.", "entry_id", entry.EntryID, "error", err)
+		}
+	}
+
+	span.SetAttributes(attribute.Int("waitlist.expired", expired), attribute.Int("waitlist.offered", offered))
+
+	return offered, nil
+}
+
+// `notify` queues the email of the offer in the outbox, when the party left an address.
+func (d *WaitlistDispatcher) notify(ctx context.Context, entry *model.WaitlistEntry) error {
+	if entry.Email == "" {
+		return nil
+	}
+
+	message, err := d.renderer.Render(model.WaitlistOfferNotification, &notification.TemplateData{
+		EventName:           d.eventName,
+		Name:                entry.Name,
+		Table:               entry.Table,
+		Accompanying_guests: entry.PartySize - 1,
+		OfferLink:           d.publicBaseURL + "/v2/waitlist/offers/" + entry.OfferToken,
+		OfferExpiresAt:      entry.OfferExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.notificationRepository.EnqueueNotifications(ctx, []model.Notification{{
+		Recipient: entry.Email,
+		Kind:      model.WaitlistOfferNotification,
+		Subject:   message.Subject,
+		TextBody:  message.TextBody,
+		HTMLBody:  message.HTMLBody,
+		Status:    model.NotificationPending,
+	}})
+}
+
+/*
+`bestFittingTable` returns the table with the fewest free seats that still fits the party,
+the one with the lowest id on a tie, so larger tables stay free for larger parties.
+*/
+func bestFittingTable(seats map[int]int, partySize int) (int, bool) {
+	tables := make([]int, 0, len(seats))
+	for table := range seats {
+		tables = append(tables, table)
+	}
+	sort.Ints(tables)
+
+	best, found := 0, false
+	for _, table := range tables {
+		if seats[table] >= partySize && (!found || seats[table] < seats[best]) {
+			best, found = table, true
+		}
+	}
+	return best, found
+}
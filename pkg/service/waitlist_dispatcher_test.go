@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/notification"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WaitlistDispatcher_OfferFreedSeats(t *testing.T) {
+	renderer, err := notification.NewRenderer()
+	assert.Nil(t, err)
+
+	waiting := func(id int, partySize int, email string) model.WaitlistEntry {
+		return model.WaitlistEntry{EntryID: id, Name: "Party" + string(rune('A'+id)), Email: email, PartySize: partySize, Status: model.WaitlistWaiting, Version: 1}
+	}
+	// OfferEntry fills in the offer like the repository does
+	offer := func(_ context.Context, entry *model.WaitlistEntry, table int, token string, _ time.Duration) error {
+		entry.Status, entry.Table, entry.OfferToken, entry.OfferExpiresAt = model.WaitlistOffered, table, token, "2024-12-31 22:00:00"
+		return nil
+	}
+
+	t.Run("Offer_Best_Fitting_Table_To_Next_Fitting_Party", func(t *testing.T) {
+		mockWaitlist := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockWaitlist.EXPECT().ExpireOffers(gomock.Any()).Return(0, nil).Times(1)
+		mockWaitlist.EXPECT().GetOfferableSeats(gomock.Any()).Return(map[int]int{1: 6, 2: 3, 3: 0}, nil).Times(1)
+		mockWaitlist.
+			EXPECT().
+			GetWaitlist(gomock.Any()).
+			Return([]model.WaitlistEntry{
+				waiting(1, 8, ""),                  // doesn't fit anywhere
+				waiting(2, 3, "party@example.com"), // fits table 2 exactly
+				waiting(3, 4, ""),                  // only fits table 1
+				waiting(4, 3, ""),                  // table 1 has 2 seats left
+			}, nil).
+			Times(1)
+		mockWaitlist.EXPECT().OfferEntry(gomock.Any(), gomock.Any(), 2, gomock.Any(), time.Hour).DoAndReturn(offer).Times(1)
+		mockWaitlist.EXPECT().OfferEntry(gomock.Any(), gomock.Any(), 1, gomock.Any(), time.Hour).DoAndReturn(offer).Times(1)
+
+		mockNotifications := repository.NewMockINotificationRepository(gomock.NewController(t))
+		mockNotifications.
+			EXPECT().
+			EnqueueNotifications(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notifications []model.Notification) error {
+				assert.Len(t, notifications, 1)
+				assert.Equal(t, "party@example.com", notifications[0].Recipient)
+				assert.Equal(t, model.WaitlistOfferNotification, notifications[0].Kind)
+				assert.Contains(t, notifications[0].TextBody, "http://localhost:3000/v2/waitlist/offers/")
+				assert.True(t, strings.Contains(notifications[0].TextBody, "table 2"))
+				return nil
+			}).
+			Times(1)
+
+		d := NewWaitlistDispatcher(mockWaitlist, mockNotifications, renderer, "Party", "http://localhost:3000/", time.Hour, 0, nil, time.Minute, logging.NewNop())
+
+		offered, err := d.OfferFreedSeats(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, offered)
+	})
+
+	t.Run("Leave_Seats_Held_For_VIPs_Out_Of_Offers", func(t *testing.T) {
+		mockWaitlist := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockWaitlist.EXPECT().ExpireOffers(gomock.Any()).Return(0, nil).Times(1)
+		mockWaitlist.EXPECT().GetOfferableSeats(gomock.Any()).Return(map[int]int{1: 4, 2: 6}, nil).Times(1)
+		mockWaitlist.EXPECT().GetWaitlist(gomock.Any()).Return([]model.WaitlistEntry{waiting(1, 3, "")}, nil).Times(1)
+		// table 1 only has 2 seats outside the 2 held back for VIPs
+		mockWaitlist.EXPECT().OfferEntry(gomock.Any(), gomock.Any(), 2, gomock.Any(), time.Hour).DoAndReturn(offer).Times(1)
+
+		d := NewWaitlistDispatcher(mockWaitlist, nil, renderer, "Party", "http://localhost:3000", time.Hour, 2, nil, time.Minute, logging.NewNop())
+
+		offered, err := d.OfferFreedSeats(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, offered)
+	})
+
+	t.Run("Skip_Party_Changed_In_The_Meantime", func(t *testing.T) {
+		mockWaitlist := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockWaitlist.EXPECT().ExpireOffers(gomock.Any()).Return(2, nil).Times(1)
+		mockWaitlist.EXPECT().GetOfferableSeats(gomock.Any()).Return(map[int]int{1: 4}, nil).Times(1)
+		mockWaitlist.EXPECT().GetWaitlist(gomock.Any()).Return([]model.WaitlistEntry{waiting(1, 4, ""), waiting(2, 4, "")}, nil).Times(1)
+		gomock.InOrder(
+			mockWaitlist.EXPECT().OfferEntry(gomock.Any(), gomock.Any(), 1, gomock.Any(), time.Hour).Return(ex.NewPreconditionFailedError("waitlist entry", 1)),
+			mockWaitlist.EXPECT().OfferEntry(gomock.Any(), gomock.Any(), 1, gomock.Any(), time.Hour).DoAndReturn(offer),
+		)
+
+		d := NewWaitlistDispatcher(mockWaitlist, nil, renderer, "Party", "http://localhost:3000", time.Hour, 0, nil, time.Minute, logging.NewNop())
+
+		offered, err := d.OfferFreedSeats(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, offered)
+	})
+}
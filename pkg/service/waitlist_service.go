@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	e "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/fpetrikovich/go-guestlist/pkg/tracing"
+)
+
+/*
+The `DefaultWaitlistService` keeps the parties that didn't fit at the event waiting for seats.
+
+Seats are offered to the parties by the `WaitlistDispatcher`. When a party accepts, they
+are added to the guest list through the guest service, which checks the seats are still
+free. Changes that may leave seats to offer notify `seatsFreed`.
+*/
+type DefaultWaitlistService struct {
+	waitlistRepository repository.IWaitlistRepository
+	guestService       IGuestService
+	seatsFreed         *SeatsFreedSignal
+	logger             *slog.Logger
+}
+
+func NewDefaultWaitlistService(wRepo repository.IWaitlistRepository, gService IGuestService, seatsFreed *SeatsFreedSignal, logger *slog.Logger) *DefaultWaitlistService {
+	return &DefaultWaitlistService{
+		waitlistRepository: wRepo,
+		guestService:       gService,
+		seatsFreed:         seatsFreed,
+		logger:             logger,
+	}
+}
+
+func (d *DefaultWaitlistService) GetWaitlist(ctx context.Context) ([]model.WaitlistEntry, error) {
+	ctx, span := tracer.Start(ctx, "DefaultWaitlistService.GetWaitlist")
+	defer span.End()
+
+	entries, err := d.waitlistRepository.GetWaitlist(ctx)
+	return entries, tracing.RecordError(span, err)
+}
+
+/**
+ * Adds a party to the waitlist. The name must be a valid guest name, the party must
+ * have at least one person, and the email, when given, must be a single address.
+ * Seats free right now are offered to the party by the next run of the dispatcher.
+ *
+ * @param  params  pointer to WaitlistRequest
+ * @return         pointer to the created WaitlistEntry
+ */
+func (d *DefaultWaitlistService) Enqueue(ctx context.Context, params *model.WaitlistRequest) (*model.WaitlistEntry, error) {
+	ctx, span := tracer.Start(ctx, "DefaultWaitlistService.Enqueue")
+	defer span.End()
+
+	params.Email = strings.TrimSpace(params.Email)
+
+	if params.Name == "" {
+		return nil, tracing.RecordError(span, e.NewBadInputError("name is required"))
+	}
+	if err := e.ValidateStringInput(params.Name); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+	if params.PartySize < 1 {
+		return nil, tracing.RecordError(span, e.NewBadInputError("party_size must be at least 1"))
+	}
+	if err := e.ValidateEmailInput(params.Email); err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	entry, err := d.waitlistRepository.CreateEntry(ctx, params)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	d.logger.InfoContext(ctx, "Added party to waitlist.",
+		"entry_id", entry.EntryID,
+		logging.GuestName(entry.Name),
+		"party_size", entry.PartySize,
+		"priority", entry.Priority,
+	)
+
+	d.seatsFreed.Notify()
+
+	return entry, nil
+}
+
+/**
+ * Removes a party from the waitlist. Seats offered to the party are offered to the
+ * next party. Returns a WaitlistStatus error if the party already left the waitlist.
+ *
+ * @param  id  id of the entry
+ */
+func (d *DefaultWaitlistService) Cancel(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "DefaultWaitlistService.Cancel")
+	defer span.End()
+
+	entry, err := d.waitlistRepository.GetEntry(ctx, id)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if entry.Status != model.WaitlistWaiting && entry.Status != model.WaitlistOffered {
+		return tracing.RecordError(span, e.NewWaitlistStatusError(string(entry.Status)))
+	}
+
+	held := entry.Status == model.WaitlistOffered
+	entry.Status = model.WaitlistCancelled
+
+	err = d.waitlistRepository.UpdateEntry(ctx, entry)
+	if err != nil {
+		return tracing.RecordError(span, err)
+	}
+
+	if held {
+		d.seatsFreed.Notify()
+	}
+
+	return nil
+}
+
+func (d *DefaultWaitlistService) GetOffer(ctx context.Context, token string) (*model.WaitlistEntry, error) {
+	ctx, span := tracer.Start(ctx, "DefaultWaitlistService.GetOffer")
+	defer span.End()
+
+	entry, err := d.waitlistRepository.GetEntryByOfferToken(ctx, token)
+	return entry, tracing.RecordError(span, err)
+}
+
+/**
+ * Answers the seats offered to a party. When accepted, the party is added to the guest
+ * list at the offered table. If someone else took the seats in the meantime, the party
+ * goes back to waiting and the ExceedsCapacity error is returned. When declined, the
+ * seats are offered to the next party.
+ * Returns a WaitlistStatus error if the offer was already answered or expired.
+ *
+ * @param  token   token of the offer link
+ * @param  accept  whether the party takes the seats
+ * @return         pointer to the answered WaitlistEntry
+ */
+func (d *DefaultWaitlistService) AnswerOffer(ctx context.Context, token string, accept bool) (*model.WaitlistEntry, error) {
+	ctx, span := tracer.Start(ctx, "DefaultWaitlistService.AnswerOffer")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("waitlist.accept", accept))
+
+	// offers past their expiry can't be answered, even if the dispatcher didn't expire them yet
+	_, err := d.waitlistRepository.ExpireOffers(ctx)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	entry, err := d.waitlistRepository.GetEntryByOfferToken(ctx, token)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	if entry.Status != model.WaitlistOffered {
+		return nil, tracing.RecordError(span, e.NewWaitlistStatusError(string(entry.Status)))
+	}
+
+	if !accept {
+		entry.Status = model.WaitlistDeclined
+		err = d.waitlistRepository.UpdateEntry(ctx, entry)
+		if err != nil {
+			return nil, tracing.RecordError(span, err)
+		}
+		d.seatsFreed.Notify()
+		return entry, nil
+	}
+
+	err = d.guestService.CreateGuest(ctx, &model.GuestData{
+		Name:                entry.Name,
+		Table:               entry.Table,
+		Accompanying_guests: entry.PartySize - 1,
+		GuestProfile:        model.GuestProfile{Email: entry.Email},
+	})
+
+	if _, full := err.(*e.ExceedsCapacityError); full {
+		d.logger.WarnContext(ctx, "Offered seats were taken, party is waiting again.", "entry_id", entry.EntryID, "table", entry.Table)
+
+		entry.Status = model.WaitlistWaiting
+		entry.Table = 0
+		if updateErr := d.waitlistRepository.UpdateEntry(ctx, entry); updateErr != nil {
+			return nil, tracing.RecordError(span, updateErr)
+		}
+		d.seatsFreed.Notify()
+		return nil, tracing.RecordError(span, err)
+	}
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	entry.Status = model.WaitlistAccepted
+	err = d.waitlistRepository.UpdateEntry(ctx, entry)
+	if err != nil {
+		return nil, tracing.RecordError(span, err)
+	}
+
+	d.logger.InfoContext(ctx, "Waitlisted party accepted offer.", "entry_id", entry.EntryID, logging.GuestName(entry.Name), "table", entry.Table)
+
+	return entry, nil
+}
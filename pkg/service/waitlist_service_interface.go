@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+)
+
+/*
+The `IWaitlistService` is an interface that defines methods for managing the parties
+waiting for seats, and for the parties to answer the seats offered to them.
+*/
+type IWaitlistService interface {
+	// Retrieves the parties waiting or holding an offer, in the order seats are offered to them.
+	GetWaitlist(ctx context.Context) ([]model.WaitlistEntry, error)
+	// Adds a party to the waitlist.
+	Enqueue(ctx context.Context, params *model.WaitlistRequest) (*model.WaitlistEntry, error)
+	// Removes a party from the waitlist.
+	Cancel(ctx context.Context, id int) error
+	// Retrieves the entry an offer was made to by the token of the offer.
+	GetOffer(ctx context.Context, token string) (*model.WaitlistEntry, error)
+	// Accepts or declines an offer, adding the party to the guest list when accepted.
+	AnswerOffer(ctx context.Context, token string, accept bool) (*model.WaitlistEntry, error)
+}
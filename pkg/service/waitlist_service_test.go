@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	ex "github.com/fpetrikovich/go-guestlist/pkg/exception"
+	"github.com/fpetrikovich/go-guestlist/pkg/logging"
+	"github.com/fpetrikovich/go-guestlist/pkg/model"
+	"github.com/fpetrikovich/go-guestlist/pkg/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultWaitlistService_Enqueue(t *testing.T) {
+	t.Run("Return_BadInput_When_Request_Is_Invalid", func(t *testing.T) {
+		ws := NewDefaultWaitlistService(nil, nil, nil, logging.NewNop())
+
+		for _, request := range []model.WaitlistRequest{
+			{Name: "", PartySize: 2},
+			{Name: "Two Words", PartySize: 2},
+			{Name: "Flor", PartySize: 0},
+			{Name: "Flor", PartySize: 2, Email: "not-an-email"},
+		} {
+			_, err := ws.Enqueue(context.Background(), &request)
+			assert.IsType(t, &ex.BadInputError{}, err, request)
+		}
+	})
+
+	t.Run("Notify_Seats_Freed_When_Enqueued", func(t *testing.T) {
+		request := model.WaitlistRequest{Name: "Flor", PartySize: 3, Email: " flor@example.com "}
+
+		mockRepository := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockRepository.
+			EXPECT().
+			CreateEntry(gomock.Any(), &model.WaitlistRequest{Name: "Flor", PartySize: 3, Email: "flor@example.com"}).
+			Return(&model.WaitlistEntry{EntryID: 1, Name: "Flor", PartySize: 3, Status: model.WaitlistWaiting}, nil).
+			Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		ws := NewDefaultWaitlistService(mockRepository, nil, seatsFreed, logging.NewNop())
+
+		entry, err := ws.Enqueue(context.Background(), &request)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, entry.EntryID)
+		assert.Len(t, seatsFreed.C(), 1)
+	})
+}
+
+func Test_DefaultWaitlistService_AnswerOffer(t *testing.T) {
+	token := "0123456789abcdef0123456789abcdef"
+	offered := func() *model.WaitlistEntry {
+		return &model.WaitlistEntry{EntryID: 1, Name: "Flor", Email: "flor@example.com", PartySize: 3, Status: model.WaitlistOffered, Table: 2, Version: 2}
+	}
+
+	t.Run("Return_WaitlistStatus_When_Offer_Expired", func(t *testing.T) {
+		mockRepository := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockRepository.EXPECT().ExpireOffers(gomock.Any()).Return(1, nil).Times(1)
+		mockRepository.
+			EXPECT().
+			GetEntryByOfferToken(gomock.Any(), token).
+			Return(&model.WaitlistEntry{EntryID: 1, Status: model.WaitlistExpired}, nil).
+			Times(1)
+
+		ws := NewDefaultWaitlistService(mockRepository, nil, nil, logging.NewNop())
+
+		_, err := ws.AnswerOffer(context.Background(), token, true)
+		assert.Equal(t, ex.NewWaitlistStatusError("expired"), err)
+	})
+
+	t.Run("Add_Guest_When_Accepted", func(t *testing.T) {
+		entry := offered()
+
+		mockRepository := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockRepository.EXPECT().ExpireOffers(gomock.Any()).Return(0, nil).Times(1)
+		mockRepository.EXPECT().GetEntryByOfferToken(gomock.Any(), token).Return(entry, nil).Times(1)
+		mockRepository.EXPECT().UpdateEntry(gomock.Any(), entry).Return(nil).Times(1)
+
+		mockGuestService := NewMockIGuestService(gomock.NewController(t))
+		mockGuestService.
+			EXPECT().
+			CreateGuest(gomock.Any(), &model.GuestData{
+				Name:                "Flor",
+				Table:               2,
+				Accompanying_guests: 2,
+				GuestProfile:        model.GuestProfile{Email: "flor@example.com"},
+			}).
+			Return(nil).
+			Times(1)
+
+		ws := NewDefaultWaitlistService(mockRepository, mockGuestService, nil, logging.NewNop())
+
+		answered, err := ws.AnswerOffer(context.Background(), token, true)
+		assert.Nil(t, err)
+		assert.Equal(t, model.WaitlistAccepted, answered.Status)
+	})
+
+	t.Run("Wait_Again_When_Seats_Were_Taken", func(t *testing.T) {
+		entry := offered()
+		errFull := ex.NewExceedsCapacityError(1, 2)
+
+		mockRepository := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockRepository.EXPECT().ExpireOffers(gomock.Any()).Return(0, nil).Times(1)
+		mockRepository.EXPECT().GetEntryByOfferToken(gomock.Any(), token).Return(entry, nil).Times(1)
+		mockRepository.EXPECT().UpdateEntry(gomock.Any(), entry).Return(nil).Times(1)
+
+		mockGuestService := NewMockIGuestService(gomock.NewController(t))
+		mockGuestService.EXPECT().CreateGuest(gomock.Any(), gomock.Any()).Return(errFull).Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		ws := NewDefaultWaitlistService(mockRepository, mockGuestService, seatsFreed, logging.NewNop())
+
+		_, err := ws.AnswerOffer(context.Background(), token, true)
+		assert.Equal(t, errFull, err)
+		assert.Equal(t, model.WaitlistWaiting, entry.Status)
+		assert.Equal(t, 0, entry.Table)
+		assert.Len(t, seatsFreed.C(), 1)
+	})
+
+	t.Run("Free_Seats_When_Declined", func(t *testing.T) {
+		entry := offered()
+
+		mockRepository := repository.NewMockIWaitlistRepository(gomock.NewController(t))
+		mockRepository.EXPECT().ExpireOffers(gomock.Any()).Return(0, nil).Times(1)
+		mockRepository.EXPECT().GetEntryByOfferToken(gomock.Any(), token).Return(entry, nil).Times(1)
+		mockRepository.EXPECT().UpdateEntry(gomock.Any(), entry).Return(nil).Times(1)
+
+		seatsFreed := NewSeatsFreedSignal()
+		ws := NewDefaultWaitlistService(mockRepository, nil, seatsFreed, logging.NewNop())
+
+		answered, err := ws.AnswerOffer(context.Background(), token, false)
+		assert.Nil(t, err)
+		assert.Equal(t, model.WaitlistDeclined, answered.Status)
+		assert.Len(t, seatsFreed.C(), 1)
+	})
+}
@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/fpetrikovich/go-guestlist/pkg/config"
+)
+
+// Exporters supported by InitTracer.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+/*
+Type `ShutdownFunc` flushes any pending spans and releases the exporter.
+It must be called before the application exits.
+*/
+type ShutdownFunc func(context.Context) error
+
+/**
+ * Sets up the global OpenTelemetry tracer provider using the exporter selected
+ * in the configuration. The `otlp` exporter sends spans over OTLP/HTTP to a collector,
+ * the `stdout` exporter prints them, and `none` keeps the default no-op provider.
+ *
+ * @param  ctx  context used while creating the exporter
+ * @param  cfg  pointer to the application Config
+ * @return      function that flushes and stops the tracer provider
+ */
+func InitTracer(ctx context.Context, cfg *config.Config) (ShutdownFunc, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch cfg.TracingExporter {
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx, otlptracehttp.WithEndpoint(cfg.OTLPEndpoint), otlptracehttp.WithInsecure())
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterNone, "":
+		slog.Info("Tracing disabled.")
+		return func(context.Context) error { return nil }, nil
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.TracingExporter)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	slog.Info("Tracing enabled.", "exporter", cfg.TracingExporter)
+
+	return provider.Shutdown, nil
+}
+
+/*
+`RecordError` marks the span as failed when err is not nil, so slow or failing
+layers stand out in the trace view. It returns err unchanged for convenience.
+*/
+func RecordError(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
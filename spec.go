@@ -0,0 +1,9 @@
+// Package guestlist holds the files of the repository that are embedded in the binary.
+package guestlist
+
+import _ "embed"
+
+// APISpec is the OpenAPI specification of the API, `api-spec.yaml`.
+//
+//go:embed api-spec.yaml
+var APISpec []byte